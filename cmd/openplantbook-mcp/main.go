@@ -2,12 +2,15 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/rmrfslashbin/openplantbook-mcp/internal/server"
 )
@@ -19,10 +22,17 @@ var (
 	buildTime = "unknown"
 )
 
+// shutdownGracePeriod bounds how long main waits for the server to stop after a signal
+const shutdownGracePeriod = 15 * time.Second
+
 func main() {
 	// Parse flags
-	configPath := flag.String("config", "", "Path to config file (default: ~/.config/openplantbook-mcp/config.json)")
+	configPath := flag.String("config", "", "Path to a JSON, YAML or TOML config file (default: ~/.config/openplantbook-mcp/config.{json,yaml,toml})")
 	showVersion := flag.Bool("version", false, "Show version information")
+	validateConfig := flag.Bool("validate-config", false, "Validate configuration and credential format, then exit without contacting the API")
+	transport := flag.String("transport", "", "Transport to serve: stdio, or http (alias sse) for HTTP/SSE (default: config transport or stdio)")
+	listenAddr := flag.String("listen", "", "Address the http transport listens on (default: config listen_addr or 127.0.0.1:8080)")
+	maxRequestBytes := flag.Int64("max-request-bytes", 0, "Maximum HTTP request body size in bytes (default: config max_request_bytes or 1 MiB; stdio is unaffected)")
 	flag.Parse()
 
 	// Show version and exit
@@ -44,14 +54,38 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  OPENPLANTBOOK_CLIENT_ID=xxx OPENPLANTBOOK_CLIENT_SECRET=xxx  (for OAuth2)\n")
 		os.Exit(1)
 	}
+	if *maxRequestBytes > 0 {
+		config.MaxRequestBytes = *maxRequestBytes
+	}
+	// Normalized like the config file and environment value, so -transport HTTP works
+	if t := strings.ToLower(strings.TrimSpace(*transport)); t != "" {
+		config.Transport = t
+	}
+	if *listenAddr != "" {
+		config.ListenAddr = *listenAddr
+	}
 
-	// Create server
+	// Create server; the API client is only needed when serving
 	srv, err := server.New(config, version)
+	if err == nil && !*validateConfig {
+		err = srv.Ping()
+	}
 	if err != nil {
 		slog.Error("failed to create server", "error", err)
+		if errors.Is(err, server.ErrAuthConfig) {
+			fmt.Fprintf(os.Stderr, "Credential error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "\nCheck OPENPLANTBOOK_API_KEY, or OPENPLANTBOOK_CLIENT_ID and OPENPLANTBOOK_CLIENT_SECRET.\n")
+		} else {
+			fmt.Fprintf(os.Stderr, "Startup error: %v\n", err)
+		}
 		os.Exit(1)
 	}
 
+	if *validateConfig {
+		fmt.Println("Configuration OK")
+		os.Exit(0)
+	}
+
 	// Set up context with cancellation
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -71,6 +105,17 @@ func main() {
 	case sig := <-sigChan:
 		slog.Info("shutdown signal received", "signal", sig)
 		cancel()
+
+		// Let the HTTP transport finish in-flight requests; stdio stops with context.Canceled
+		select {
+		case err := <-errChan:
+			if err != nil && !errors.Is(err, context.Canceled) {
+				slog.Error("server error during shutdown", "error", err)
+				os.Exit(1)
+			}
+		case <-time.After(shutdownGracePeriod):
+			slog.Warn("shutdown timed out")
+		}
 	case err := <-errChan:
 		if err != nil {
 			slog.Error("server error", "error", err)
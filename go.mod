@@ -7,6 +7,8 @@ require (
 	github.com/rmrfslashbin/openplantbook-go v1.1.3
 	github.com/rs/xid v1.6.0
 	github.com/spf13/viper v1.21.0
+	golang.org/x/oauth2 v0.32.0
+	golang.org/x/time v0.14.0
 )
 
 require (
@@ -27,9 +29,7 @@ require (
 	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
 	github.com/yosida95/uritemplate/v3 v3.0.2 // indirect
 	go.yaml.in/yaml/v3 v3.0.4 // indirect
-	golang.org/x/oauth2 v0.32.0 // indirect
 	golang.org/x/sys v0.29.0 // indirect
 	golang.org/x/text v0.28.0 // indirect
-	golang.org/x/time v0.14.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,105 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Aggregation methods for multi-sample readings
+const (
+	aggregateMean   = "mean"
+	aggregateMedian = "median"
+	aggregateLatest = "latest"
+)
+
+// aggregationMethods lists the accepted aggregation values
+var aggregationMethods = []string{aggregateMean, aggregateMedian, aggregateLatest}
+
+// validAggregation reports whether method is a supported aggregation
+func validAggregation(method string) bool {
+	for _, m := range aggregationMethods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// aggregateSamples reduces samples to one value. Samples are in the order given,
+// so "latest" is the last element.
+func aggregateSamples(samples []float64, method string) float64 {
+	switch method {
+	case aggregateLatest:
+		return samples[len(samples)-1]
+	case aggregateMedian:
+		sorted := append([]float64(nil), samples...)
+		sort.Float64s(sorted)
+		mid := len(sorted) / 2
+		if len(sorted)%2 == 0 {
+			return (sorted[mid-1] + sorted[mid]) / 2
+		}
+		return sorted[mid]
+	}
+
+	total := 0.0
+	for _, v := range samples {
+		total += v
+	}
+	return total / float64(len(samples))
+}
+
+// aggregateConditions replaces array readings with a single aggregated value.
+// Single numbers pass through unchanged. It returns the sample count for each
+// metric that was given as an array, or an error for a malformed array.
+func aggregateConditions(conditions map[string]interface{}, method string) (map[string]interface{}, map[string]int, error) {
+	aggregated := make(map[string]interface{}, len(conditions))
+	counts := map[string]int{}
+
+	for key, value := range conditions {
+		raw, isArray := value.([]interface{})
+		if !isArray {
+			aggregated[key] = value
+			continue
+		}
+		if len(raw) == 0 {
+			return nil, nil, fmt.Errorf("current_conditions.%s must contain at least one sample", key)
+		}
+
+		samples := make([]float64, len(raw))
+		for i, item := range raw {
+			v, ok := item.(float64)
+			if !ok {
+				return nil, nil, fmt.Errorf("current_conditions.%s sample %d must be a number", key, i)
+			}
+			samples[i] = v
+		}
+		aggregated[key] = aggregateSamples(samples, method)
+		counts[key] = len(samples)
+	}
+
+	return aggregated, counts, nil
+}
+
+// formatAggregationNote describes how multi-sample readings were reduced
+func formatAggregationNote(method string, counts map[string]int) string {
+	if len(counts) == 0 {
+		return ""
+	}
+
+	var parts []string
+	for _, m := range careMetrics {
+		if n, ok := counts[m.key]; ok {
+			parts = append(parts, fmt.Sprintf("%s: %d", m.key, n))
+		}
+	}
+	return fmt.Sprintf("\n_Multi-sample readings aggregated by **%s** (samples - %s)._\n", method, strings.Join(parts, ", "))
+}
+
+// defaultAggregation returns the configured aggregation, or mean when unset or invalid
+func (s *Server) defaultAggregation() string {
+	if validAggregation(s.config.Aggregation) {
+		return s.config.Aggregation
+	}
+	return aggregateMean
+}
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestAggregateSamples(t *testing.T) {
+	// A single spike drags the mean but not the median
+	samples := []float64{40, 42, 41, 95, 43}
+
+	tests := []struct {
+		method   string
+		samples  []float64
+		expected float64
+	}{
+		{aggregateMean, samples, 52.2},
+		{aggregateMedian, samples, 42},
+		{aggregateMedian, []float64{1, 4, 2, 3}, 2.5},
+		{aggregateLatest, samples, 43},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := aggregateSamples(tt.samples, tt.method); got != tt.expected {
+				t.Errorf("aggregateSamples(%v, %s) = %v, want %v", tt.samples, tt.method, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAggregateConditions(t *testing.T) {
+	conditions := map[string]interface{}{
+		"moisture":    []interface{}{30.0, 50.0},
+		"temperature": 21.0,
+	}
+
+	aggregated, counts, err := aggregateConditions(conditions, aggregateMean)
+	if err != nil {
+		t.Fatalf("aggregateConditions() error = %v", err)
+	}
+	if aggregated["moisture"] != 40.0 || aggregated["temperature"] != 21.0 {
+		t.Errorf("aggregated = %v", aggregated)
+	}
+	if len(counts) != 1 || counts["moisture"] != 2 {
+		t.Errorf("counts = %v", counts)
+	}
+
+	for _, bad := range []interface{}{[]interface{}{}, []interface{}{"wet"}} {
+		if _, _, err := aggregateConditions(map[string]interface{}{"moisture": bad}, aggregateMean); err == nil {
+			t.Errorf("expected error for %v", bad)
+		}
+	}
+}
+
+func TestHandleCompareConditions_Aggregation(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"basil|": {PID: "basil", Alias: "basil", MinSoilMoist: 20, MaxSoilMoist: 60},
+	}}
+	s := newTestServer(t, client)
+
+	tests := []struct {
+		name        string
+		aggregation interface{}
+		want        []string
+	}{
+		{"default mean sees the spike", nil, []string{"Soil Moisture Too High", "aggregated by **mean** (samples - moisture: 4)"}},
+		{"median ignores the spike", "median", []string{"✅ **Soil Moisture**: 43.8%", "aggregated by **median**"}},
+		{"latest", "latest", []string{"✅ **Soil Moisture**: 45.0%", "aggregated by **latest**"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{
+				"pid":                "basil",
+				"current_conditions": map[string]interface{}{"moisture": []interface{}{40.0, 250.0, 42.5, 45.0}},
+			}
+			if tt.aggregation != nil {
+				req.GetArguments()["aggregation"] = tt.aggregation
+			}
+
+			result, err := s.handleCompareConditions(context.Background(), req)
+			if err != nil {
+				t.Fatalf("handleCompareConditions() error = %v", err)
+			}
+			text := resultText(t, result)
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("expected %q in output:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// autocompleteMaxResults caps the suggestions returned for a prefix
+	autocompleteMaxResults = 8
+
+	// autocompleteMinQuery is the shortest prefix worth sending to the API
+	autocompleteMinQuery = 2
+
+	// autocompleteCacheTTL keeps suggestions briefly; type-ahead repeats the same prefixes often
+	autocompleteCacheTTL = 10 * time.Minute
+)
+
+// normalizeAutocompleteQuery folds case and whitespace so equivalent prefixes share a cache entry
+func normalizeAutocompleteQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+// suggestionNames extracts unique display names, preserving the API's ranking
+func suggestionNames(results []openplantbook.PlantSearchResult) []string {
+	names := make([]string, 0, len(results))
+	seen := map[string]bool{}
+	for _, r := range results {
+		name := r.DisplayPID
+		if name == "" {
+			name = r.PID
+		}
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == autocompleteMaxResults {
+			break
+		}
+	}
+	return names
+}
+
+// autocomplete returns display-name suggestions for a prefix, using the short-lived suggestion cache
+func (s *Server) autocomplete(ctx context.Context, query string) ([]string, error) {
+	if len(query) < autocompleteMinQuery {
+		return []string{}, nil
+	}
+
+	if s.suggestCache != nil {
+		if cached, ok := s.suggestCache.get(query); ok {
+			s.logger.Debug("autocomplete cache hit", "query", query)
+			s.usage.suggestCacheHits.Add(1)
+			return append([]string(nil), cached.([]string)...), nil
+		}
+	}
+
+	client, err := s.apiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.SearchPlants(ctx, query, &openplantbook.SearchOptions{
+		Limit: autocompleteMaxResults,
+	})
+	s.usage.recordCall(&s.usage.searchCalls, err)
+	if err != nil {
+		return nil, err
+	}
+
+	names := suggestionNames(results)
+	if s.suggestCache != nil {
+		s.suggestCache.set(query, append([]string(nil), names...))
+	}
+	return names, nil
+}
+
+// handleAutocomplete handles the autocomplete tool
+func (s *Server) handleAutocomplete(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "autocomplete")
+
+	// Extract parameters
+	query, err := request.RequireString("query")
+	if err != nil {
+		logger.Warn("invalid query parameter", "error", err)
+		return mcp.NewToolResultError("query parameter is required and must be a string"), nil
+	}
+	query = normalizeAutocompleteQuery(query)
+
+	logger.Debug("autocompleting", "query", query)
+
+	names, err := s.autocomplete(ctx, query)
+	if err != nil {
+		logger.Error("autocomplete failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("autocomplete failed: %v", err)), nil
+	}
+
+	// Compact JSON keeps the payload small for suggestion dropdowns
+	data, err := json.Marshal(map[string]interface{}{
+		"query":       query,
+		"suggestions": names,
+	})
+	if err != nil {
+		logger.Error("marshal suggestions failed", "error", err)
+		return mcp.NewToolResultError("failed to format suggestions"), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestHandleAutocomplete(t *testing.T) {
+	var results []openplantbook.PlantSearchResult
+	for i := 0; i < 12; i++ {
+		results = append(results, openplantbook.PlantSearchResult{
+			PID:        fmt.Sprintf("monstera %d", i),
+			DisplayPID: fmt.Sprintf("Monstera %d", i),
+		})
+	}
+	// Duplicate display names are collapsed
+	results = append([]openplantbook.PlantSearchResult{results[0]}, results...)
+
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{"mon": results}}
+	s := newTestServer(t, client)
+	s.suggestCache = newResponseCache(autocompleteCacheTTL, 0)
+
+	call := func(query string) string {
+		req := mcp.CallToolRequest{}
+		req.Params.Arguments = map[string]interface{}{"query": query}
+		result, err := s.handleAutocomplete(context.Background(), req)
+		if err != nil {
+			t.Fatalf("handleAutocomplete() error = %v", err)
+		}
+		return resultText(t, result)
+	}
+
+	want := `{"query":"mon","suggestions":["Monstera 0","Monstera 1","Monstera 2","Monstera 3","Monstera 4","Monstera 5","Monstera 6","Monstera 7"]}`
+	if got := call("Mon"); got != want {
+		t.Errorf("autocomplete = %s, want %s", got, want)
+	}
+
+	// Equivalent prefix is served from the suggestion cache
+	if got := call("  MON "); got != want {
+		t.Errorf("cached autocomplete = %s, want %s", got, want)
+	}
+	if len(client.searchCalls) != 1 {
+		t.Errorf("expected 1 search call, got %d", len(client.searchCalls))
+	}
+
+	// Too-short prefixes don't reach the API
+	if got := call("m"); got != `{"query":"m","suggestions":[]}` {
+		t.Errorf("short query = %s", got)
+	}
+	if len(client.searchCalls) != 1 {
+		t.Errorf("short query should not search, got %d calls", len(client.searchCalls))
+	}
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// defaultBadgeCriticalDeviation is used when badge_critical_deviation is not configured
+const defaultBadgeCriticalDeviation = 25.0
+
+// badgeStatus is the single status token shown on a dashboard
+type badgeStatus int
+
+const (
+	badgeHealthy badgeStatus = iota
+	badgeAttention
+	badgeCritical
+)
+
+// String returns the status emoji and label
+func (b badgeStatus) String() string {
+	switch b {
+	case badgeAttention:
+		return "🟡 attention"
+	case badgeCritical:
+		return "🔴 critical"
+	}
+	return "🟢 healthy"
+}
+
+// metricBadge is the badge severity for one metric
+type metricBadge struct {
+	metric    careMetric
+	value     float64
+	min, max  float64
+	deviation float64 // percent of the range width outside the range; 0 when inside
+	status    badgeStatus
+}
+
+// rangeDeviation returns how far value lies outside [min, max] as a percentage of the
+// range width. Zero-width ranges are measured against the boundary value instead.
+func rangeDeviation(value, min, max float64) float64 {
+	dist := distanceOutside(value, min, max)
+	if dist == 0 {
+		return 0
+	}
+	width := max - min
+	if width <= 0 {
+		width = math.Max(math.Abs(max), 1)
+	}
+	return dist / width * 100
+}
+
+// rateMetrics rates every metric that has both a reading and plant data, in careMetrics order.
+// In range is healthy; outside by less than criticalDeviation percent of the range width
+// needs attention; anything further is critical.
+func rateMetrics(details *openplantbook.PlantDetails, conditions map[string]interface{}, criticalDeviation float64) []metricBadge {
+	var rated []metricBadge
+
+	for _, m := range careMetrics {
+		value, exists := conditions[m.key].(float64)
+		if !exists {
+			continue
+		}
+		min, max, ok := m.ideal(details)
+		if !ok {
+			continue
+		}
+
+		b := metricBadge{metric: m, value: value, min: min, max: max, deviation: rangeDeviation(value, min, max)}
+		switch {
+		case b.deviation == 0:
+			b.status = badgeHealthy
+		case b.deviation < criticalDeviation:
+			b.status = badgeAttention
+		default:
+			b.status = badgeCritical
+		}
+		rated = append(rated, b)
+	}
+
+	return rated
+}
+
+// computeBadge rates each metric and returns the worst one.
+// ok is false when nothing was comparable.
+func computeBadge(details *openplantbook.PlantDetails, conditions map[string]interface{}, criticalDeviation float64) (metricBadge, bool) {
+	var worst metricBadge
+	found := false
+
+	for _, b := range rateMetrics(details, conditions, criticalDeviation) {
+		if !found || b.status > worst.status || (b.status == worst.status && b.deviation > worst.deviation) {
+			worst = b
+		}
+		found = true
+	}
+
+	return worst, found
+}
+
+// badgeReason is the one-line explanation shown next to the badge
+func badgeReason(b metricBadge) string {
+	if b.status == badgeHealthy {
+		return "all readings within ideal ranges"
+	}
+	direction := "low"
+	if b.value > b.max {
+		direction = "high"
+	}
+	return fmt.Sprintf("%s %s: %g%s (ideal %g-%g%s)", b.metric.label, direction, b.value, b.metric.unit, b.min, b.max, b.metric.unit)
+}
+
+// badgeCriticalDeviation returns the configured critical threshold or the default
+func (s *Server) badgeCriticalDeviation() float64 {
+	if s.config.BadgeCriticalDeviation > 0 {
+		return s.config.BadgeCriticalDeviation
+	}
+	return defaultBadgeCriticalDeviation
+}
+
+// handleStatusBadge handles the status_badge tool
+func (s *Server) handleStatusBadge(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "status_badge")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	conditions, ok := request.GetArguments()["current_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid current_conditions parameter")
+		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
+	}
+
+	logger.Info("computing status badge", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	badge, ok := computeBadge(details, conditions, s.badgeCriticalDeviation())
+	if !ok {
+		return mcp.NewToolResultError("no comparable conditions provided: supply moisture, temperature, light_lux, or humidity for metrics this plant has data for"), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("%s - %s", badge.status, badgeReason(badge))), nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestComputeBadge(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		MinSoilMoist: 20, MaxSoilMoist: 60, // width 40
+		MinTemp: 15, MaxTemp: 25, // width 10
+	}
+
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		expected   badgeStatus
+		metric     string
+		reason     string
+	}{
+		{"all in range", map[string]interface{}{"moisture": 40.0, "temperature": 20.0}, badgeHealthy, "", "all readings within ideal ranges"},
+		{"slightly dry", map[string]interface{}{"moisture": 15.0, "temperature": 20.0}, badgeAttention, "moisture", "Soil Moisture low: 15% (ideal 20-60%)"},
+		{"at critical threshold", map[string]interface{}{"moisture": 10.0}, badgeCritical, "moisture", ""},
+		{"worst metric wins", map[string]interface{}{"moisture": 15.0, "temperature": 30.0}, badgeCritical, "temperature", "Temperature high: 30°C (ideal 15-25°C)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			badge, ok := computeBadge(details, tt.conditions, defaultBadgeCriticalDeviation)
+			if !ok {
+				t.Fatal("computeBadge() found no comparable metrics")
+			}
+			if badge.status != tt.expected {
+				t.Errorf("status = %s, want %s", badge.status, tt.expected)
+			}
+			if tt.metric != "" && badge.metric.key != tt.metric {
+				t.Errorf("metric = %s, want %s", badge.metric.key, tt.metric)
+			}
+			if tt.reason != "" && badgeReason(badge) != tt.reason {
+				t.Errorf("reason = %q, want %q", badgeReason(badge), tt.reason)
+			}
+		})
+	}
+
+	t.Run("configurable threshold", func(t *testing.T) {
+		badge, _ := computeBadge(details, map[string]interface{}{"moisture": 15.0}, 10)
+		if badge.status != badgeCritical {
+			t.Errorf("status = %s, want critical with a 10%% threshold", badge.status)
+		}
+	})
+
+	t.Run("nothing comparable", func(t *testing.T) {
+		if _, ok := computeBadge(details, map[string]interface{}{"humidity": 50.0}, defaultBadgeCriticalDeviation); ok {
+			t.Error("expected no badge when the plant has no data for the supplied metrics")
+		}
+	})
+}
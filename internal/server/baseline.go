@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// defaultBaselineProfile is the "generic houseplant": ranges most common houseplants
+// tolerate. Individual metrics can be overridden with the baseline_profile config key.
+var defaultBaselineProfile = map[string]valueRange{
+	"light_lux":   {2000, 15000},
+	"temperature": {16, 27},
+	"humidity":    {40, 60},
+	"moisture":    {20, 60},
+	"soil_ec":     {350, 1500},
+}
+
+// baselineSimilarFraction is how far a plant's range midpoint may sit from the baseline
+// midpoint, as a fraction of the baseline range width, and still count as similar
+const baselineSimilarFraction = 0.25
+
+// baselineMetric describes how to compare one metric against the baseline
+type baselineMetric struct {
+	key   string
+	label string
+	more  string // phrase when the plant needs more than average
+	less  string // phrase when the plant needs less than average
+	ideal func(d *openplantbook.PlantDetails) (min, max float64, ok bool)
+}
+
+// baselineMetrics lists the metrics compared against the baseline, in display order
+var baselineMetrics = []baselineMetric{
+	{"light_lux", "Light", "needs more light than average", "tolerates less light than average", careMetricIdeal("light_lux")},
+	{"temperature", "Temperature", "prefers warmer conditions than average", "prefers cooler conditions than average", careMetricIdeal("temperature")},
+	{"humidity", "Humidity", "needs more humidity than average", "prefers drier air than average", careMetricIdeal("humidity")},
+	{"moisture", "Soil Moisture", "prefers wetter soil than average", "prefers drier soil than average", careMetricIdeal("moisture")},
+	{"soil_ec", "Fertilizer (EC)", "is a heavier feeder than average", "is a lighter feeder than average", func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+		return float64(d.MinSoilEC), float64(d.MaxSoilEC), d.MaxSoilEC > 0
+	}},
+}
+
+// careMetricIdeal returns the ideal-range function of the careMetrics entry with the given key
+func careMetricIdeal(key string) func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+	for _, m := range careMetrics {
+		if m.key == key {
+			return m.ideal
+		}
+	}
+	panic("unknown care metric: " + key)
+}
+
+// baselineDelta is how one metric of a plant compares to the baseline
+type baselineDelta struct {
+	metric baselineMetric
+	shift  float64 // midpoint difference as a fraction of the baseline width
+}
+
+// describe returns a plain-language comparison
+func (d baselineDelta) describe() string {
+	switch {
+	case d.shift > baselineSimilarFraction:
+		return d.metric.more
+	case d.shift < -baselineSimilarFraction:
+		return d.metric.less
+	}
+	return "similar to a typical houseplant"
+}
+
+// computeBaselineDeltas compares each metric's range midpoint to the baseline midpoint.
+// Metrics without plant data or without a baseline range are skipped.
+func computeBaselineDeltas(details *openplantbook.PlantDetails, baseline map[string]valueRange) []baselineDelta {
+	var deltas []baselineDelta
+	for _, m := range baselineMetrics {
+		base, ok := baseline[m.key]
+		if !ok || base.max <= base.min {
+			continue
+		}
+		min, max, ok := m.ideal(details)
+		if !ok {
+			continue
+		}
+		shift := ((min+max)/2 - (base.min+base.max)/2) / (base.max - base.min)
+		deltas = append(deltas, baselineDelta{metric: m, shift: shift})
+	}
+	return deltas
+}
+
+// formatBaselineDeltas renders the comparison as a summary section
+func formatBaselineDeltas(deltas []baselineDelta, baseline map[string]valueRange) string {
+	if len(deltas) == 0 {
+		return ""
+	}
+
+	var similar []string
+	output := "## Compared to a Typical Houseplant\n\n"
+	for _, d := range deltas {
+		base := baseline[d.metric.key]
+		if math.Abs(d.shift) <= baselineSimilarFraction {
+			similar = append(similar, strings.ToLower(d.metric.label))
+			continue
+		}
+		output += fmt.Sprintf("- **%s**: %s (typical: %g - %g)\n", d.metric.label, d.describe(), base.min, base.max)
+	}
+	if len(similar) > 0 {
+		output += fmt.Sprintf("- Similar to average: %s\n", strings.Join(similar, ", "))
+	}
+	return output + "\n"
+}
+
+// baselineProfile returns the configured baseline, falling back to the defaults per metric
+func (s *Server) baselineProfile() map[string]valueRange {
+	profile := make(map[string]valueRange, len(defaultBaselineProfile))
+	for key, r := range defaultBaselineProfile {
+		profile[key] = r
+	}
+	for key, r := range s.config.BaselineProfile {
+		profile[key] = orderedRange(r[0], r[1])
+	}
+	return profile
+}
+
+// parseBaselineProfile reads baseline overrides from config. Each metric may be a
+// {"min": x, "max": y} object or an [x, y] array; invalid entries are ignored.
+func parseBaselineProfile(raw interface{}) map[string][2]float64 {
+	entries, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	profile := map[string][2]float64{}
+	for key, value := range entries {
+		if r, ok := parseConditionRange(normalizeNumbers(value)); ok && r.max > r.min {
+			profile[strings.ToLower(key)] = [2]float64{r.min, r.max}
+		}
+	}
+	return profile
+}
+
+// normalizeNumbers converts integer config values to float64 so parseConditionRange accepts them
+func normalizeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = normalizeNumbers(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = normalizeNumbers(item)
+		}
+		return out
+	}
+	return value
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestComputeBaselineDeltas(t *testing.T) {
+	// A fern-like plant: shady, humid, typical temperature
+	fern := &openplantbook.PlantDetails{
+		MinLightLux: 500, MaxLightLux: 4000,
+		MinTemp: 15, MaxTemp: 27,
+		MinEnvHumid: 60, MaxEnvHumid: 90,
+	}
+
+	deltas := computeBaselineDeltas(fern, defaultBaselineProfile)
+	if len(deltas) != 3 {
+		t.Fatalf("expected 3 deltas (no moisture or EC data), got %d", len(deltas))
+	}
+
+	expected := map[string]string{
+		"light_lux":   "tolerates less light than average",
+		"temperature": "similar to a typical houseplant",
+		"humidity":    "needs more humidity than average",
+	}
+	for _, d := range deltas {
+		if got := d.describe(); got != expected[d.metric.key] {
+			t.Errorf("%s: describe() = %q, want %q (shift %.2f)", d.metric.key, got, expected[d.metric.key], d.shift)
+		}
+	}
+
+	output := formatBaselineDeltas(deltas, defaultBaselineProfile)
+	for _, want := range []string{"**Humidity**: needs more humidity than average (typical: 40 - 60)", "Similar to average: temperature"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+}
+
+func TestBaselineMetrics_HaveDefaults(t *testing.T) {
+	for _, m := range baselineMetrics {
+		if _, ok := defaultBaselineProfile[m.key]; !ok {
+			t.Errorf("baseline metric %q has no default range", m.key)
+		}
+	}
+}
+
+func TestBaselineProfile_Overrides(t *testing.T) {
+	s := newTestServer(t, &fakeClient{})
+	s.config.BaselineProfile = parseBaselineProfile(map[string]interface{}{
+		"Humidity":    map[string]interface{}{"min": 50, "max": 70},
+		"temperature": []interface{}{25.0, 18.0},
+		"light_lux":   "bright", // ignored
+	})
+
+	profile := s.baselineProfile()
+	if profile["humidity"] != (valueRange{50, 70}) {
+		t.Errorf("humidity = %v, want {50 70}", profile["humidity"])
+	}
+	if profile["temperature"] != (valueRange{18, 25}) {
+		t.Errorf("temperature = %v, want {18 25}", profile["temperature"])
+	}
+	if profile["light_lux"] != defaultBaselineProfile["light_lux"] {
+		t.Errorf("light_lux should keep the default, got %v", profile["light_lux"])
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+)
+
+// defaultMaxBatchSize caps the plants a bulk tool accepts per call when max_batch_size
+// is not configured. Each plant costs up to one upstream call per fallback language.
+const defaultMaxBatchSize = 50
+
+// defaultFetchConcurrency is how many plant details bulk tools fetch at once when
+// fetch_concurrency is not configured
+const defaultFetchConcurrency = 8
+
+// maxBatchSize returns the configured batch limit or the default
+func (s *Server) maxBatchSize() int {
+	if s.config.MaxBatchSize > 0 {
+		return s.config.MaxBatchSize
+	}
+	return defaultMaxBatchSize
+}
+
+// fetchConcurrency returns the configured fetch worker count or the default
+func (s *Server) fetchConcurrency() int {
+	if s.config.FetchConcurrency > 0 {
+		return s.config.FetchConcurrency
+	}
+	return defaultFetchConcurrency
+}
+
+// runConcurrently calls job for every index below n on a pool of at most
+// fetchConcurrency workers, returning once all calls have finished. Bulk tools use it so
+// a full batch doesn't hit the API, or the rate limiter, all at once.
+func (s *Server) runConcurrently(n int, job func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(s.fetchConcurrency(), n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				job(i)
+			}
+		}()
+	}
+	for i := range n {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// checkBatchSize rejects a bulk parameter with more than maxBatchSize entries
+func (s *Server) checkBatchSize(param string, n int) error {
+	if limit := s.maxBatchSize(); n > limit {
+		return fmt.Errorf("%s has %d entries but at most %d are allowed per call; split the request into smaller batches", param, n, limit)
+	}
+	return nil
+}
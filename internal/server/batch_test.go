@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBulkToolsRejectOversizedBatches(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.MaxBatchSize = 3
+
+	pids := []interface{}{"a", "b", "c", "d"}
+	plants := make([]interface{}, len(pids))
+	for i, pid := range pids {
+		plants[i] = map[string]interface{}{"pid": pid, "current_moisture": 40.0}
+	}
+	conditions := map[string]interface{}{"temperature": 20.0}
+
+	tests := []struct {
+		name    string
+		handler func(*Server, context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]interface{}
+		param   string
+	}{
+		{"validate_pids", (*Server).handleValidatePids, map[string]interface{}{"pids": pids}, "pids"},
+		{"group_by_trait", (*Server).handleGroupByTrait, map[string]interface{}{"pids": pids, "trait": "high humidity"}, "pids"},
+		{"simulate_change", (*Server).handleSimulateChange, map[string]interface{}{"pids": pids, "from_conditions": conditions, "to_conditions": conditions}, "pids"},
+		{"shelf_placement", (*Server).handleShelfPlacement, map[string]interface{}{"pids": pids, "shelves": []interface{}{map[string]interface{}{"name": "top", "lux": 5000.0}}}, "pids"},
+		{"care_matrix", (*Server).handleCareMatrix, map[string]interface{}{"pids": pids}, "pids"},
+		{"find_care_duplicates", (*Server).handleFindCareDuplicates, map[string]interface{}{"pids": pids}, "pids"},
+		{"export_garden_planner", (*Server).handleExportGardenPlanner, map[string]interface{}{"pids": pids}, "pids"},
+		{"monthly_checklist", (*Server).handleMonthlyChecklist, map[string]interface{}{"pids": pids}, "pids"},
+		{"compare_plants", (*Server).handleComparePlants, map[string]interface{}{"pids": pids}, "pids"},
+		{"recommend_plants", (*Server).handleRecommendPlants, map[string]interface{}{"pids": pids, "light_lux": 5000.0}, "pids"},
+		{"get_plant_care_batch", (*Server).handleGetPlantCareBatch, map[string]interface{}{"pids": pids}, "pids"},
+		{"collection_watering_plan", (*Server).handleCollectionWateringPlan, map[string]interface{}{"plants": plants}, "plants"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+
+			result, err := tt.handler(srv, context.Background(), request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			text := resultText(t, result)
+			if !result.IsError {
+				t.Fatalf("expected oversized batch to be rejected, got:\n%s", text)
+			}
+			want := fmt.Sprintf("%s has 4 entries but at most 3", tt.param)
+			if !strings.Contains(text, want) || !strings.Contains(text, "split") {
+				t.Errorf("expected %q and a hint to split, got %q", want, text)
+			}
+		})
+	}
+}
+
+func TestMaxBatchSizeDefault(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	if got := srv.maxBatchSize(); got != defaultMaxBatchSize {
+		t.Errorf("maxBatchSize() = %d, want default %d", got, defaultMaxBatchSize)
+	}
+	if err := srv.checkBatchSize("pids", defaultMaxBatchSize); err != nil {
+		t.Errorf("batch at the limit should pass: %v", err)
+	}
+}
+
+// concurrencyClient records the most GetPlantDetails calls in flight at once
+type concurrencyClient struct {
+	fakeClient
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (c *concurrencyClient) GetPlantDetails(ctx context.Context, pid string, opts *openplantbook.DetailOptions) (*openplantbook.PlantDetails, error) {
+	c.mu.Lock()
+	c.inFlight++
+	c.peak = max(c.peak, c.inFlight)
+	c.mu.Unlock()
+
+	time.Sleep(5 * time.Millisecond)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+	return &openplantbook.PlantDetails{PID: pid, Alias: pid}, nil
+}
+
+func TestRunConcurrently_Bounded(t *testing.T) {
+	newPids := func(n int) []string {
+		pids := make([]string, n)
+		for i := range pids {
+			pids[i] = fmt.Sprintf("plant %d", i)
+		}
+		return pids
+	}
+
+	tests := []struct {
+		name string
+		run  func(*Server, []string) int // returns how many pids succeeded
+	}{
+		{"fetchPlantsInLanguage", func(srv *Server, pids []string) int {
+			ok := 0
+			for i, f := range srv.fetchPlantsInLanguage(context.Background(), pids, "") {
+				if f.err == nil && f.pid == pids[i] && f.details.PID == pids[i] {
+					ok++
+				}
+			}
+			return ok
+		}},
+		{"validatePids", func(srv *Server, pids []string) int {
+			ok := 0
+			for i, v := range srv.validatePids(context.Background(), pids) {
+				if v.valid() && v.pid == pids[i] {
+					ok++
+				}
+			}
+			return ok
+		}},
+	}
+
+	for _, tt := range tests {
+		for _, concurrency := range []int{3, 0} {
+			t.Run(fmt.Sprintf("%s/%d", tt.name, concurrency), func(t *testing.T) {
+				client := &concurrencyClient{}
+				srv := newTestServer(t, client)
+				srv.config.FetchConcurrency = concurrency
+
+				pids := newPids(20)
+				if ok := tt.run(srv, pids); ok != len(pids) {
+					t.Fatalf("%d of %d pids succeeded in order", ok, len(pids))
+				}
+				if want := srv.fetchConcurrency(); client.peak > want {
+					t.Errorf("peak concurrency = %d, want at most %d", client.peak, want)
+				}
+			})
+		}
+	}
+}
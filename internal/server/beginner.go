@@ -0,0 +1,108 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// beginnerModeParam is the per-call override added to the schema of beginnerModeTools
+const beginnerModeParam = "beginner_mode"
+
+// beginnerModeTools have plain-language variants of their markdown output. Their
+// formatters take the beginner flag themselves; other tools are unaffected.
+var beginnerModeTools = map[string]bool{
+	"get_care_summary":   true,
+	"compare_conditions": true,
+}
+
+// fertilizerLevel maps an EC value (µS/cm) to a plain-language feeding level
+func fertilizerLevel(ec float64) string {
+	switch {
+	case ec < 500:
+		return "low"
+	case ec < 1500:
+		return "medium"
+	}
+	return "high"
+}
+
+// fertilizerAdvice explains a feeding level
+var fertilizerAdvice = map[string]string{
+	"low":    "feed rarely, at quarter strength",
+	"medium": "regular feeding at the label's dose",
+	"high":   "a heavy feeder, feed often",
+}
+
+// lightBandFor returns the light band an average lux value falls in
+func lightBandFor(lux float64) lightBand {
+	for _, b := range lightBands {
+		if lux < float64(b.below) {
+			return b
+		}
+	}
+	return lightBands[len(lightBands)-1]
+}
+
+// lightName is the short lowercase name of a band, e.g. "medium indirect light"
+func lightName(b lightBand) string {
+	name, _, _ := strings.Cut(b.description, " - ")
+	return strings.ToLower(name)
+}
+
+// humidityPhrase describes a humidity range in everyday terms
+func humidityPhrase(avg float64) string {
+	switch {
+	case avg < 40:
+		return "dry air is fine, like most heated homes"
+	case avg < 60:
+		return "normal room humidity"
+	}
+	return "humid air, like a bathroom or terrarium"
+}
+
+// beginnerLightCheck compares a light reading with the plant's range in named light
+// levels instead of lux. It reports whether the reading is within the range.
+func beginnerLightCheck(light, min, max float64) (string, bool) {
+	current := lightName(lightBandFor(light))
+	needs := lightBandFor((min + max) / 2).description
+	switch {
+	case light < min:
+		return fmt.Sprintf("❌ **Light Too Low**: Current %s, needs %s (not enough light)", current, needs), false
+	case light > max:
+		return fmt.Sprintf("❌ **Light Too High**: Current %s, needs %s (too much light)", current, needs), false
+	}
+	return fmt.Sprintf("✅ **Light**: %s (within the plant's range)", current), true
+}
+
+// beginnerFertilizerCheck compares an EC reading with the plant's range in fertilizer
+// levels instead of µS/cm. It reports whether the reading is within the range.
+func beginnerFertilizerCheck(ec, min, max float64) (string, bool) {
+	current := fertilizerLevel(ec)
+	needs := fertilizerLevel((min + max) / 2)
+	switch {
+	case ec < min:
+		return fmt.Sprintf("❌ **Fertilizer Too Low**: Current %s fertilizer level, needs %s (too little fertilizer)", current, needs), false
+	case ec > max:
+		return fmt.Sprintf("❌ **Fertilizer Too High**: Current %s fertilizer level, needs %s (too much fertilizer)", current, needs), false
+	}
+	return fmt.Sprintf("✅ **Fertilizer**: %s fertilizer level (within the plant's range)", current), true
+}
+
+// addBeginnerModeProperty adds the beginner_mode override to a tool's input schema
+func addBeginnerModeProperty(tool *mcp.Tool) {
+	if tool.InputSchema.Properties == nil {
+		tool.InputSchema.Properties = map[string]interface{}{}
+	}
+	tool.InputSchema.Properties[beginnerModeParam] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Simplify the output for beginners: plain-language light and fertilizer levels instead of lux and EC (optional, defaults to the server setting)",
+	}
+}
+
+// beginnerMode reports whether a call asked for plain-language output, falling back to
+// the server setting
+func (s *Server) beginnerMode(request mcp.CallToolRequest) bool {
+	return request.GetBool(beginnerModeParam, s.config.BeginnerMode)
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Care summary output profiles
+const (
+	summaryProfileFull     = "full"
+	summaryProfileBeginner = "beginner"
+)
+
+// summaryProfiles lists the accepted get_care_summary profile values
+var summaryProfiles = []string{summaryProfileFull, summaryProfileBeginner}
+
+// beginnerPhrasebook holds the beginner profile's wording for one language. Each slice
+// is indexed by the band the plant's range falls in, mildest need first.
+type beginnerPhrasebook struct {
+	title       string // format string taking the plant name
+	labels      beginnerLabels
+	water       [4]string // by moisture band: dry, slightly moist, evenly moist, wet
+	light       [4]string // by lightBands index
+	temperature [3]string // cool, normal room, warm
+	humidity    [3]string // dry air fine, normal, humid
+	feeding     [3]string // by fertilizerLevel: low, medium, high
+}
+
+// beginnerLabels are the bullet labels
+type beginnerLabels struct {
+	water, light, temperature, humidity, feeding string
+}
+
+// beginnerPhrasebooks maps a language to its phrasebook. Add a language here (and to
+// interpretationLanguages) to localize the beginner profile.
+var beginnerPhrasebooks = map[string]beginnerPhrasebook{
+	"en": {
+		title: "How to keep %s happy",
+		labels: beginnerLabels{
+			water:       "Water",
+			light:       "Light",
+			temperature: "Temperature",
+			humidity:    "Humidity",
+			feeding:     "Feeding",
+		},
+		water: [4]string{
+			"water only when the soil has dried out completely",
+			"water when the top few centimetres of soil feel dry",
+			"water when the surface starts to feel dry, keeping the soil lightly moist",
+			"keep the soil moist and water as soon as the surface begins to dry",
+		},
+		light: [4]string{
+			"happy away from windows, even in a dim room",
+			"keep a few steps back from a window, in bright but indirect light",
+			"keep near a bright window, out of harsh midday sun",
+			"give it the sunniest window you have",
+		},
+		temperature: [3]string{
+			"prefers a cool room, away from heaters",
+			"normal room temperature suits it",
+			"likes it warm, so keep it away from cold windows in winter",
+		},
+		humidity: [3]string{
+			"dry indoor air is fine",
+			"normal room humidity is fine",
+			"likes humid air, so a bathroom or a group of plants helps",
+		},
+		feeding: [3]string{
+			"feed rarely, a weak dose a few times a year",
+			"feed about once a month in spring and summer",
+			"feed every two weeks in spring and summer",
+		},
+	},
+}
+
+// Temperature limits of an ordinary living room, in °C
+const (
+	beginnerRoomTempLow  = 18.0
+	beginnerRoomTempHigh = 24.0
+)
+
+// beginnerPhrases returns the phrasebook for lang, falling back to the default language
+func beginnerPhrases(lang string) beginnerPhrasebook {
+	if book, ok := beginnerPhrasebooks[baseLanguage(lang)]; ok {
+		return book
+	}
+	return beginnerPhrasebooks[defaultInterpretationLang]
+}
+
+// moistureBand places a moisture range in the same bands as interpretMoistureLevel
+func moistureBand(min, max int) int {
+	switch avg := (min + max) / 2; {
+	case avg < 20:
+		return 0
+	case avg < 40:
+		return 1
+	case avg < 60:
+		return 2
+	}
+	return 3
+}
+
+// lightBandIndex returns the index in lightBands of a lux range's average
+func lightBandIndex(min, max int) int {
+	avg := (min + max) / 2
+	for i, b := range lightBands[:len(lightBands)-1] {
+		if avg < b.below {
+			return i
+		}
+	}
+	return len(lightBands) - 1
+}
+
+// temperatureBand is 0 for plants that can't take a normal room's warmth, 2 for plants
+// that need more than its coolest, and 1 when an ordinary room is within the range
+func temperatureBand(min, max float64) int {
+	switch {
+	case max < beginnerRoomTempHigh:
+		return 0
+	case min > beginnerRoomTempLow:
+		return 2
+	}
+	return 1
+}
+
+// humidityBand buckets a humidity range with the same thresholds as humidityPhrase
+func humidityBand(min, max int) int {
+	switch avg := float64(min+max) / 2; {
+	case avg < 40:
+		return 0
+	case avg < 60:
+		return 1
+	}
+	return 2
+}
+
+// feedingBand buckets an EC range by fertilizerLevel
+func feedingBand(min, max int) int {
+	switch fertilizerLevel(float64(min+max) / 2) {
+	case "low":
+		return 0
+	case "medium":
+		return 1
+	}
+	return 2
+}
+
+// beginnerGuidance translates a plant's ranges into plain-language tips, most important
+// first, in the given language. Metrics without data are skipped; no numbers are shown.
+func beginnerGuidance(details *openplantbook.PlantDetails, lang string) []string {
+	book := beginnerPhrases(lang)
+	var tips []string
+
+	if details.MaxSoilMoist > 0 {
+		tips = append(tips, fmt.Sprintf("**%s**: %s", book.labels.water, book.water[moistureBand(details.MinSoilMoist, details.MaxSoilMoist)]))
+	}
+	if details.MaxLightLux > 0 {
+		tips = append(tips, fmt.Sprintf("**%s**: %s", book.labels.light, book.light[lightBandIndex(details.MinLightLux, details.MaxLightLux)]))
+	}
+	if details.MaxTemp > 0 {
+		tips = append(tips, fmt.Sprintf("**%s**: %s", book.labels.temperature, book.temperature[temperatureBand(details.MinTemp, details.MaxTemp)]))
+	}
+	if details.MaxEnvHumid > 0 {
+		tips = append(tips, fmt.Sprintf("**%s**: %s", book.labels.humidity, book.humidity[humidityBand(details.MinEnvHumid, details.MaxEnvHumid)]))
+	}
+	if details.MaxSoilEC > 0 {
+		tips = append(tips, fmt.Sprintf("**%s**: %s", book.labels.feeding, book.feeding[feedingBand(details.MinSoilEC, details.MaxSoilEC)]))
+	}
+	return tips
+}
+
+// renderBeginnerSummary renders the beginner profile of a care summary
+func renderBeginnerSummary(details *openplantbook.PlantDetails, lang string) string {
+	name := details.Alias
+	if name == "" {
+		name = details.DisplayPID
+	}
+	summary := fmt.Sprintf("# "+beginnerPhrases(lang).title+"\n\n", name)
+	for _, tip := range beginnerGuidance(details, lang) {
+		summary += "- " + tip + "\n"
+	}
+	return summary
+}
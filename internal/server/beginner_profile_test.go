@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBeginnerGuidance(t *testing.T) {
+	tests := []struct {
+		name    string
+		details *openplantbook.PlantDetails
+		want    []string
+	}{
+		{
+			name: "tropical houseplant",
+			details: &openplantbook.PlantDetails{
+				MinSoilMoist: 30, MaxSoilMoist: 60, MinLightLux: 1500, MaxLightLux: 15000,
+				MinTemp: 18, MaxTemp: 27, MinEnvHumid: 60, MaxEnvHumid: 80, MinSoilEC: 350, MaxSoilEC: 2000,
+			},
+			want: []string{
+				"**Water**: water when the surface starts to feel dry, keeping the soil lightly moist",
+				"**Light**: keep a few steps back from a window, in bright but indirect light",
+				"**Temperature**: normal room temperature suits it",
+				"**Humidity**: likes humid air, so a bathroom or a group of plants helps",
+				"**Feeding**: feed about once a month in spring and summer",
+			},
+		},
+		{
+			name: "cactus",
+			details: &openplantbook.PlantDetails{
+				MinSoilMoist: 5, MaxSoilMoist: 25, MinLightLux: 20000, MaxLightLux: 80000, MinTemp: 20, MaxTemp: 35,
+			},
+			want: []string{
+				"**Water**: water only when the soil has dried out completely",
+				"**Light**: give it the sunniest window you have",
+				"**Temperature**: likes it warm, so keep it away from cold windows in winter",
+			},
+		},
+		{
+			name:    "cool-loving",
+			details: &openplantbook.PlantDetails{MinTemp: 5, MaxTemp: 20, MinEnvHumid: 20, MaxEnvHumid: 40},
+			want: []string{
+				"**Temperature**: prefers a cool room, away from heaters",
+				"**Humidity**: dry indoor air is fine",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := beginnerGuidance(tt.details, "en")
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("beginnerGuidance =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestBeginnerPhrases_FallsBack(t *testing.T) {
+	if got := beginnerPhrases("xx-YY").labels.water; got != "Water" {
+		t.Errorf("expected the default phrasebook, got label %q", got)
+	}
+	if got := beginnerPhrases("EN-gb").labels.water; got != "Water" {
+		t.Errorf("expected en for en-GB, got label %q", got)
+	}
+}
+
+func TestGetCareSummary_BeginnerProfile(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {
+			PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera",
+			MinLightLux: 1500, MaxLightLux: 15000, MinSoilMoist: 30, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000,
+		},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa", "profile": "beginner", "include_table": true}
+	result, err := srv.handleGetCareSummary(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("get_care_summary failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	if !strings.HasPrefix(text, "# How to keep Monstera happy") {
+		t.Errorf("unexpected title:\n%s", text)
+	}
+	for _, jargon := range []string{"lux", "µS/cm", "EC", "Thresholds"} {
+		if strings.Contains(text, jargon) {
+			t.Errorf("beginner profile should hide %q:\n%s", jargon, text)
+		}
+	}
+
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa", "profile": "expert"}
+	result, _ = srv.handleGetCareSummary(context.Background(), request)
+	if !result.IsError {
+		t.Error("expected an unknown profile to be rejected")
+	}
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestRenderCareSummary_Beginner(t *testing.T) {
+	details := &openplantbook.PlantDetails{PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera",
+		MinLightLux: 1500, MaxLightLux: 15000, MinTemp: 15, MaxTemp: 30, MinEnvHumid: 60, MaxEnvHumid: 90,
+		MinSoilMoist: 15, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000}
+
+	summary := renderCareSummary(details, summaryOptions{metric: true, beginner: true, thresholdTable: true})
+
+	for _, want := range []string{
+		"**Light**: Medium indirect light - typical indoor lighting\n",
+		"**Temperature**: 15.0 - 30.0°C",
+		"**Humidity**: humid air, like a bathroom or terrarium\n",
+		"**Soil Moisture**: Slightly moist - let soil dry between waterings\n",
+		"**Fertilizer**: medium (regular feeding at the label's dose)\n",
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("beginner summary missing %q:\n%s", want, summary)
+		}
+	}
+	for _, jargon := range []string{"lux", "µS/cm", "EC", "Units:", "## Thresholds"} {
+		if strings.Contains(summary, jargon) {
+			t.Errorf("beginner summary should not contain %q:\n%s", jargon, summary)
+		}
+	}
+}
+
+func TestCompareConditions_Beginner(t *testing.T) {
+	details := &openplantbook.PlantDetails{Alias: "Monstera", MinLightLux: 1500, MaxLightLux: 15000, MinSoilEC: 350, MaxSoilEC: 2000}
+
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		want       string
+	}{
+		{"light too low", map[string]interface{}{"light_lux": 500.0}, "❌ **Light Too Low**: Current low light, needs Medium indirect light - typical indoor lighting (not enough light)"},
+		{"light in range", map[string]interface{}{"light_lux": 5000.0}, "✅ **Light**: medium indirect light (within the plant's range)"},
+		{"fertilizer too high", map[string]interface{}{"soil_ec": 2400.0}, "❌ **Fertilizer Too High**: Current high fertilizer level, needs medium (too much fertilizer)"},
+		{"fertilizer too low", map[string]interface{}{"soil_ec": 200.0}, "❌ **Fertilizer Too Low**: Current low fertilizer level, needs medium (too little fertilizer)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := compareConditions(details, tt.conditions, true, numberPrecision{}, true)
+			if !strings.Contains(analysis, tt.want) {
+				t.Errorf("analysis missing %q:\n%s", tt.want, analysis)
+			}
+			if strings.Contains(analysis, "lux") || strings.Contains(analysis, "µS/cm") {
+				t.Errorf("beginner analysis should not mention lux or µS/cm:\n%s", analysis)
+			}
+		})
+	}
+}
+
+func TestBeginnerMode_Handlers(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera",
+			MinLightLux: 1500, MaxLightLux: 20000, MinSoilEC: 350, MaxSoilEC: 2000},
+	}})
+
+	call := func(handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error), args map[string]interface{}) string {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := handler(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return resultText(t, result)
+	}
+	summary := func(args map[string]interface{}) string {
+		args["pid"] = "monstera deliciosa"
+		return call(srv.handleGetCareSummary, args)
+	}
+
+	if text := summary(map[string]interface{}{}); !strings.Contains(text, "µS/cm") {
+		t.Errorf("beginner mode off by default should keep units:\n%s", text)
+	}
+	if text := summary(map[string]interface{}{"beginner_mode": true}); strings.Contains(text, "µS/cm") || strings.Contains(text, "lux") {
+		t.Errorf("per-call beginner mode should remove lux and µS/cm:\n%s", text)
+	}
+
+	// compare_conditions takes the flag too
+	conditions := map[string]interface{}{"pid": "monstera deliciosa", "current_conditions": map[string]interface{}{"light_lux": 500.0, "soil_ec": 2400.0}}
+	conditions["beginner_mode"] = true
+	if text := call(srv.handleCompareConditions, conditions); !strings.Contains(text, "not enough light") || strings.Contains(text, "µS/cm") {
+		t.Errorf("beginner compare_conditions should use plain-language levels:\n%s", text)
+	}
+
+	srv.config.BeginnerMode = true
+	if text := summary(map[string]interface{}{"beginner_mode": false}); !strings.Contains(text, "µS/cm") {
+		t.Errorf("per-call false should override the server setting:\n%s", text)
+	}
+	if text := summary(map[string]interface{}{}); !strings.Contains(text, "medium (regular feeding") {
+		t.Errorf("server-wide beginner mode should apply:\n%s", text)
+	}
+	if text := summary(map[string]interface{}{"format": "json"}); !strings.Contains(text, `"unit": "µS/cm"`) {
+		t.Errorf("JSON results should not be simplified:\n%s", text)
+	}
+}
+
+func TestBeginnerMode_OnlyPlainLanguageTools(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MinSoilEC: 350, MaxSoilEC: 2000},
+	}})
+	srv.config.BeginnerMode = true
+	mcpServer := server.NewMCPServer("test", "test")
+	if err := srv.registerTools(mcpServer); err != nil {
+		t.Fatalf("registerTools() error = %v", err)
+	}
+
+	for name, tool := range mcpServer.ListTools() {
+		_, has := tool.Tool.InputSchema.Properties[beginnerModeParam]
+		if has != beginnerModeTools[name] {
+			t.Errorf("%s: beginner_mode parameter = %v, want %v", name, has, beginnerModeTools[name])
+		}
+	}
+
+	// Other tools keep their wording even with beginner mode on server-wide
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	reading := func(days int, value float64) map[string]interface{} {
+		return map[string]interface{}{"timestamp": start.AddDate(0, 0, days).Format(time.RFC3339), "value": value}
+	}
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "ec_drift_advice"
+	request.Params.Arguments = map[string]interface{}{
+		"pid":      "monstera deliciosa",
+		"readings": []interface{}{reading(0, 1200), reading(7, 1500), reading(14, 1800)},
+	}
+	result, err := mcpServer.GetTool("ec_drift_advice").Handler(context.Background(), request)
+	if err != nil {
+		t.Fatalf("ec_drift_advice error = %v", err)
+	}
+	if text := resultText(t, result); !strings.Contains(text, "# EC Drift for Monstera") || !strings.Contains(text, "µS/cm") {
+		t.Errorf("ec_drift_advice output should be unchanged:\n%s", text)
+	}
+}
@@ -0,0 +1,275 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// careBundleFormat names the shareable care bundle; careBundleVersion is bumped whenever a
+// field is renamed, removed or changes meaning. Additive, optional fields don't bump it,
+// and import_bundle ignores fields it doesn't know.
+const (
+	careBundleFormat  = "openplantbook-care-bundle"
+	careBundleVersion = "1"
+)
+
+// careBundleVersions lists the bundle versions import_bundle understands
+var careBundleVersions = []string{careBundleVersion}
+
+// Bundle origins: fetched from OpenPlantbook, or a profile imported from another bundle
+const (
+	bundleOriginOpenPlantbook = "openplantbook"
+	bundleOriginImported      = "imported"
+)
+
+// careBundle is a self-contained care profile that another server can import
+type careBundle struct {
+	Format          string            `json:"format"`
+	FormatVersion   string            `json:"format_version"`
+	ExportedAt      string            `json:"exported_at"`
+	Plant           passportIdentity  `json:"plant"`
+	Care            passportCare      `json:"care"`
+	Interpretations map[string]string `json:"interpretations,omitempty"`
+	ImageURL        string            `json:"image_url,omitempty"`
+	Source          careBundleSource  `json:"source"`
+}
+
+// careBundleSource records where the profile came from
+type careBundleSource struct {
+	Name   string `json:"name"`
+	URL    string `json:"url"`
+	Origin string `json:"origin"` // openplantbook, or imported when re-exporting an import
+}
+
+// importedProfiles holds care profiles loaded with import_bundle. They take precedence
+// over the API for their pid, server-wide, until the server restarts.
+type importedProfiles struct {
+	mu       sync.Mutex
+	profiles map[string]*openplantbook.PlantDetails // lowercase pid -> details
+}
+
+// set stores an imported profile
+func (p *importedProfiles) set(details *openplantbook.PlantDetails) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.profiles == nil {
+		p.profiles = make(map[string]*openplantbook.PlantDetails)
+	}
+	p.profiles[strings.ToLower(details.PID)] = details
+}
+
+// get returns a copy of the imported profile for pid
+func (p *importedProfiles) get(pid string) (*openplantbook.PlantDetails, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	details, ok := p.profiles[strings.ToLower(pid)]
+	if !ok {
+		return nil, false
+	}
+	clone := *details
+	return &clone, true
+}
+
+// buildCareBundle assembles a bundle from plant details
+func buildCareBundle(details *openplantbook.PlantDetails, imported bool, exportedAt time.Time) careBundle {
+	passport := buildPlantPassport(details, exportedAt)
+	origin := bundleOriginOpenPlantbook
+	if imported {
+		origin = bundleOriginImported
+	}
+	return careBundle{
+		Format:          careBundleFormat,
+		FormatVersion:   careBundleVersion,
+		ExportedAt:      passport.GeneratedAt,
+		Plant:           passport.Plant,
+		Care:            passport.Care,
+		Interpretations: passport.Guidance,
+		ImageURL:        details.ImageURL,
+		Source:          careBundleSource{Name: dataSourceName, URL: dataSourceURL, Origin: origin},
+	}
+}
+
+// parseCareBundle decodes a bundle given as an object or a JSON string
+func parseCareBundle(raw interface{}) (careBundle, error) {
+	var data []byte
+	switch v := raw.(type) {
+	case string:
+		data = []byte(v)
+	case map[string]interface{}:
+		data, _ = json.Marshal(v)
+	default:
+		return careBundle{}, fmt.Errorf("bundle parameter is required and must be an export_bundle object or its JSON text")
+	}
+
+	var bundle careBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return careBundle{}, fmt.Errorf("bundle is not valid JSON: %v", err)
+	}
+	return bundle, validateCareBundle(bundle)
+}
+
+// validateCareBundle rejects bundles this server can't safely import
+func validateCareBundle(b careBundle) error {
+	if b.Format != careBundleFormat {
+		return fmt.Errorf("bundle format %q is not supported; expected %q", b.Format, careBundleFormat)
+	}
+	if !containsString(careBundleVersions, b.FormatVersion) {
+		return fmt.Errorf("bundle format_version %q is not supported; this server reads: %s", b.FormatVersion, strings.Join(careBundleVersions, ", "))
+	}
+	if strings.TrimSpace(b.Plant.PID) == "" {
+		return fmt.Errorf("bundle plant.pid is required")
+	}
+	if isPinToken(b.Plant.PID) {
+		return fmt.Errorf("bundle plant.pid %q is a pin token, not a pid", b.Plant.PID)
+	}
+
+	ranges := []struct {
+		name string
+		r    *passportRange
+	}{
+		{"light_lux", b.Care.LightLux},
+		{"temperature_c", b.Care.TemperatureC},
+		{"humidity_pct", b.Care.HumidityPct},
+		{"soil_moisture_pct", b.Care.SoilMoisturePct},
+		{"soil_ec_us_cm", b.Care.SoilECUsCm},
+	}
+	present := 0
+	for _, c := range ranges {
+		if c.r == nil {
+			continue
+		}
+		present++
+		if c.r.Min > c.r.Max || c.r.Max <= 0 {
+			return fmt.Errorf("bundle care.%s must have min <= max and a positive max", c.name)
+		}
+		if c.name != "temperature_c" && c.r.Min < 0 {
+			return fmt.Errorf("bundle care.%s cannot be negative", c.name)
+		}
+	}
+	for _, c := range ranges[2:4] {
+		if c.r != nil && c.r.Max > 100 {
+			return fmt.Errorf("bundle care.%s is a percentage and cannot exceed 100", c.name)
+		}
+	}
+	if present == 0 {
+		return fmt.Errorf("bundle has no care ranges")
+	}
+	return nil
+}
+
+// containsString reports whether list contains s
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// bundleDetails converts a validated bundle back into plant details
+func bundleDetails(b careBundle) *openplantbook.PlantDetails {
+	details := &openplantbook.PlantDetails{
+		PID:        b.Plant.PID,
+		DisplayPID: b.Plant.DisplayPID,
+		Alias:      b.Plant.Alias,
+		Category:   b.Plant.Category,
+		ImageURL:   b.ImageURL,
+	}
+	if details.DisplayPID == "" {
+		details.DisplayPID = details.PID
+	}
+
+	whole := func(v float64) int { return int(math.Round(v)) }
+	if r := b.Care.LightLux; r != nil {
+		details.MinLightLux, details.MaxLightLux = whole(r.Min), whole(r.Max)
+	}
+	if r := b.Care.TemperatureC; r != nil {
+		details.MinTemp, details.MaxTemp = r.Min, r.Max
+	}
+	if r := b.Care.HumidityPct; r != nil {
+		details.MinEnvHumid, details.MaxEnvHumid = whole(r.Min), whole(r.Max)
+	}
+	if r := b.Care.SoilMoisturePct; r != nil {
+		details.MinSoilMoist, details.MaxSoilMoist = whole(r.Min), whole(r.Max)
+	}
+	if r := b.Care.SoilECUsCm; r != nil {
+		details.MinSoilEC, details.MaxSoilEC = whole(r.Min), whole(r.Max)
+	}
+	return details
+}
+
+// handleExportBundle handles the export_bundle tool
+func (s *Server) handleExportBundle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "export_bundle")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	language := request.GetString("language", "")
+
+	logger.Info("exporting care bundle", "pid", pid, "language", language)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, language)
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	_, imported := s.imported.get(details.PID)
+	data, err := json.MarshalIndent(buildCareBundle(details, imported, time.Now()), "", "  ")
+	if err != nil {
+		logger.Error("marshal bundle failed", "error", err)
+		return mcp.NewToolResultError("failed to format care bundle"), nil
+	}
+
+	logger.Info("care bundle exported", "pid", details.PID, "imported", imported)
+
+	return mcp.NewToolResultText(string(data)), nil
+}
+
+// handleImportBundle handles the import_bundle tool
+func (s *Server) handleImportBundle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "import_bundle")
+
+	// Extract parameters
+	bundle, err := parseCareBundle(request.GetArguments()["bundle"])
+	if err != nil {
+		logger.Warn("invalid bundle", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	details := bundleDetails(bundle)
+	_, replaced := s.imported.get(details.PID)
+	s.imported.set(details)
+
+	logger.Info("care bundle imported", "pid", details.PID, "replaced", replaced)
+
+	output := fmt.Sprintf("# Imported %s (%s)\n\n", details.Alias, details.DisplayPID)
+	if replaced {
+		output += "Replaced the profile imported earlier for this pid.\n\n"
+	}
+	output += fmt.Sprintf("Tools called with pid `%s` now use this care profile instead of OpenPlantbook data until the server restarts.\n", details.PID)
+	return mcp.NewToolResultText(output), nil
+}
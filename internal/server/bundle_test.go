@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestCareBundle_RoundTrip(t *testing.T) {
+	exporter := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {
+			PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera", Category: "Araceae",
+			MinLightLux: 1500, MaxLightLux: 15000, MinTemp: 18, MaxTemp: 27,
+			MinEnvHumid: 50, MaxEnvHumid: 80, MinSoilMoist: 30, MaxSoilMoist: 60,
+		},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+	result, err := exporter.handleExportBundle(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("export failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+
+	var bundle careBundle
+	if err := json.Unmarshal([]byte(text), &bundle); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if bundle.Format != careBundleFormat || bundle.FormatVersion != careBundleVersion || bundle.Source.Origin != bundleOriginOpenPlantbook {
+		t.Errorf("unexpected bundle header: %+v", bundle)
+	}
+	if bundle.Care.SoilECUsCm != nil {
+		t.Error("expected ranges without data to be omitted")
+	}
+
+	// A second server with no API data imports the bundle as JSON text
+	importer := newTestServer(t, &fakeClient{})
+	request.Params.Arguments = map[string]interface{}{"bundle": text}
+	result, err = importer.handleImportBundle(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("import failed: %v %s", err, resultText(t, result))
+	}
+
+	details, err := importer.getPlantDetails(context.Background(), "Monstera Deliciosa", "")
+	if err != nil {
+		t.Fatalf("expected the imported profile, got %v", err)
+	}
+	if details.MaxLightLux != 15000 || details.MinTemp != 18 || details.MaxSoilMoist != 60 {
+		t.Errorf("imported details = %+v", details)
+	}
+
+	// Re-exporting an import marks its origin
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+	result, _ = importer.handleExportBundle(context.Background(), request)
+	if !strings.Contains(resultText(t, result), `"origin": "imported"`) {
+		t.Errorf("expected imported origin:\n%s", resultText(t, result))
+	}
+}
+
+func TestParseCareBundle_Rejects(t *testing.T) {
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"format":         careBundleFormat,
+			"format_version": careBundleVersion,
+			"plant":          map[string]interface{}{"pid": "monstera deliciosa"},
+			"care":           map[string]interface{}{"light_lux": map[string]interface{}{"min": 1500.0, "max": 15000.0}},
+		}
+	}
+	if _, err := parseCareBundle(valid()); err != nil {
+		t.Fatalf("valid bundle rejected: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(b map[string]interface{})
+		want   string
+	}{
+		{"wrong format", func(b map[string]interface{}) { b["format"] = "other" }, "format"},
+		{"future version", func(b map[string]interface{}) { b["format_version"] = "2" }, "format_version"},
+		{"missing pid", func(b map[string]interface{}) { b["plant"] = map[string]interface{}{} }, "plant.pid"},
+		{"no ranges", func(b map[string]interface{}) { b["care"] = map[string]interface{}{} }, "no care ranges"},
+		{"inverted range", func(b map[string]interface{}) {
+			b["care"] = map[string]interface{}{"temperature_c": map[string]interface{}{"min": 30.0, "max": 10.0}}
+		}, "temperature_c"},
+		{"humidity over 100", func(b map[string]interface{}) {
+			b["care"] = map[string]interface{}{"humidity_pct": map[string]interface{}{"min": 50.0, "max": 120.0}}
+		}, "humidity_pct"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := valid()
+			tt.mutate(b)
+			if _, err := parseCareBundle(b); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error mentioning %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if _, err := parseCareBundle("{not json"); err == nil {
+		t.Error("expected malformed JSON to be rejected")
+	}
+	if _, err := parseCareBundle(42.0); err == nil {
+		t.Error("expected a non-object bundle to be rejected")
+	}
+}
@@ -0,0 +1,302 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// missingPlantCacheTTL is how long a "not found" answer is remembered. It is shorter than
+// the response TTL so newly added plants become visible without a restart.
+const missingPlantCacheTTL = time.Hour
+
+// defaultCacheMaxEntries bounds each cache unless cache_max_entries says otherwise. It is
+// generous: a details entry is a few kilobytes.
+const defaultCacheMaxEntries = 10000
+
+// cacheMaxEntries returns the configured cache size limit; negative values mean unbounded
+func (s *Server) cacheMaxEntries() int {
+	if s.config.CacheMaxEntries < 0 {
+		return 0
+	}
+	return s.config.CacheMaxEntries
+}
+
+// responseCache is a thread-safe in-memory cache of API responses with a fixed TTL.
+// With maxEntries set, the least recently used entry is evicted to make room.
+type responseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int // 0 means unbounded
+	entries    map[string]*list.Element
+	order      *list.List // most recently used at the front
+	evictions  int64
+	now        func() time.Time
+}
+
+// cacheEntry is a cached value and its expiry
+type cacheEntry struct {
+	key       string
+	value     interface{}
+	fetchedAt time.Time
+	expiresAt time.Time
+}
+
+// cacheStats is a point-in-time view of a cache's size
+type cacheStats struct {
+	Entries    int   `json:"entries"`
+	MaxEntries int   `json:"max_entries,omitempty"`
+	Evictions  int64 `json:"evictions"`
+}
+
+// newResponseCache creates a cache whose entries expire after ttl, holding at most
+// maxEntries entries (0 for no limit)
+func newResponseCache(ttl time.Duration, maxEntries int) *responseCache {
+	return &responseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// get returns a live entry and marks it recently used, dropping it if it has expired
+func (c *responseCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(elem)
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// set stores a value fetched just now; see setFetched
+func (c *responseCache) set(key string, value interface{}) {
+	c.setFetched(key, value, c.now())
+}
+
+// setFetched stores a value fetched at the given time, replacing any existing entry, and
+// evicts the least recently used entries while the cache is over its limit. The entry
+// expires one TTL after it was fetched.
+func (c *responseCache) setFetched(key string, value interface{}, fetchedAt time.Time) {
+	c.store(key, value, fetchedAt, fetchedAt.Add(c.ttl))
+}
+
+// setTTL stores a value fetched just now. A positive ttl, such as the max-age the API
+// sent with it, replaces the cache's TTL for this entry.
+func (c *responseCache) setTTL(key string, value interface{}, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = c.ttl
+	}
+	now := c.now()
+	c.store(key, value, now, now.Add(ttl))
+}
+
+// store adds or replaces an entry and evicts for space; see setFetched
+func (c *responseCache) store(key string, value interface{}, fetchedAt, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{
+		key:       key,
+		value:     value,
+		fetchedAt: fetchedAt,
+		expiresAt: expiresAt,
+	}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+		c.evictions++
+	}
+}
+
+// remove drops an entry; the caller holds the lock
+func (c *responseCache) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+// fetchedAt returns when a live entry was stored. It doesn't count as a use.
+func (c *responseCache) fetchedAt(key string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return time.Time{}, false
+	}
+	entry := elem.Value.(*cacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		return time.Time{}, false
+	}
+	return entry.fetchedAt, true
+}
+
+// stats reports the current size and how many entries were evicted for space
+func (c *responseCache) stats() cacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return cacheStats{Entries: c.order.Len(), MaxEntries: c.maxEntries, Evictions: c.evictions}
+}
+
+// noCacheKey marks a context whose API calls must skip cache lookups
+type noCacheKey struct{}
+
+// withNoCache returns a context that bypasses cache reads; fresh results still refresh the cache
+func withNoCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noCacheKey{}, true)
+}
+
+// cacheBypassed reports whether the context asks to skip cache reads
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(noCacheKey{}).(bool)
+	return bypass
+}
+
+// detailsCacheKey is the cache key for one plant's details in one language
+func detailsCacheKey(pid, language string) string {
+	return fmt.Sprintf("details:%s:%s", pid, language)
+}
+
+// searchCacheKey is the cache key for a search. The query is normalized so searches that
+// differ only in case or spacing share an entry.
+func searchCacheKey(query string, limit int) string {
+	return fmt.Sprintf("search:%s:%d", normalizeName(query), limit)
+}
+
+// fetchDetails returns plant details for a single language, consulting the cache first
+func (s *Server) fetchDetails(ctx context.Context, pid, language string) (*openplantbook.PlantDetails, error) {
+	key := detailsCacheKey(pid, language)
+
+	if s.cache != nil && !cacheBypassed(ctx) {
+		if cached, ok := s.cache.get(key); ok {
+			s.logger.Debug("cache hit", "key", key)
+			s.usage.detailCacheHits.Add(1)
+			details := cached.(openplantbook.PlantDetails)
+			return &details, nil
+		}
+		s.logger.Debug("cache miss", "key", key)
+	}
+
+	// A persisted copy warms the in-memory cache, keeping its original fetch time
+	if s.fileCache != nil && !cacheBypassed(ctx) {
+		if details, fetchedAt, expiresAt, ok := s.fileCache.get(pid, language); ok {
+			s.logger.Debug("file cache hit", "key", key)
+			s.usage.detailCacheHits.Add(1)
+			if s.cache != nil {
+				s.cache.store(key, details, fetchedAt, expiresAt)
+			}
+			return &details, nil
+		}
+	}
+
+	if s.missingCache != nil && !cacheBypassed(ctx) {
+		if cached, ok := s.missingCache.get(key); ok {
+			s.logger.Debug("negative cache hit", "key", key)
+			s.usage.detailCacheHits.Add(1)
+			return nil, cached.(error)
+		}
+	}
+
+	client, err := s.apiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var details *openplantbook.PlantDetails
+	callCtx, freshness := withFreshness(ctx)
+	err = s.withRetry(ctx, "get_plant_details", func() error {
+		var err error
+		details, err = client.GetPlantDetails(callCtx, pid, &openplantbook.DetailOptions{
+			Language: language,
+		})
+		s.usage.recordCall(&s.usage.detailCalls, err)
+		return err
+	})
+	if err != nil {
+		// Only "not found" is remembered; transient failures are retried next time
+		if s.missingCache != nil && errors.Is(err, openplantbook.ErrNotFound) {
+			s.missingCache.set(key, err)
+		}
+		return nil, err
+	}
+
+	// Cache a copy so callers can't mutate the cached entry, for as long as the API allows
+	ttl, cacheable := cacheTTL(freshness)
+	if s.cache != nil && cacheable {
+		s.cache.setTTL(key, *details, ttl)
+	}
+	if s.fileCache != nil && cacheable {
+		if err := s.fileCache.setTTL(pid, language, *details, ttl); err != nil {
+			// The API answer is still good; the next launch just starts colder
+			s.logger.Warn("file cache write failed", "pid", pid, "error", err)
+		}
+	}
+	return details, nil
+}
+
+// searchPlants runs a plant search, consulting the cache first
+func (s *Server) searchPlants(ctx context.Context, query string, opts *openplantbook.SearchOptions) ([]openplantbook.PlantSearchResult, error) {
+	results, _, _, err := s.searchPlantsWithSnapshot(ctx, query, opts)
+	return results, err
+}
+
+// searchPlantsWithSnapshot is searchPlants that also records every upstream result in the
+// search history and returns the snapshot it replaced, if any. Cache hits leave the
+// history alone and report no prior snapshot.
+func (s *Server) searchPlantsWithSnapshot(ctx context.Context, query string, opts *openplantbook.SearchOptions) ([]openplantbook.PlantSearchResult, searchSnapshot, bool, error) {
+	key := searchCacheKey(query, opts.Limit)
+
+	if s.cache != nil && !cacheBypassed(ctx) {
+		if cached, ok := s.cache.get(key); ok {
+			s.logger.Debug("cache hit", "key", key)
+			s.usage.searchCacheHits.Add(1)
+			return append([]openplantbook.PlantSearchResult(nil), cached.([]openplantbook.PlantSearchResult)...), searchSnapshot{}, false, nil
+		}
+		s.logger.Debug("cache miss", "key", key)
+	}
+
+	client, err := s.apiClient()
+	if err != nil {
+		return nil, searchSnapshot{}, false, err
+	}
+
+	var results []openplantbook.PlantSearchResult
+	callCtx, freshness := withFreshness(ctx)
+	err = s.withRetry(ctx, "search_plants", func() error {
+		var err error
+		results, err = client.SearchPlants(callCtx, query, opts)
+		s.usage.recordCall(&s.usage.searchCalls, err)
+		return err
+	})
+	if err != nil {
+		return nil, searchSnapshot{}, false, err
+	}
+
+	if ttl, cacheable := cacheTTL(freshness); s.cache != nil && cacheable {
+		s.cache.setTTL(key, append([]openplantbook.PlantSearchResult(nil), results...), ttl)
+	}
+	previous, ok := s.searchHistory.record(searchSnapshotKey(query, opts.Limit), results, time.Now())
+	return results, previous, ok, nil
+}
@@ -0,0 +1,177 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// validatorCacheTTL is how long an ETag or Last-Modified validator and its body are kept
+// for conditional requests. It outlives response cache entries on purpose: revalidating
+// an expired entry is where a 304 saves the download.
+const validatorCacheTTL = 7 * 24 * time.Hour
+
+// responseFreshness is what the caching headers of an API response allow. The HTTP
+// transport fills it in for the call whose context carries it.
+type responseFreshness struct {
+	maxAge    time.Duration
+	hasMaxAge bool
+	noStore   bool
+}
+
+// freshnessKey is the context key for the responseFreshness of one API call
+type freshnessKey struct{}
+
+// withFreshness returns a context whose API responses record their caching headers in
+// the returned responseFreshness. With retries, the last response wins.
+func withFreshness(ctx context.Context) (context.Context, *responseFreshness) {
+	f := &responseFreshness{}
+	return context.WithValue(ctx, freshnessKey{}, f), f
+}
+
+// parseCacheControl reads the directives that matter to a private client cache:
+// no-store forbids caching, no-cache allows storing only for revalidation, and max-age
+// gives the freshness lifetime
+func parseCacheControl(header string) responseFreshness {
+	var f responseFreshness
+	for _, directive := range strings.Split(header, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store":
+			f.noStore = true
+		case "no-cache":
+			f.maxAge, f.hasMaxAge = 0, true
+		case "max-age":
+			seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+			if err != nil || seconds < 0 {
+				continue
+			}
+			// no-cache wins over any max-age
+			if !f.hasMaxAge || f.maxAge > 0 {
+				f.maxAge, f.hasMaxAge = time.Duration(seconds)*time.Second, true
+			}
+		}
+	}
+	return f
+}
+
+// cacheTTL is how long a response may be cached: its max-age when the API sent one, or 0
+// for the cache's own TTL (CacheTTL). It returns false when the response must not be
+// cached at all: no-store, no-cache or max-age=0.
+func cacheTTL(f *responseFreshness) (time.Duration, bool) {
+	switch {
+	case f.noStore:
+		return 0, false
+	case f.hasMaxAge:
+		return f.maxAge, f.maxAge > 0
+	}
+	return 0, true
+}
+
+// storedResponse is a response body kept with the validators that can revalidate it
+type storedResponse struct {
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+// cacheHeaderTransport records the caching headers of API responses and, with
+// validators set, makes GETs conditional: a stored ETag or Last-Modified is sent as
+// If-None-Match or If-Modified-Since, and a 304 is answered from the stored body so the
+// SDK decodes it like any other response
+type cacheHeaderTransport struct {
+	next       http.RoundTripper
+	validators *responseCache // keyed by URL; nil disables conditional requests
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *cacheHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	var stored *storedResponse
+	if t.validators != nil {
+		if cached, ok := t.validators.get(key); ok {
+			stored = cached.(*storedResponse)
+			req = req.Clone(req.Context())
+			if stored.etag != "" {
+				req.Header.Set("If-None-Match", stored.etag)
+			}
+			if stored.lastModified != "" {
+				req.Header.Set("If-Modified-Since", stored.lastModified)
+			}
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	freshness := parseCacheControl(resp.Header.Get("Cache-Control"))
+	if f, ok := req.Context().Value(freshnessKey{}).(*responseFreshness); ok {
+		*f = freshness
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && stored != nil:
+		resp.Body.Close()
+		resp.StatusCode, resp.Status = http.StatusOK, "200 OK"
+		resp.Body = io.NopCloser(bytes.NewReader(stored.body))
+		resp.ContentLength = int64(len(stored.body))
+		t.validators.set(key, stored)
+	case resp.StatusCode == http.StatusOK && t.validators != nil:
+		etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+		if freshness.noStore || (etag == "" && lastModified == "") {
+			break
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		t.validators.set(key, &storedResponse{etag: etag, lastModified: lastModified, body: body})
+	}
+	return resp, nil
+}
+
+// apiKeyTransport adds API key authentication to requests, as the SDK does when it
+// builds its own HTTP client
+type apiKeyTransport struct {
+	apiKey string
+	next   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *apiKeyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Token "+t.apiKey)
+	return t.next.RoundTrip(req)
+}
+
+// newAPIHTTPClient returns an HTTP client authenticated like the SDK's own, sending
+// requests through base. The SDK skips its auth setup when given a client, so the
+// server has to provide it to see response headers.
+func newAPIHTTPClient(config *Config, base http.RoundTripper) *http.Client {
+	if config.APIKey != "" {
+		return &http.Client{Transport: &apiKeyTransport{apiKey: config.APIKey, next: base}}
+	}
+	oauthConfig := &clientcredentials.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		TokenURL:     openplantbook.DefaultBaseURL + "/token/",
+	}
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: base})
+	return oauthConfig.Client(ctx)
+}
@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestParseCacheControl(t *testing.T) {
+	tests := []struct {
+		header  string
+		wantTTL time.Duration
+		wantOK  bool
+	}{
+		{"", 0, true},
+		{"public, max-age=600", 10 * time.Minute, true},
+		{`max-age="60"`, time.Minute, true},
+		{"max-age=0", 0, false},
+		{"no-store", 0, false},
+		{"max-age=600, no-store", 0, false},
+		{"no-cache, max-age=600", 0, false},
+		{"max-age=600, no-cache", 0, false},
+		{"max-age=bogus", 0, true},
+		{"private", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			f := parseCacheControl(tt.header)
+			if ttl, ok := cacheTTL(&f); ttl != tt.wantTTL || ok != tt.wantOK {
+				t.Errorf("cacheTTL(%q) = %v, %v; want %v, %v", tt.header, ttl, ok, tt.wantTTL, tt.wantOK)
+			}
+		})
+	}
+}
+
+// headerAPI is a fake OpenPlantbook that answers plant details with the given caching
+// headers, honors If-None-Match and records what it was asked
+type headerAPI struct {
+	cacheControl string
+	etag         string
+
+	mu          sync.Mutex
+	requests    int
+	notModified int
+	auth        string
+}
+
+func (a *headerAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.requests++
+	a.auth = r.Header.Get("Authorization")
+	if a.cacheControl != "" {
+		w.Header().Set("Cache-Control", a.cacheControl)
+	}
+	if a.etag != "" {
+		w.Header().Set("ETag", a.etag)
+		if r.Header.Get("If-None-Match") == a.etag {
+			a.notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	io.WriteString(w, `{"pid":"monstera deliciosa","display_pid":"Monstera deliciosa","alias":"Monstera","max_temp":30}`)
+}
+
+// newHeaderTestServer builds a Server whose SDK client talks to api through the same
+// HTTP client New installs
+func newHeaderTestServer(t *testing.T, api *headerAPI) (*Server, *time.Time) {
+	t.Helper()
+	ts := httptest.NewServer(api)
+	t.Cleanup(ts.Close)
+
+	srv := newTestServer(t, nil)
+	transport := &cacheHeaderTransport{next: http.DefaultTransport, validators: newResponseCache(validatorCacheTTL, 0)}
+	client, err := openplantbook.New(
+		openplantbook.WithBaseURL(ts.URL),
+		openplantbook.WithHTTPClient(newAPIHTTPClient(srv.config, transport)),
+		openplantbook.WithCache(openplantbook.NewNoOpCache()),
+		openplantbook.DisableRateLimit(),
+	)
+	if err != nil {
+		t.Fatalf("openplantbook.New() error = %v", err)
+	}
+	srv.client = client
+
+	now := time.Now()
+	srv.cache = newResponseCache(24*time.Hour, 0)
+	srv.cache.now = func() time.Time { return now }
+	return srv, &now
+}
+
+func TestFetchDetails_CacheHeaders(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheControl string
+		advance      time.Duration
+		wantRequests int
+	}{
+		{"max-age still fresh", "max-age=60", 59 * time.Second, 1},
+		{"max-age expired", "max-age=60", 61 * time.Second, 2},
+		{"no headers use CacheTTL", "", 23 * time.Hour, 1},
+		{"no-store is never cached", "no-store", 0, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &headerAPI{cacheControl: tt.cacheControl}
+			srv, now := newHeaderTestServer(t, api)
+			ctx := context.Background()
+
+			for range 2 {
+				details, err := srv.fetchDetails(ctx, "monstera deliciosa", "en")
+				if err != nil {
+					t.Fatalf("fetchDetails() error = %v", err)
+				}
+				if details.Alias != "Monstera" {
+					t.Fatalf("details = %+v, want Monstera", details)
+				}
+				*now = now.Add(tt.advance)
+			}
+			if api.requests != tt.wantRequests {
+				t.Errorf("API requests = %d, want %d", api.requests, tt.wantRequests)
+			}
+			if api.auth != "Token test-key" {
+				t.Errorf("Authorization = %q, want the API key", api.auth)
+			}
+		})
+	}
+}
+
+func TestFetchDetails_ConditionalRequest(t *testing.T) {
+	api := &headerAPI{cacheControl: "max-age=60", etag: `"v1"`}
+	srv, now := newHeaderTestServer(t, api)
+	ctx := context.Background()
+
+	if _, err := srv.fetchDetails(ctx, "monstera deliciosa", "en"); err != nil {
+		t.Fatalf("first fetch error = %v", err)
+	}
+
+	// Once the entry expires the refetch revalidates, and the 304 reuses the stored body
+	*now = now.Add(2 * time.Minute)
+	details, err := srv.fetchDetails(ctx, "monstera deliciosa", "en")
+	if err != nil {
+		t.Fatalf("revalidated fetch error = %v", err)
+	}
+	if details.Alias != "Monstera" || details.MaxTemp != 30 {
+		t.Errorf("details = %+v, want the stored Monstera details", details)
+	}
+	if api.requests != 2 || api.notModified != 1 {
+		t.Errorf("requests = %d, 304s = %d; want 2 and 1", api.requests, api.notModified)
+	}
+
+	// The revalidated entry is cached again for its max-age
+	if _, err := srv.fetchDetails(ctx, "monstera deliciosa", "en"); err != nil {
+		t.Fatalf("cached fetch error = %v", err)
+	}
+	if api.requests != 2 {
+		t.Errorf("requests = %d, want the revalidated entry served from cache", api.requests)
+	}
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestResponseCache_Expiry(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	cache := newResponseCache(time.Hour, 0)
+	cache.now = func() time.Time { return now }
+
+	cache.set("key", "value")
+	if v, ok := cache.get("key"); !ok || v != "value" {
+		t.Fatalf("get() = %v, %v; want value, true", v, ok)
+	}
+
+	now = now.Add(59 * time.Minute)
+	if _, ok := cache.get("key"); !ok {
+		t.Error("entry should still be live before the TTL")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.get("key"); ok {
+		t.Error("entry should expire at the TTL")
+	}
+}
+
+func TestResponseCache_LRUEviction(t *testing.T) {
+	cache := newResponseCache(time.Hour, 3)
+	cache.set("a", 1)
+	cache.set("b", 2)
+	cache.set("c", 3)
+
+	// Reading a makes b the least recently used
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("a should be cached")
+	}
+	cache.set("d", 4)
+	if _, ok := cache.get("b"); ok {
+		t.Error("b should have been evicted as least recently used")
+	}
+
+	// Overwriting c refreshes it, so a is next out
+	cache.set("c", 30)
+	cache.set("e", 5)
+	if _, ok := cache.get("a"); ok {
+		t.Error("a should have been evicted after c was refreshed")
+	}
+	for key, want := range map[string]int{"c": 30, "d": 4, "e": 5} {
+		if v, ok := cache.get(key); !ok || v != want {
+			t.Errorf("get(%q) = %v, %v; want %d, true", key, v, ok, want)
+		}
+	}
+
+	stats := cache.stats()
+	if stats.Entries != 3 || stats.MaxEntries != 3 || stats.Evictions != 2 {
+		t.Errorf("stats = %+v, want 3 entries, max 3, 2 evictions", stats)
+	}
+}
+
+func TestResponseCache_Unbounded(t *testing.T) {
+	cache := newResponseCache(time.Hour, 0)
+	for i := 0; i < 100; i++ {
+		cache.set(fmt.Sprint(i), i)
+	}
+	if stats := cache.stats(); stats.Entries != 100 || stats.Evictions != 0 {
+		t.Errorf("stats = %+v, want 100 entries and no evictions", stats)
+	}
+}
+
+func TestResponseCache_ExpiryIsNotEviction(t *testing.T) {
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	cache := newResponseCache(time.Hour, 2)
+	cache.now = func() time.Time { return now }
+
+	cache.set("a", 1)
+	now = now.Add(2 * time.Hour)
+	if _, ok := cache.get("a"); ok {
+		t.Fatal("a should have expired")
+	}
+	if stats := cache.stats(); stats.Entries != 0 || stats.Evictions != 0 {
+		t.Errorf("stats = %+v, want an empty cache with no evictions", stats)
+	}
+}
+
+func TestServer_NoCacheBypass(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("get_plant_care", func(t *testing.T) {
+		client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			"monstera deliciosa|": {PID: "monstera deliciosa", Alias: "old alias"},
+		}}
+		srv := newTestServer(t, client)
+		srv.cache = newResponseCache(time.Hour, 0)
+
+		call := func(args map[string]interface{}) {
+			t.Helper()
+			result, err := srv.handleGetPlantCare(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Name: "get_plant_care", Arguments: args},
+			})
+			if err != nil || result.IsError {
+				t.Fatalf("handleGetPlantCare() = %v, %v", result, err)
+			}
+		}
+
+		call(map[string]interface{}{"pid": "monstera deliciosa"})
+		call(map[string]interface{}{"pid": "monstera deliciosa"})
+		if len(client.detailCalls) != 1 {
+			t.Fatalf("expected cached second call, got %d SDK calls", len(client.detailCalls))
+		}
+
+		// Upstream changes; a bypassed call must refetch and refresh the cache
+		client.details["monstera deliciosa|"] = &openplantbook.PlantDetails{PID: "monstera deliciosa", Alias: "new alias"}
+		call(map[string]interface{}{"pid": "monstera deliciosa", "no_cache": true})
+		if len(client.detailCalls) != 2 {
+			t.Fatalf("expected no_cache to refetch, got %d SDK calls", len(client.detailCalls))
+		}
+
+		details, err := srv.fetchDetails(ctx, "monstera deliciosa", "en")
+		if err != nil {
+			t.Fatalf("fetchDetails() error = %v", err)
+		}
+		if details.Alias != "new alias" {
+			t.Errorf("cache should hold the fresh result, got alias %q", details.Alias)
+		}
+		if len(client.detailCalls) != 2 {
+			t.Errorf("expected refreshed entry to be served from cache, got %d SDK calls", len(client.detailCalls))
+		}
+	})
+
+	t.Run("search_plants", func(t *testing.T) {
+		client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{
+			"monstera": {{PID: "monstera deliciosa"}},
+		}}
+		srv := newTestServer(t, client)
+		srv.cache = newResponseCache(time.Hour, 0)
+
+		for _, args := range []map[string]interface{}{
+			{"query": "monstera"},
+			{"query": "monstera"},
+			{"query": "monstera", "no_cache": true},
+		} {
+			result, err := srv.handleSearchPlants(ctx, mcp.CallToolRequest{
+				Params: mcp.CallToolParams{Name: "search_plants", Arguments: args},
+			})
+			if err != nil || result.IsError {
+				t.Fatalf("handleSearchPlants() = %v, %v", result, err)
+			}
+		}
+
+		if len(client.searchCalls) != 2 {
+			t.Errorf("expected 2 SDK searches (initial + bypass), got %d", len(client.searchCalls))
+		}
+	})
+}
+
+func TestServer_SearchCacheNormalizesQuery(t *testing.T) {
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{
+		"Monstera": {{PID: "monstera deliciosa"}},
+	}}
+	srv := newTestServer(t, client)
+	srv.cache = newResponseCache(time.Hour, 0)
+
+	for _, query := range []string{"Monstera", "  monstera ", "MONSTERA"} {
+		result, err := srv.handleSearchPlants(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "search_plants", Arguments: map[string]interface{}{"query": query}},
+		})
+		if err != nil || result.IsError {
+			t.Fatalf("handleSearchPlants(%q) = %v, %v", query, result, err)
+		}
+	}
+
+	if len(client.searchCalls) != 1 {
+		t.Errorf("expected one SDK search for queries differing only in case and spacing, got %d", len(client.searchCalls))
+	}
+	if _, ok := srv.cache.get(searchCacheKey("monstera", searchFetchLimit(0, defaultSearchLimit))); !ok {
+		t.Error("expected the normalized search key to be cached")
+	}
+}
+
+func TestServer_DetailsCacheSharedAcrossTools(t *testing.T) {
+	plant := &openplantbook.PlantDetails{
+		PID: "monstera deliciosa", Alias: "Monstera",
+		MinSoilMoist: 15, MaxSoilMoist: 60, MinTemp: 12, MaxTemp: 32,
+		MinLightLux: 1500, MaxLightLux: 20000, MinEnvHumid: 30, MaxEnvHumid: 80,
+	}
+	calls := []struct {
+		name    string
+		handler func(*Server, context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]interface{}
+	}{
+		{"get_plant_care", (*Server).handleGetPlantCare, map[string]interface{}{"pid": "monstera deliciosa"}},
+		{"get_care_summary", (*Server).handleGetCareSummary, map[string]interface{}{"pid": "monstera deliciosa"}},
+		{"compare_conditions", (*Server).handleCompareConditions, map[string]interface{}{"pid": "monstera deliciosa", "current_conditions": map[string]interface{}{"moisture": 40.0}}},
+	}
+
+	tests := []struct {
+		name      string
+		cache     bool
+		wantCalls int
+	}{
+		{"enabled", true, 1},
+		{"disabled passes through", false, len(calls)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakeClient{details: map[string]*openplantbook.PlantDetails{"monstera deliciosa|en": plant}}
+			srv := newTestServer(t, client)
+			if tt.cache {
+				srv.cache = newResponseCache(time.Hour, 0)
+			}
+
+			for _, call := range calls {
+				result, err := call.handler(srv, context.Background(), mcp.CallToolRequest{
+					Params: mcp.CallToolParams{Name: call.name, Arguments: call.args},
+				})
+				if err != nil || result.IsError {
+					t.Fatalf("%s = %v, %v", call.name, result, err)
+				}
+			}
+
+			if len(client.detailCalls) != tt.wantCalls {
+				t.Errorf("SDK detail calls = %v, want %d", client.detailCalls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// handleCareDiffReport handles the care_diff_report tool
+func (s *Server) handleCareDiffReport(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "care_diff_report")
+
+	// Extract parameters
+	pidA, err := request.RequireString("pid_a")
+	if err != nil {
+		logger.Warn("invalid pid_a parameter", "error", err)
+		return mcp.NewToolResultError("pid_a parameter is required and must be a string"), nil
+	}
+
+	pidB, err := request.RequireString("pid_b")
+	if err != nil {
+		logger.Warn("invalid pid_b parameter", "error", err)
+		return mcp.NewToolResultError("pid_b parameter is required and must be a string"), nil
+	}
+
+	metric := request.GetBool("metric", true)
+
+	logger.Info("generating care diff report", "pid_a", pidA, "pid_b", pidB, "metric", metric)
+
+	// Get plant details for both plants
+	detailsA, err := s.getPlantDetails(ctx, pidA, "")
+	if err != nil {
+		logger.Error("get details failed", "pid", pidA, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details for pid_a %q: %v", pidA, err)), nil
+	}
+
+	detailsB, err := s.getPlantDetails(ctx, pidB, "")
+	if err != nil {
+		logger.Error("get details failed", "pid", pidB, "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details for pid_b %q: %v", pidB, err)), nil
+	}
+
+	for _, d := range []struct {
+		pid     string
+		details *openplantbook.PlantDetails
+	}{{pidA, detailsA}, {pidB, detailsB}} {
+		if !hasCareData(d.details) {
+			logger.Warn("plant has no care data", "pid", d.pid)
+			return mcp.NewToolResultError(noCareDataMessage(d.pid)), nil
+		}
+	}
+
+	// Diff the human-readable summaries
+	report := formatCareDiff(detailsA, detailsB, metric)
+
+	logger.Info("care diff report generated", "pid_a", detailsA.PID, "pid_b", detailsB.PID)
+
+	return mcp.NewToolResultText(report), nil
+}
+
+// formatCareDiff renders a unified-diff-style comparison of two care summaries
+func formatCareDiff(a, b *openplantbook.PlantDetails, metric bool) string {
+	linesA := summaryLines(formatCareSummary(a, metric))
+	linesB := summaryLines(formatCareSummary(b, metric))
+
+	diff := diffLines(linesA, linesB)
+
+	changed := 0
+	for _, line := range diff {
+		if line.op != ' ' {
+			changed++
+		}
+	}
+
+	report := fmt.Sprintf("# Care Diff: %s vs %s\n\n", a.Alias, b.Alias)
+	if changed == 0 {
+		report += "The care summaries are identical.\n"
+		return report
+	}
+
+	report += "Lines prefixed with `-` appear only for the first plant, `+` only for the second.\n\n"
+	report += "```diff\n"
+	report += fmt.Sprintf("--- %s\n", a.DisplayPID)
+	report += fmt.Sprintf("+++ %s\n", b.DisplayPID)
+	for _, line := range diff {
+		report += fmt.Sprintf("%c %s\n", line.op, line.text)
+	}
+	report += "```\n"
+	report += fmt.Sprintf("\n**Summary**: %d line(s) differ between the two care summaries.\n", changed)
+
+	return report
+}
+
+// summaryLines splits a summary into non-empty lines so blank spacing doesn't show up as differences
+func summaryLines(summary string) []string {
+	var lines []string
+	for _, line := range strings.Split(summary, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// diffLine is a single line of diff output; op is ' ', '-' or '+'
+type diffLine struct {
+	op   byte
+	text string
+}
+
+// diffLines computes a line diff using the longest common subsequence
+func diffLines(a, b []string) []diffLine {
+	// lcs[i][j] holds the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diff []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			diff = append(diff, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, diffLine{'-', a[i]})
+			i++
+		default:
+			diff = append(diff, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		diff = append(diff, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		diff = append(diff, diffLine{'+', b[j]})
+	}
+
+	return diff
+}
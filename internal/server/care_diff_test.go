@@ -0,0 +1,75 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        []string
+		b        []string
+		expected string
+	}{
+		{"identical", []string{"x", "y"}, []string{"x", "y"}, " x| y"},
+		{"changed middle", []string{"x", "y", "z"}, []string{"x", "q", "z"}, " x|-y|+q| z"},
+		{"added at end", []string{"x"}, []string{"x", "y"}, " x|+y"},
+		{"removed at start", []string{"x", "y"}, []string{"y"}, "-x| y"},
+		{"both empty", nil, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, line := range diffLines(tt.a, tt.b) {
+				got = append(got, string(line.op)+line.text)
+			}
+			if result := strings.Join(got, "|"); result != tt.expected {
+				t.Errorf("diffLines() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCareDiff(t *testing.T) {
+	a := &openplantbook.PlantDetails{
+		PID: "plant a", DisplayPID: "Plant A", Alias: "A", Category: "Araceae",
+		MinLightLux: 1000, MaxLightLux: 3000, MinTemp: 18, MaxTemp: 27,
+		MinEnvHumid: 60, MaxEnvHumid: 80,
+	}
+	b := &openplantbook.PlantDetails{
+		PID: "plant b", DisplayPID: "Plant B", Alias: "B", Category: "Araceae",
+		MinLightLux: 1000, MaxLightLux: 3000, MinTemp: 10, MaxTemp: 20,
+		MinEnvHumid: 60, MaxEnvHumid: 80,
+	}
+
+	t.Run("metric", func(t *testing.T) {
+		report := formatCareDiff(a, b, true)
+		if !strings.Contains(report, "- **Temperature**: 18.0 - 27.0°C") {
+			t.Errorf("expected removed temperature line, got:\n%s", report)
+		}
+		if !strings.Contains(report, "+ **Temperature**: 10.0 - 20.0°C") {
+			t.Errorf("expected added temperature line, got:\n%s", report)
+		}
+		if !strings.Contains(report, "  **Humidity**: 60 - 80%") {
+			t.Errorf("expected shared humidity line, got:\n%s", report)
+		}
+	})
+
+	t.Run("imperial", func(t *testing.T) {
+		report := formatCareDiff(a, b, false)
+		if !strings.Contains(report, "°F") || strings.Contains(report, "°C") {
+			t.Errorf("expected Fahrenheit-only output, got:\n%s", report)
+		}
+	})
+
+	t.Run("identical", func(t *testing.T) {
+		report := formatCareDiff(a, a, true)
+		if !strings.Contains(report, "identical") {
+			t.Errorf("expected identical notice, got:\n%s", report)
+		}
+	})
+}
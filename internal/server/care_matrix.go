@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// matrixUnits is the column unit per baseline metric key
+var matrixUnits = map[string]string{
+	"light_lux":   "lux",
+	"temperature": "°C",
+	"humidity":    "%",
+	"moisture":    "%",
+	"soil_ec":     "µS/cm",
+}
+
+// careMatrix is the structured form of the care_matrix result. Rows follow the order
+// of the requested pids; plants that failed to load carry an error instead of cells.
+type careMatrix struct {
+	Metrics []string        `json:"metrics"`
+	Rows    []careMatrixRow `json:"rows"`
+
+	// MostDemanding and LeastDemanding are pids; empty when no plant could be scored
+	MostDemanding  string `json:"most_demanding,omitempty"`
+	LeastDemanding string `json:"least_demanding,omitempty"`
+
+	// TightestByMetric is the pid with the narrowest range per metric key
+	TightestByMetric map[string]string `json:"tightest_by_metric,omitempty"`
+}
+
+// careMatrixRow is one plant in the matrix
+type careMatrixRow struct {
+	PID    string                    `json:"pid"`
+	Name   string                    `json:"name,omitempty"`
+	Ranges map[string]*passportRange `json:"ranges,omitempty"` // keyed by metric key; nil when no data
+	Demand float64                   `json:"demand,omitempty"`
+	Error  string                    `json:"error,omitempty"`
+
+	widthRatios map[string]float64
+}
+
+// careDemand scores how demanding a plant is: the mean sensor_recommendation score over
+// its metrics, so tight and unusual ranges both count. False when no metric has data.
+func careDemand(details *openplantbook.PlantDetails, baseline map[string]valueRange) (float64, map[string]float64, bool) {
+	advice := rankSensors(details, baseline)
+	if len(advice) == 0 {
+		return 0, nil, false
+	}
+
+	total := 0.0
+	ratios := make(map[string]float64, len(advice))
+	for _, a := range advice {
+		total += a.score
+		ratios[a.metric.key] = a.widthRatio
+	}
+	return total / float64(len(advice)), ratios, true
+}
+
+// buildCareMatrix lays out fetched plants as matrix rows and picks the summary plants
+func buildCareMatrix(plants []plantFetch, baseline map[string]valueRange) careMatrix {
+	matrix := careMatrix{TightestByMetric: map[string]string{}}
+	for _, m := range baselineMetrics {
+		matrix.Metrics = append(matrix.Metrics, m.key)
+	}
+
+	var most, least *careMatrixRow
+	tightest := map[string]float64{}
+	matrix.Rows = make([]careMatrixRow, len(plants))
+	for i, p := range plants {
+		row := &matrix.Rows[i]
+		row.PID = p.pid
+		if p.err != nil {
+			row.Error = p.err.Error()
+			continue
+		}
+
+		row.Name = p.details.Alias
+		row.Ranges = map[string]*passportRange{}
+		for _, m := range baselineMetrics {
+			if min, max, ok := m.ideal(p.details); ok {
+				row.Ranges[m.key] = &passportRange{Min: min, Max: max}
+			}
+		}
+
+		demand, ratios, ok := careDemand(p.details, baseline)
+		if !ok {
+			continue
+		}
+		row.Demand, row.widthRatios = demand, ratios
+		if most == nil || demand > most.Demand {
+			most = row
+		}
+		if least == nil || demand < least.Demand {
+			least = row
+		}
+		for key, ratio := range ratios {
+			if best, seen := tightest[key]; !seen || ratio < best {
+				tightest[key] = ratio
+				matrix.TightestByMetric[key] = row.PID
+			}
+		}
+	}
+
+	if most != nil {
+		matrix.MostDemanding, matrix.LeastDemanding = most.PID, least.PID
+	}
+	return matrix
+}
+
+// matrixName returns the display name of a pid in the matrix
+func (m careMatrix) matrixName(pid string) string {
+	for _, row := range m.Rows {
+		if row.PID == pid && row.Name != "" {
+			return row.Name
+		}
+	}
+	return pid
+}
+
+// formatCareMatrix renders the matrix as a markdown table with a summary row
+func formatCareMatrix(matrix careMatrix) string {
+	output := "# Care Matrix\n\n"
+
+	header := []string{"Plant"}
+	for _, m := range baselineMetrics {
+		header = append(header, fmt.Sprintf("%s (%s)", m.label, matrixUnits[m.key]))
+	}
+	header = append(header, "Demand")
+	output += "| " + strings.Join(header, " | ") + " |\n"
+	output += "|" + strings.Repeat(" --- |", len(header)) + "\n"
+
+	for _, row := range matrix.Rows {
+		cells := []string{row.PID}
+		if row.Name != "" {
+			cells[0] = fmt.Sprintf("%s (%s)", row.Name, row.PID)
+		}
+		for _, m := range baselineMetrics {
+			switch r := row.Ranges[m.key]; {
+			case row.Error != "":
+				cells = append(cells, "unavailable")
+			case r == nil:
+				cells = append(cells, "—")
+			default:
+				cells = append(cells, fmt.Sprintf("%g - %g", r.Min, r.Max))
+			}
+		}
+		switch {
+		case row.Error != "":
+			cells = append(cells, "unavailable")
+		case row.widthRatios == nil:
+			cells = append(cells, "—")
+		default:
+			cells = append(cells, fmt.Sprintf("%.1f", row.Demand))
+		}
+		output += "| " + strings.Join(cells, " | ") + " |\n"
+	}
+
+	// Summary row: the plant with the tightest range in each column
+	summary := []string{"**Tightest range**"}
+	for _, m := range baselineMetrics {
+		if pid, ok := matrix.TightestByMetric[m.key]; ok {
+			summary = append(summary, matrix.matrixName(pid))
+		} else {
+			summary = append(summary, "—")
+		}
+	}
+	if matrix.MostDemanding != "" {
+		summary = append(summary, matrix.matrixName(matrix.MostDemanding))
+	} else {
+		summary = append(summary, "—")
+	}
+	output += "| " + strings.Join(summary, " | ") + " |\n\n"
+
+	if matrix.MostDemanding != "" {
+		output += fmt.Sprintf("**Most demanding overall**: %s · **Least demanding overall**: %s\n\n",
+			matrix.matrixName(matrix.MostDemanding), matrix.matrixName(matrix.LeastDemanding))
+	}
+	for _, row := range matrix.Rows {
+		if row.Error != "" {
+			output += fmt.Sprintf("- %s could not be loaded: %s\n", row.PID, row.Error)
+		}
+	}
+
+	output += "\n_Demand averages how narrow and how unusual each range is compared to a typical houseplant; higher means fussier._\n"
+	return output
+}
+
+// handleCareMatrix handles the care_matrix tool
+func (s *Server) handleCareMatrix(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "care_matrix")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("building care matrix", "pids", len(pids))
+
+	matrix := buildCareMatrix(s.fetchPlantsConcurrently(ctx, pids), s.baselineProfile())
+
+	logger.Info("care matrix built", "pids", len(pids), "most_demanding", matrix.MostDemanding)
+
+	return mcp.NewToolResultStructured(matrix, formatCareMatrix(matrix)), nil
+}
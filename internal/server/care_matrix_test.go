@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBuildCareMatrix(t *testing.T) {
+	pothos := &openplantbook.PlantDetails{PID: "epipremnum aureum", Alias: "Pothos",
+		MinLightLux: 1000, MaxLightLux: 20000, MinTemp: 12, MaxTemp: 32, MinEnvHumid: 30, MaxEnvHumid: 80,
+		MinSoilMoist: 15, MaxSoilMoist: 60, MinSoilEC: 300, MaxSoilEC: 1500}
+	calathea := &openplantbook.PlantDetails{PID: "calathea orbifolia", Alias: "Calathea",
+		MinLightLux: 2000, MaxLightLux: 6000, MinTemp: 18, MaxTemp: 24, MinEnvHumid: 60, MaxEnvHumid: 75,
+		MinSoilMoist: 40, MaxSoilMoist: 55}
+
+	matrix := buildCareMatrix([]plantFetch{
+		{pid: "epipremnum aureum", details: pothos},
+		{pid: "bogus", err: errors.New("not found")},
+		{pid: "calathea orbifolia", details: calathea},
+	}, defaultBaselineProfile)
+
+	if matrix.MostDemanding != "calathea orbifolia" || matrix.LeastDemanding != "epipremnum aureum" {
+		t.Errorf("most/least = %q/%q, want calathea/pothos", matrix.MostDemanding, matrix.LeastDemanding)
+	}
+	if got := matrix.TightestByMetric["soil_ec"]; got != "epipremnum aureum" {
+		t.Errorf("tightest soil_ec = %q, want the only plant with EC data", got)
+	}
+	if matrix.Rows[1].Error == "" || matrix.Rows[1].Ranges != nil {
+		t.Errorf("failed plant should carry an error and no ranges: %+v", matrix.Rows[1])
+	}
+	if matrix.Rows[2].Ranges["soil_ec"] != nil {
+		t.Error("metric without data should have no range")
+	}
+
+	output := formatCareMatrix(matrix)
+	for _, want := range []string{
+		"| Calathea (calathea orbifolia) | 2000 - 6000 | 18 - 24 | 60 - 75 | 40 - 55 | — |",
+		"| bogus | unavailable | unavailable |",
+		"| **Tightest range** | Calathea | Calathea | Calathea | Calathea | Pothos | Calathea |",
+		"**Most demanding overall**: Calathea · **Least demanding overall**: Pothos",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+}
+
+func TestHandleCareMatrix_Structured(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", Alias: "Monstera", MinTemp: 15, MaxTemp: 30},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pids": []interface{}{"monstera deliciosa"}}
+
+	result, err := srv.handleCareMatrix(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("care_matrix failed: %v", err)
+	}
+	matrix, ok := result.StructuredContent.(careMatrix)
+	if !ok {
+		t.Fatalf("expected structured careMatrix, got %T", result.StructuredContent)
+	}
+	if len(matrix.Rows) != 1 || matrix.Rows[0].Ranges["temperature"].Max != 30 {
+		t.Errorf("unexpected structured rows: %+v", matrix.Rows)
+	}
+	if !strings.Contains(resultText(t, result), "# Care Matrix") {
+		t.Error("expected the markdown table as text content")
+	}
+}
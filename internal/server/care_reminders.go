@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// careRemindersFormat names the payload format; careRemindersVersion is bumped whenever a
+// field is renamed, removed or changes meaning
+const (
+	careRemindersFormat  = "openplantbook-care-reminders"
+	careRemindersVersion = "1"
+)
+
+const (
+	// defaultReminderDays is how far ahead reminders are generated
+	defaultReminderDays = 14
+
+	// maxReminderDays caps the horizon so a typo can't produce thousands of payloads
+	maxReminderDays = 90
+
+	// defaultReminderHour is the local hour reminders are due at
+	defaultReminderHour = 9
+)
+
+// Reminder priorities, named as ntfy names them
+const (
+	reminderPriorityHigh    = "high"
+	reminderPriorityDefault = "default"
+	reminderPriorityLow     = "low"
+)
+
+// careReminders is the document produced by care_reminders
+type careReminders struct {
+	Format        string         `json:"format"`
+	FormatVersion string         `json:"format_version"`
+	GeneratedAt   string         `json:"generated_at"`
+	PID           string         `json:"pid"`
+	Plant         string         `json:"plant"`
+	Season        string         `json:"season"`
+	Reminders     []careReminder `json:"reminders"`
+}
+
+// careReminder is one notification payload
+type careReminder struct {
+	ID       string `json:"id"`   // stable for the same plant, task and day, for de-duplication
+	Task     string `json:"task"` // water, feed or humidity_check
+	Title    string `json:"title"`
+	Body     string `json:"body"`
+	Due      string `json:"due"` // RFC 3339
+	Priority string `json:"priority"`
+}
+
+// reminderOptions are the schedule inputs
+type reminderOptions struct {
+	start      time.Time
+	days       int
+	hour       int
+	potSize    string
+	moisture   float64 // negative when unknown
+	hemisphere string
+}
+
+// reminderDue is the due time dayOffset days after start, at the reminder hour in start's
+// time zone. A due time that has already passed today becomes start itself.
+func reminderDue(start time.Time, dayOffset, hour int) time.Time {
+	due := time.Date(start.Year(), start.Month(), start.Day()+dayOffset, hour, 0, 0, 0, start.Location())
+	if due.Before(start) {
+		return start
+	}
+	return due
+}
+
+// buildCareReminders derives reminders from the same schedule as monthly_checklist, with
+// the first watering projected from the current moisture as collection_watering_plan does
+func buildCareReminders(details *openplantbook.PlantDetails, opts reminderOptions) careReminders {
+	season := seasonForMonth(opts.start.Month(), opts.hemisphere)
+	plan := planChecklistPlant(details, season)
+
+	doc := careReminders{
+		Format:        careRemindersFormat,
+		FormatVersion: careRemindersVersion,
+		GeneratedAt:   opts.start.Format(time.RFC3339),
+		PID:           details.PID,
+		Plant:         plan.name,
+		Season:        season,
+		Reminders:     []careReminder{},
+	}
+
+	add := func(task string, dayOffset int, title, body, priority string) {
+		due := reminderDue(opts.start, dayOffset, opts.hour)
+		doc.Reminders = append(doc.Reminders, careReminder{
+			ID:       fmt.Sprintf("%s:%s:%s", task, strings.ReplaceAll(details.PID, " ", "-"), due.Format("2006-01-02")),
+			Task:     task,
+			Title:    title,
+			Body:     body,
+			Due:      due.Format(time.RFC3339),
+			Priority: priority,
+		})
+	}
+
+	if plan.waterEveryDays > 0 {
+		// Scale the medium-pot interval to this pot's drying rate
+		rate := potDryingRates[opts.potSize]
+		interval := int(math.Max(math.Round(float64(plan.waterEveryDays)*potDryingRates[defaultPotSize]/rate), 1))
+		first := 0
+		if opts.moisture >= 0 {
+			first = daysUntilWatering(opts.moisture, float64(details.MinSoilMoist), float64(details.MaxSoilMoist), rate)
+		}
+		for day := first; day < opts.days; day += interval {
+			priority := reminderPriorityDefault
+			if day == 0 && opts.moisture >= 0 && opts.moisture < float64(details.MinSoilMoist) {
+				priority = reminderPriorityHigh
+			}
+			add("water", day, fmt.Sprintf("Water %s", plan.name),
+				fmt.Sprintf("Check the soil and water when it is below %d%% moisture; stop before %d%%. Next watering in about %d day(s).", details.MinSoilMoist, details.MaxSoilMoist, interval),
+				priority)
+		}
+	}
+
+	for day := 0; plan.feedEveryDays > 0 && day < opts.days; day += plan.feedEveryDays {
+		add("feed", day, fmt.Sprintf("Feed %s", plan.name),
+			fmt.Sprintf("Fertilize to keep soil EC between %d and %d µS/cm (%s).", details.MinSoilEC, details.MaxSoilEC, fertilizerAdvice[fertilizerLevel(float64(details.MinSoilEC+details.MaxSoilEC)/2)]),
+			reminderPriorityLow)
+	}
+
+	humidityEvery := 0
+	switch {
+	case plan.weeklyHumidity:
+		humidityEvery = 7
+	case plan.monthlyHumidity:
+		humidityEvery = 28
+	}
+	for day := 0; humidityEvery > 0 && day < opts.days; day += humidityEvery {
+		add("humidity_check", day, fmt.Sprintf("Check humidity for %s", plan.name),
+			fmt.Sprintf("Aim for %d-%d%% relative humidity around the plant.", details.MinEnvHumid, details.MaxEnvHumid),
+			reminderPriorityLow)
+	}
+
+	sort.SliceStable(doc.Reminders, func(i, j int) bool {
+		return doc.Reminders[i].Due < doc.Reminders[j].Due
+	})
+	return doc
+}
+
+// parseReminderOptions reads and validates the schedule parameters
+func parseReminderOptions(request mcp.CallToolRequest, now time.Time) (reminderOptions, error) {
+	opts := reminderOptions{
+		start:      now,
+		days:       request.GetInt("days", defaultReminderDays),
+		hour:       request.GetInt("hour", defaultReminderHour),
+		potSize:    request.GetString("pot_size", defaultPotSize),
+		moisture:   request.GetFloat("current_moisture", -1),
+		hemisphere: request.GetString("hemisphere", "north"),
+	}
+
+	if raw := request.GetString("start", ""); raw != "" {
+		start, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return opts, fmt.Errorf("start must be an RFC 3339 timestamp such as 2024-05-01T08:00:00+02:00")
+		}
+		opts.start = start
+	}
+
+	switch {
+	case opts.days < 1 || opts.days > maxReminderDays:
+		return opts, fmt.Errorf("days must be between 1 and %d", maxReminderDays)
+	case opts.hour < 0 || opts.hour > 23:
+		return opts, fmt.Errorf("hour must be between 0 and 23")
+	case opts.moisture > 100 || (opts.moisture < 0 && request.GetArguments()["current_moisture"] != nil):
+		return opts, fmt.Errorf("current_moisture must be a percentage (0-100)")
+	case !containsString(potSizes, opts.potSize):
+		return opts, fmt.Errorf("pot_size must be one of: %s", strings.Join(potSizes, ", "))
+	case !containsString(hemispheres, opts.hemisphere):
+		return opts, fmt.Errorf("hemisphere must be one of: %s", strings.Join(hemispheres, ", "))
+	}
+	return opts, nil
+}
+
+// handleCareReminders handles the care_reminders tool
+func (s *Server) handleCareReminders(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "care_reminders")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	opts, err := parseReminderOptions(request, time.Now())
+	if err != nil {
+		logger.Warn("invalid schedule parameters", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("building care reminders", "pid", pid, "days", opts.days)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	doc := buildCareReminders(details, opts)
+
+	logger.Info("care reminders built", "pid", details.PID, "reminders", len(doc.Reminders))
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		logger.Error("marshal reminders failed", "error", err)
+		return mcp.NewToolResultError("failed to format reminders"), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestReminderDue(t *testing.T) {
+	start := time.Date(2024, 4, 1, 10, 30, 0, 0, time.UTC)
+	if got := reminderDue(start, 0, 9); !got.Equal(start) {
+		t.Errorf("a due time already passed today should be now, got %v", got)
+	}
+	if got, want := reminderDue(start, 2, 9), time.Date(2024, 4, 3, 9, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("reminderDue(+2) = %v, want %v", got, want)
+	}
+}
+
+func TestBuildCareReminders(t *testing.T) {
+	basil := &openplantbook.PlantDetails{PID: "ocimum basilicum", Alias: "Basil",
+		MinSoilMoist: 20, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000, MinEnvHumid: 40, MaxEnvHumid: 70}
+	opts := reminderOptions{
+		start:      time.Date(2024, 4, 1, 8, 0, 0, 0, time.UTC),
+		days:       14,
+		hour:       9,
+		potSize:    "medium",
+		moisture:   30,
+		hemisphere: "north",
+	}
+
+	doc := buildCareReminders(basil, opts)
+	var got []string
+	for _, r := range doc.Reminders {
+		got = append(got, r.Task+"@"+r.Due)
+	}
+	want := []string{
+		"feed@2024-04-01T09:00:00Z",
+		"humidity_check@2024-04-01T09:00:00Z",
+		"water@2024-04-03T09:00:00Z",
+		"water@2024-04-11T09:00:00Z",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("reminders = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("reminder %d = %s, want %s", i, got[i], want[i])
+		}
+	}
+	if doc.Season != "spring" || doc.Reminders[2].ID != "water:ocimum-basilicum:2024-04-03" {
+		t.Errorf("unexpected document: season %s, id %s", doc.Season, doc.Reminders[2].ID)
+	}
+
+	// Dry soil in a small pot: water now, urgently, and more often
+	opts.moisture, opts.potSize = 10, "small"
+	doc = buildCareReminders(basil, opts)
+	var waters []careReminder
+	for _, r := range doc.Reminders {
+		if r.Task == "water" {
+			waters = append(waters, r)
+		}
+	}
+	if len(waters) != 3 || waters[0].Priority != reminderPriorityHigh || waters[0].Due != "2024-04-01T09:00:00Z" || waters[1].Due != "2024-04-06T09:00:00Z" {
+		t.Errorf("unexpected small-pot waterings: %+v", waters)
+	}
+
+	// Winter pauses feeding
+	opts.start = time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+	for _, r := range buildCareReminders(basil, opts).Reminders {
+		if r.Task == "feed" {
+			t.Errorf("expected no feeding reminders in winter, got %+v", r)
+		}
+	}
+}
+
+func TestHandleCareReminders(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"ocimum basilicum|en": {PID: "ocimum basilicum", Alias: "Basil", MinSoilMoist: 20, MaxSoilMoist: 60},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "ocimum basilicum", "start": "2024-04-01T08:00:00+02:00", "days": 7.0}
+	result, err := srv.handleCareReminders(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("care_reminders failed: %v %s", err, resultText(t, result))
+	}
+	var doc careReminders
+	if err := json.Unmarshal([]byte(resultText(t, result)), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if doc.Format != careRemindersFormat || len(doc.Reminders) != 1 || doc.Reminders[0].Due != "2024-04-01T09:00:00+02:00" {
+		t.Errorf("unexpected reminders: %+v", doc)
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"pid": "ocimum basilicum", "days": 0.0},
+		{"pid": "ocimum basilicum", "hour": 24.0},
+		{"pid": "ocimum basilicum", "current_moisture": -5.0},
+		{"pid": "ocimum basilicum", "pot_size": "huge"},
+		{"pid": "ocimum basilicum", "start": "tomorrow"},
+	} {
+		request.Params.Arguments = args
+		if result, _ := srv.handleCareReminders(context.Background(), request); !result.IsError {
+			t.Errorf("expected %v to be rejected", args)
+		}
+	}
+}
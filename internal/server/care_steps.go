@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// careStepsHumidifierMin is the minimum humidity above which care_steps suggests humidifying
+const careStepsHumidifierMin = 60
+
+// buildCareSteps turns a plant's ranges into concrete, ordered care actions.
+// Descriptive text comes from the same interpretation helpers as get_care_summary.
+func buildCareSteps(details *openplantbook.PlantDetails, metric bool) []string {
+	var steps []string
+
+	if details.MaxLightLux > 0 {
+		level, where, _ := strings.Cut(stripInterpretation(interpretLightLevel(details.MinLightLux, details.MaxLightLux)), " - ")
+		step := fmt.Sprintf("Place in %s (%d–%d lux)", strings.ToLower(level), details.MinLightLux, details.MaxLightLux)
+		if where != "" {
+			step += ": " + where
+		}
+		steps = append(steps, step+".")
+	}
+
+	if details.MaxSoilMoist > 0 {
+		_, advice, _ := strings.Cut(stripInterpretation(interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist)), " - ")
+		steps = append(steps, fmt.Sprintf("Water when soil moisture drops below %d%%, and stop before it passes %d%% (%s).",
+			details.MinSoilMoist, details.MaxSoilMoist, advice))
+	}
+
+	if details.MaxTemp > 0 {
+		min, max, unit := details.MinTemp, details.MaxTemp, "°C"
+		if !metric {
+			min, max, unit = celsiusToFahrenheit(min), celsiusToFahrenheit(max), "°F"
+		}
+		steps = append(steps, fmt.Sprintf("Keep the temperature between %.0f–%.0f%s, away from drafts and heaters.", min, max, unit))
+	}
+
+	if details.MaxEnvHumid > 0 {
+		step := fmt.Sprintf("Maintain humidity between %d–%d%%", details.MinEnvHumid, details.MaxEnvHumid)
+		if details.MinEnvHumid >= careStepsHumidifierMin {
+			step += "; use a humidifier, pebble tray, or group it with other plants"
+		}
+		steps = append(steps, step+".")
+	}
+
+	if details.MaxSoilEC > 0 {
+		step := fmt.Sprintf("Fertilize to keep soil EC between %d–%d µS/cm", details.MinSoilEC, details.MaxSoilEC)
+		switch {
+		case details.MaxSoilEC < substrateLowFeederMaxEC:
+			step += "; it is a light feeder, so use diluted fertilizer sparingly"
+		case details.MinSoilEC >= substrateHeavyFeederMinEC:
+			step += "; it is a heavy feeder, so feed regularly in the growing season"
+		}
+		steps = append(steps, step+".")
+	}
+
+	return steps
+}
+
+// formatCareSteps renders steps as a numbered markdown list
+func formatCareSteps(details *openplantbook.PlantDetails, steps []string) string {
+	output := fmt.Sprintf("# How to Care for %s (%s)\n\n", details.Alias, details.DisplayPID)
+	for i, step := range steps {
+		output += fmt.Sprintf("%d. %s\n", i+1, step)
+	}
+	return output
+}
+
+// handleCareSteps handles the care_steps tool
+func (s *Server) handleCareSteps(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "care_steps")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	language := request.GetString("language", "")
+	metric := request.GetBool("metric", true)
+
+	// Step text is written by the interpretation helpers; fall back to English
+	stepLang, fellBack := resolveInterpretationLang(language)
+
+	logger.Info("generating care steps", "pid", pid, "language", language, "metric", metric)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, language)
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	output := formatCareSteps(details, buildCareSteps(details, metric))
+	if fellBack {
+		output += fmt.Sprintf("\n_Plant names are in %q; care steps are not yet translated and are shown in %s._\n", language, stepLang)
+	}
+
+	logger.Info("care steps generated", "pid", details.PID)
+
+	return mcp.NewToolResultText(output), nil
+}
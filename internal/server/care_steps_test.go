@@ -0,0 +1,51 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBuildCareSteps(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "Boston fern", DisplayPID: "Nephrolepis exaltata",
+		MinLightLux: 2500, MaxLightLux: 10000,
+		MinSoilMoist: 30, MaxSoilMoist: 60,
+		MinTemp: 16, MaxTemp: 24,
+		MinEnvHumid: 60, MaxEnvHumid: 90,
+		MinSoilEC: 300, MaxSoilEC: 800,
+	}
+
+	steps := buildCareSteps(details, true)
+	expected := []string{
+		"Place in medium indirect light (2500–10000 lux): typical indoor lighting.",
+		"Water when soil moisture drops below 30%, and stop before it passes 60% (keep soil consistently moist).",
+		"Keep the temperature between 16–24°C, away from drafts and heaters.",
+		"Maintain humidity between 60–90%; use a humidifier, pebble tray, or group it with other plants.",
+		"Fertilize to keep soil EC between 300–800 µS/cm; it is a light feeder, so use diluted fertilizer sparingly.",
+	}
+	if len(steps) != len(expected) {
+		t.Fatalf("got %d steps, want %d: %v", len(steps), len(expected), steps)
+	}
+	for i := range expected {
+		if steps[i] != expected[i] {
+			t.Errorf("step %d = %q, want %q", i+1, steps[i], expected[i])
+		}
+	}
+
+	t.Run("imperial temperature", func(t *testing.T) {
+		steps := buildCareSteps(details, false)
+		if !strings.Contains(steps[2], "61–75°F") {
+			t.Errorf("temperature step = %q", steps[2])
+		}
+	})
+
+	t.Run("numbered output skips missing ranges", func(t *testing.T) {
+		partial := &openplantbook.PlantDetails{Alias: "x", DisplayPID: "X", MinTemp: 10, MaxTemp: 30}
+		output := formatCareSteps(partial, buildCareSteps(partial, true))
+		if !strings.Contains(output, "1. Keep the temperature between 10–30°C") || strings.Contains(output, "2.") {
+			t.Errorf("unexpected output:\n%s", output)
+		}
+	})
+}
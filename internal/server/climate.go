@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// climateZone places a reading relative to the plant's range
+type climateZone int
+
+const (
+	zoneBelow climateZone = iota // under the minimum
+	zoneLow                      // lowest quarter of the range
+	zoneMid                      // middle half of the range
+	zoneHigh                     // highest quarter of the range
+	zoneAbove                    // over the maximum
+)
+
+// climateEdgeFraction is the share of the range at each end counted as low/high
+const climateEdgeFraction = 0.25
+
+// classifyClimateZone returns where value sits within [min, max]
+func classifyClimateZone(value, min, max float64) climateZone {
+	edge := (max - min) * climateEdgeFraction
+	switch {
+	case value < min:
+		return zoneBelow
+	case value > max:
+		return zoneAbove
+	case value < min+edge:
+		return zoneLow
+	case value > max-edge:
+		return zoneHigh
+	}
+	return zoneMid
+}
+
+// climateProfile is the subset of care data the comfort rules look at
+type climateProfile struct {
+	category  string // lowercase botanical family/category
+	minHumid  int
+	tempZone  climateZone
+	humidZone climateZone
+}
+
+// tropical reports whether the plant comes from humid tropics, by family or humidity needs
+func (p climateProfile) tropical() bool {
+	return categoryHasAny(p.category, "araceae", "marantaceae", "bromeliaceae", "orchidaceae", "gesneriaceae", "begoniaceae", "aroid") ||
+		p.minHumid >= 50
+}
+
+// succulent reports whether the plant is adapted to dry conditions
+func (p climateProfile) succulent() bool {
+	return categoryHasAny(p.category, "cactaceae", "crassulaceae", "aizoaceae", "cact", "succulent")
+}
+
+// warm reports a temperature in the upper quarter or above the range
+func (p climateProfile) warm() bool { return p.tempZone >= zoneHigh }
+
+// cold reports a temperature in the lower quarter or below the range
+func (p climateProfile) cold() bool { return p.tempZone <= zoneLow }
+
+// dry reports humidity in the lower quarter or below the range
+func (p climateProfile) dry() bool { return p.humidZone <= zoneLow }
+
+// humid reports humidity in the upper quarter or above the range
+func (p climateProfile) humid() bool { return p.humidZone >= zoneHigh }
+
+// climateRule is a dangerous temperature/humidity combination
+type climateRule struct {
+	name     string
+	severity badgeStatus
+	effect   string
+	advice   string
+	matches  func(p climateProfile) bool
+}
+
+// climateRules is the combined-comfort table behind climate_comfort. Every matching rule
+// is reported. Rules fire on combinations near or past the range edges, so they catch
+// stress that checking temperature and humidity separately would pass:
+//
+//	warm + dry, tropical family or humidity-loving -> critical: leaf crisping, spider mites
+//	warm + dry, other non-succulents                -> attention
+//	cold + wet, succulents                          -> critical: rot
+//	cold + wet, others                              -> attention: fungal disease
+//	hot + humid (both above range)                  -> attention: heat stress, mildew
+//	cold + dry (both below range), non-succulents   -> attention: chill and desiccation
+var climateRules = []climateRule{
+	{
+		name:     "Warm and dry (tropical)",
+		severity: badgeCritical,
+		effect:   "this humidity-loving plant loses water faster than its roots can replace it; expect crispy leaf edges and spider mites",
+		advice:   "raise humidity (humidifier, pebble tray, grouping) or move it away from heat sources",
+		matches: func(p climateProfile) bool {
+			return p.tropical() && p.warm() && p.dry()
+		},
+	},
+	{
+		name:     "Warm and dry",
+		severity: badgeAttention,
+		effect:   "warm, dry air speeds up transpiration and soil drying",
+		advice:   "check soil moisture more often and consider raising humidity",
+		matches: func(p climateProfile) bool {
+			return !p.tropical() && !p.succulent() && p.warm() && p.dry()
+		},
+	},
+	{
+		name:     "Cold and wet (succulent)",
+		severity: badgeCritical,
+		effect:   "succulents in cool, damp air can't dry out and rot quickly",
+		advice:   "move it somewhere warmer and drier and hold off watering",
+		matches: func(p climateProfile) bool {
+			return p.succulent() && p.cold() && p.humid()
+		},
+	},
+	{
+		name:     "Cold and wet",
+		severity: badgeAttention,
+		effect:   "cool, humid air slows drying and encourages fungal disease and root rot",
+		advice:   "improve air circulation, water less often and keep it away from cold glass",
+		matches: func(p climateProfile) bool {
+			return !p.succulent() && p.cold() && p.humid()
+		},
+	},
+	{
+		name:     "Hot and humid",
+		severity: badgeAttention,
+		effect:   "saturated hot air stops leaves cooling by evaporation and favors mildew",
+		advice:   "ventilate and shade the plant during the hottest part of the day",
+		matches: func(p climateProfile) bool {
+			return p.tempZone == zoneAbove && p.humidZone == zoneAbove
+		},
+	},
+	{
+		name:     "Cold and dry",
+		severity: badgeAttention,
+		effect:   "chilled roots take up little water while dry air keeps pulling it from the leaves",
+		advice:   "move it away from drafty windows and raise the humidity",
+		matches: func(p climateProfile) bool {
+			return !p.succulent() && p.tempZone == zoneBelow && p.humidZone == zoneBelow
+		},
+	},
+}
+
+// assessClimate returns every climate rule matching the reading, most severe first
+func assessClimate(details *openplantbook.PlantDetails, temperature, humidity float64) []climateRule {
+	p := climateProfile{
+		category:  strings.ToLower(details.Category),
+		minHumid:  details.MinEnvHumid,
+		tempZone:  classifyClimateZone(temperature, details.MinTemp, details.MaxTemp),
+		humidZone: classifyClimateZone(humidity, float64(details.MinEnvHumid), float64(details.MaxEnvHumid)),
+	}
+
+	var critical, other []climateRule
+	for _, rule := range climateRules {
+		if !rule.matches(p) {
+			continue
+		}
+		if rule.severity == badgeCritical {
+			critical = append(critical, rule)
+		} else {
+			other = append(other, rule)
+		}
+	}
+	return append(critical, other...)
+}
+
+// formatClimateComfort renders the individual checks followed by the combined assessment
+func formatClimateComfort(details *openplantbook.PlantDetails, temperature, humidity float64, rules []climateRule) string {
+	output := fmt.Sprintf("# Climate Comfort for %s (%s)\n\n", details.Alias, details.DisplayPID)
+	output += fmt.Sprintf("**Temperature**: %.1f°C (ideal %.1f - %.1f°C)\n\n", temperature, details.MinTemp, details.MaxTemp)
+	output += fmt.Sprintf("**Humidity**: %.0f%% (ideal %d - %d%%)\n\n", humidity, details.MinEnvHumid, details.MaxEnvHumid)
+
+	if len(rules) == 0 {
+		output += "**Combined**: 🟢 comfortable - no risky temperature/humidity combination.\n"
+		return output
+	}
+
+	output += fmt.Sprintf("**Combined**: %s\n\n", rules[0].severity)
+	for _, rule := range rules {
+		output += fmt.Sprintf("- **%s**: %s. Try to %s.\n", rule.name, rule.effect, rule.advice)
+	}
+	return output
+}
+
+// handleClimateComfort handles the climate_comfort tool
+func (s *Server) handleClimateComfort(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "climate_comfort")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	temperature, err := request.RequireFloat("temperature")
+	if err != nil {
+		logger.Warn("invalid temperature parameter", "error", err)
+		return mcp.NewToolResultError("temperature parameter is required and must be a number (°C)"), nil
+	}
+
+	humidity, err := request.RequireFloat("humidity")
+	if err != nil || humidity < 0 || humidity > 100 {
+		logger.Warn("invalid humidity parameter", "error", err)
+		return mcp.NewToolResultError("humidity parameter is required and must be a percentage (0-100)"), nil
+	}
+
+	logger.Info("assessing climate comfort", "pid", pid, "temperature", temperature, "humidity", humidity)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxTemp <= 0 || details.MaxEnvHumid <= 0 {
+		logger.Warn("plant lacks temperature or humidity data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("climate comfort needs both temperature and humidity ranges, which are not available for this plant (%s)", pid)), nil
+	}
+
+	rules := assessClimate(details, temperature, humidity)
+
+	logger.Info("climate comfort assessed", "pid", details.PID, "risks", len(rules))
+
+	return mcp.NewToolResultText(formatClimateComfort(details, temperature, humidity, rules)), nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestClassifyClimateZone(t *testing.T) {
+	// range 10-30, edges 5 wide
+	tests := map[float64]climateZone{9: zoneBelow, 12: zoneLow, 20: zoneMid, 27: zoneHigh, 31: zoneAbove}
+	for value, expected := range tests {
+		if got := classifyClimateZone(value, 10, 30); got != expected {
+			t.Errorf("classifyClimateZone(%g) = %d, want %d", value, got, expected)
+		}
+	}
+}
+
+func TestAssessClimate(t *testing.T) {
+	tropical := &openplantbook.PlantDetails{Category: "Araceae", MinTemp: 15, MaxTemp: 31, MinEnvHumid: 40, MaxEnvHumid: 80}
+	succulent := &openplantbook.PlantDetails{Category: "Crassulaceae", MinTemp: 10, MaxTemp: 30, MinEnvHumid: 20, MaxEnvHumid: 60}
+	herb := &openplantbook.PlantDetails{Category: "Lamiaceae", MinTemp: 10, MaxTemp: 30, MinEnvHumid: 20, MaxEnvHumid: 60}
+
+	tests := []struct {
+		name        string
+		details     *openplantbook.PlantDetails
+		temperature float64
+		humidity    float64
+		expected    []string
+	}{
+		// each reading is within its own range; only the combination is risky
+		{"tropical warm and dry in range", tropical, 29, 44, []string{"Warm and dry (tropical)"}},
+		{"tropical comfortable", tropical, 23, 60, nil},
+		{"succulent warm and dry is fine", succulent, 28, 22, nil},
+		{"succulent cold and wet", succulent, 11, 58, []string{"Cold and wet (succulent)"}},
+		{"herb warm and dry", herb, 28, 22, []string{"Warm and dry"}},
+		{"herb cold and wet", herb, 11, 58, []string{"Cold and wet"}},
+		{"hot and humid past both ranges", herb, 33, 70, []string{"Hot and humid"}},
+		{"cold and dry past both ranges", herb, 5, 15, []string{"Cold and dry"}},
+		{"tropical hot and humid", tropical, 35, 85, []string{"Hot and humid"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			for _, rule := range assessClimate(tt.details, tt.temperature, tt.humidity) {
+				names = append(names, rule.name)
+			}
+			if !reflect.DeepEqual(names, tt.expected) {
+				t.Errorf("rules = %v, want %v", names, tt.expected)
+			}
+		})
+	}
+
+	t.Run("humidity-loving plant counts as tropical", func(t *testing.T) {
+		fern := &openplantbook.PlantDetails{Category: "Nephrolepidaceae", MinTemp: 15, MaxTemp: 27, MinEnvHumid: 60, MaxEnvHumid: 90}
+		rules := assessClimate(fern, 26, 62)
+		if len(rules) != 1 || rules[0].severity != badgeCritical {
+			t.Errorf("expected critical warm and dry, got %+v", rules)
+		}
+	})
+
+	t.Run("output", func(t *testing.T) {
+		output := formatClimateComfort(tropical, 29, 44, assessClimate(tropical, 29, 44))
+		if !strings.Contains(output, "**Combined**: 🔴 critical") || !strings.Contains(output, "spider mites") {
+			t.Errorf("unexpected output:\n%s", output)
+		}
+		output = formatClimateComfort(tropical, 23, 60, nil)
+		if !strings.Contains(output, "🟢 comfortable") {
+			t.Errorf("unexpected output:\n%s", output)
+		}
+	})
+}
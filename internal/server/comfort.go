@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// metricOverlap is the suitability of one metric within the comfort envelope
+type metricOverlap struct {
+	metric   careMetric
+	current  valueRange
+	idealMin float64
+	idealMax float64
+	fraction float64
+}
+
+// overlapFraction returns how much of the current range (0-1) lies inside the ideal range.
+// A single reading inside the range scores 1; outside it decays linearly with the
+// distance to the nearest bound, reaching 0 one full range-width away.
+func overlapFraction(current, ideal valueRange) float64 {
+	width := ideal.max - ideal.min
+	if current.max > current.min {
+		overlap := math.Min(current.max, ideal.max) - math.Max(current.min, ideal.min)
+		if overlap <= 0 {
+			return 0
+		}
+		return overlap / (current.max - current.min)
+	}
+
+	value := current.min
+	if value >= ideal.min && value <= ideal.max {
+		return 1
+	}
+	if width <= 0 {
+		return 0
+	}
+	distance := math.Max(ideal.min-value, value-ideal.max)
+	return math.Max(0, 1-distance/width)
+}
+
+// computeComfortOverlap scores each provided metric against the plant's envelope and
+// combines them with a weighted geometric mean
+func computeComfortOverlap(details *openplantbook.PlantDetails, conditions map[string]interface{}, weights healthWeights) ([]metricOverlap, float64) {
+	var overlaps []metricOverlap
+	var fractions []float64
+	var keys []string
+
+	for _, metric := range careMetrics {
+		current, ok := parseConditionRange(conditions[metric.key])
+		if !ok {
+			continue
+		}
+		min, max, hasData := metric.ideal(details)
+		if !hasData {
+			continue
+		}
+		fraction := overlapFraction(current, valueRange{min, max})
+		overlaps = append(overlaps, metricOverlap{
+			metric:   metric,
+			current:  current,
+			idealMin: min,
+			idealMax: max,
+			fraction: fraction,
+		})
+		fractions = append(fractions, fraction)
+		keys = append(keys, metric.key)
+	}
+
+	return overlaps, weightedGeometricMean(fractions, weights.normalized(keys))
+}
+
+// handleComfortOverlap handles the comfort_overlap tool
+func (s *Server) handleComfortOverlap(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "comfort_overlap")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	conditions, ok := request.GetArguments()["current_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid current_conditions parameter")
+		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
+	}
+
+	weights, err := s.healthWeights(request.GetArguments()["weights"])
+	if err != nil {
+		logger.Warn("invalid weights parameter", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("computing comfort overlap", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	overlaps, score := computeComfortOverlap(details, conditions, weights)
+	if len(overlaps) == 0 {
+		return mcp.NewToolResultError("no comparable conditions provided: supply moisture, temperature, light_lux, or humidity for metrics this plant has data for"), nil
+	}
+
+	output := fmt.Sprintf("# Comfort Envelope for %s\n\n", details.Alias)
+	output += fmt.Sprintf("**This spot is %.0f%% suitable.**\n\n", score*100)
+	output += "| Metric | Current | Ideal | Within envelope |\n"
+	output += "|--------|---------|-------|-----------------|\n"
+	for _, o := range overlaps {
+		current := fmt.Sprintf("%g%s", o.current.min, o.metric.unit)
+		if o.current.max > o.current.min {
+			current = fmt.Sprintf("%g-%g%s", o.current.min, o.current.max, o.metric.unit)
+		}
+		output += fmt.Sprintf("| %s | %s | %g-%g%s | %.0f%% |\n", o.metric.label, current, o.idealMin, o.idealMax, o.metric.unit, o.fraction*100)
+	}
+	output += "\nPer-metric scores are combined with a geometric mean, so one completely unsuitable metric makes the whole spot unsuitable.\n"
+	if !weights.equal() {
+		metrics := make([]careMetric, len(overlaps))
+		for i, o := range overlaps {
+			metrics[i] = o.metric
+		}
+		output += fmt.Sprintf("\nWeighted: %s\n", weights.describe(metrics))
+	}
+
+	logger.Info("comfort overlap computed", "pid", details.PID, "score", score)
+
+	return mcp.NewToolResultText(output), nil
+}
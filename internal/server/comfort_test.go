@@ -0,0 +1,85 @@
+package server
+
+import (
+	"math"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestOverlapFraction(t *testing.T) {
+	ideal := valueRange{20, 30}
+	tests := []struct {
+		name     string
+		current  valueRange
+		expected float64
+	}{
+		{"point inside", valueRange{25, 25}, 1},
+		{"point on bound", valueRange{30, 30}, 1},
+		{"point half a width below", valueRange{15, 15}, 0.5},
+		{"point far above", valueRange{45, 45}, 0},
+		{"range fully inside", valueRange{22, 28}, 1},
+		{"range half inside", valueRange{25, 35}, 0.5},
+		{"range disjoint", valueRange{31, 40}, 0},
+		{"range covering ideal", valueRange{10, 40}, 1.0 / 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := overlapFraction(tt.current, ideal)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("overlapFraction(%v) = %v, want %v", tt.current, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComputeComfortOverlap(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		MinSoilMoist: 20, MaxSoilMoist: 60,
+		MinTemp: 18, MaxTemp: 28,
+		MinLightLux: 1000, MaxLightLux: 5000,
+	}
+
+	conditions := map[string]interface{}{
+		"moisture":    40.0,
+		"temperature": map[string]interface{}{"min": 23.0, "max": 33.0},
+		"light_lux":   []interface{}{1000.0, 3000.0},
+		"humidity":    50.0, // plant has no humidity data, must be skipped
+	}
+
+	overlaps, score := computeComfortOverlap(details, conditions, nil)
+	if len(overlaps) != 3 {
+		t.Fatalf("expected 3 metrics scored, got %d", len(overlaps))
+	}
+
+	expected := math.Cbrt(1 * 0.5 * 1)
+	if math.Abs(score-expected) > 1e-9 {
+		t.Errorf("score = %v, want %v", score, expected)
+	}
+}
+
+func TestParseConditionRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  valueRange
+		ok    bool
+	}{
+		{"number", 12.5, valueRange{12.5, 12.5}, true},
+		{"object", map[string]interface{}{"min": 30.0, "max": 10.0}, valueRange{10, 30}, true},
+		{"array", []interface{}{1.0, 2.0}, valueRange{1, 2}, true},
+		{"short array", []interface{}{1.0}, valueRange{}, false},
+		{"string", "warm", valueRange{}, false},
+		{"missing", nil, valueRange{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseConditionRange(tt.value)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("parseConditionRange(%v) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// plantRangeComparison is how one metric's ranges for several plants relate
+type plantRangeComparison struct {
+	metric   baselineMetric
+	ranges   []valueRange // one per plant, valid where has is true
+	has      []bool
+	withData int
+	overlap  valueRange // valid when overlaps is true
+	overlaps bool
+}
+
+// comparable reports whether at least two plants have data for the metric
+func (c plantRangeComparison) comparable() bool {
+	return c.withData >= 2
+}
+
+// comparePlantRanges lines up the plants' ranges metric by metric, in baselineMetrics order.
+// The shared range is the intersection of every plant that has data for the metric.
+func comparePlantRanges(plants []*openplantbook.PlantDetails) []plantRangeComparison {
+	comparisons := make([]plantRangeComparison, 0, len(baselineMetrics))
+	for _, m := range baselineMetrics {
+		c := plantRangeComparison{metric: m, ranges: make([]valueRange, len(plants)), has: make([]bool, len(plants))}
+		for i, p := range plants {
+			lo, hi, ok := m.ideal(p)
+			if !ok {
+				continue
+			}
+			c.ranges[i], c.has[i] = valueRange{lo, hi}, true
+			if c.withData == 0 {
+				c.overlap = c.ranges[i]
+			} else {
+				c.overlap = valueRange{max(c.overlap.min, lo), min(c.overlap.max, hi)}
+			}
+			c.withData++
+		}
+		c.overlaps = c.comparable() && c.overlap.min <= c.overlap.max
+		comparisons = append(comparisons, c)
+	}
+	return comparisons
+}
+
+// conflictPair returns the plant whose range ends lowest and the one whose range starts
+// highest: the two that pull furthest apart when the ranges don't overlap
+func (c plantRangeComparison) conflictPair() (low, high int) {
+	low, high = -1, -1
+	for i, r := range c.ranges {
+		if !c.has[i] {
+			continue
+		}
+		if low < 0 || r.max < c.ranges[low].max {
+			low = i
+		}
+		if high < 0 || r.min > c.ranges[high].min {
+			high = i
+		}
+	}
+	return low, high
+}
+
+// verdict describes the shared range, or the widest gap when the ranges conflict
+func (c plantRangeComparison) verdict(names []string) string {
+	unit := matrixUnits[c.metric.key]
+	switch {
+	case !c.comparable():
+		return "❔ unknown (missing data)"
+	case c.overlaps:
+		verdict := fmt.Sprintf("✅ overlap %g - %g %s", c.overlap.min, c.overlap.max, unit)
+		if missing := c.missing(names); len(missing) > 0 {
+			verdict += fmt.Sprintf(" (no data for %s)", strings.Join(missing, ", "))
+		}
+		return verdict
+	default:
+		low, high := c.conflictPair()
+		return fmt.Sprintf("❌ conflict: %s needs up to %g, %s at least %g (gap of %g %s)",
+			names[low], c.ranges[low].max, names[high], c.ranges[high].min, c.ranges[high].min-c.ranges[low].max, unit)
+	}
+}
+
+// incompatibility spells out a conflict with the full ranges of the two plants furthest apart
+func (c plantRangeComparison) incompatibility(names []string) string {
+	unit := matrixUnits[c.metric.key]
+	low, high := c.conflictPair()
+	return fmt.Sprintf("%s ranges incompatible: %s needs %g-%g %s, %s needs %g-%g %s", c.metric.label,
+		names[low], c.ranges[low].min, c.ranges[low].max, unit, names[high], c.ranges[high].min, c.ranges[high].max, unit)
+}
+
+// missing names the plants without data for the metric
+func (c plantRangeComparison) missing(names []string) []string {
+	var missing []string
+	for i, has := range c.has {
+		if !has {
+			missing = append(missing, names[i])
+		}
+	}
+	return missing
+}
+
+// formatPlantComparison renders the side-by-side table, the incompatibilities and a
+// one-line verdict. failures lists the pids that couldn't be compared.
+func formatPlantComparison(plants []*openplantbook.PlantDetails, comparisons []plantRangeComparison, failures []string) string {
+	names := make([]string, len(plants))
+	for i, p := range plants {
+		names[i] = p.Alias
+		if names[i] == "" {
+			names[i] = p.PID
+		}
+	}
+	together := "both"
+	if len(plants) > 2 {
+		together = "all of them"
+	}
+
+	output := fmt.Sprintf("# %s\n\n", strings.Join(names, " vs "))
+	output += fmt.Sprintf("| Metric | %s | Together |\n", strings.Join(names, " | "))
+	output += "|" + strings.Repeat(" --- |", len(plants)+2) + "\n"
+
+	var overlapping, conflicting, incompatibilities []string
+	for _, c := range comparisons {
+		cells := make([]string, len(plants))
+		for i := range plants {
+			cells[i] = "—"
+			if c.has[i] {
+				cells[i] = fmt.Sprintf("%g - %g", c.ranges[i].min, c.ranges[i].max)
+			}
+		}
+		output += fmt.Sprintf("| %s (%s) | %s | %s |\n", c.metric.label, matrixUnits[c.metric.key], strings.Join(cells, " | "), c.verdict(names))
+
+		switch {
+		case c.overlaps:
+			overlapping = append(overlapping, c.metric.label)
+		case c.comparable():
+			conflicting = append(conflicting, strings.ToLower(c.metric.label))
+			incompatibilities = append(incompatibilities, c.incompatibility(names))
+		}
+	}
+	output += "\n"
+
+	if len(incompatibilities) > 0 {
+		output += "## Incompatibilities\n\n"
+		for _, line := range incompatibilities {
+			output += fmt.Sprintf("- %s\n", line)
+		}
+		output += "\n"
+	}
+
+	switch {
+	case len(overlapping) == 0 && len(conflicting) == 0:
+		output += "**Verdict**: not enough shared data to compare these plants.\n"
+	case len(conflicting) == 0:
+		output += fmt.Sprintf("**Verdict**: compatible - all %d comparable ranges overlap, so one spot can suit %s.\n", len(overlapping), together)
+	default:
+		output += fmt.Sprintf("**Verdict**: %d of %d comparable ranges overlap; they conflict on %s, so one spot can't suit %s without compromise.\n",
+			len(overlapping), len(overlapping)+len(conflicting), strings.Join(conflicting, ", "), together)
+	}
+
+	if len(failures) > 0 {
+		output += "\n## Errors\n\n"
+		for _, f := range failures {
+			output += fmt.Sprintf("- %s\n", f)
+		}
+	}
+	return output
+}
+
+// handleComparePlants handles the compare_plants tool
+func (s *Server) handleComparePlants(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "compare_plants")
+
+	// Extract parameters; pid_a and pid_b are the deprecated two-plant form of pids
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		for _, name := range []string{"pid_a", "pid_b"} {
+			if pid := request.GetString(name, ""); pid != "" {
+				pids = append(pids, pid)
+			}
+		}
+	}
+	if len(pids) < 2 {
+		logger.Warn("invalid pids parameter", "count", len(pids))
+		return mcp.NewToolResultError("pids parameter is required and must contain at least two plant IDs"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "count", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("comparing plants", "pids", len(pids))
+
+	// Get plant details for every plant at once
+	var plants []*openplantbook.PlantDetails
+	var failures []string
+	for _, f := range s.fetchPlantsConcurrently(ctx, pids) {
+		switch {
+		case f.err != nil:
+			logger.Warn("get details failed", "pid", f.pid, "error", f.err)
+			failures = append(failures, fmt.Sprintf("%s: failed to get plant details: %v", f.pid, f.err))
+		case !hasCareData(f.details):
+			logger.Warn("plant has no care data", "pid", f.pid)
+			failures = append(failures, noCareDataMessage(f.pid))
+		default:
+			plants = append(plants, f.details)
+		}
+	}
+	if len(plants) < 2 {
+		return mcp.NewToolResultError(fmt.Sprintf("at least two plants with care data are needed to compare: %s", strings.Join(failures, "; "))), nil
+	}
+
+	comparisons := comparePlantRanges(plants)
+
+	logger.Info("plants compared", "plants", len(plants), "failed", len(failures))
+
+	return mcp.NewToolResultText(formatPlantComparison(plants, comparisons, failures)), nil
+}
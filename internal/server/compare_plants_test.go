@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestComparePlantRanges(t *testing.T) {
+	fern := &openplantbook.PlantDetails{
+		MinLightLux: 1000, MaxLightLux: 5000, MinTemp: 16, MaxTemp: 24,
+		MinEnvHumid: 60, MaxEnvHumid: 90, MinSoilMoist: 40, MaxSoilMoist: 70,
+	}
+	cactus := &openplantbook.PlantDetails{
+		MinLightLux: 20000, MaxLightLux: 80000, MinTemp: 10, MaxTemp: 35,
+		MinEnvHumid: 10, MaxEnvHumid: 40, MinSoilMoist: 5, MaxSoilMoist: 20,
+		MinSoilEC: 200, MaxSoilEC: 800,
+	}
+
+	names := []string{"Fern", "Cactus"}
+	byKey := map[string]plantRangeComparison{}
+	for _, c := range comparePlantRanges([]*openplantbook.PlantDetails{fern, cactus}) {
+		byKey[c.metric.key] = c
+	}
+
+	if c := byKey["temperature"]; !c.overlaps || c.overlap != (valueRange{16, 24}) {
+		t.Errorf("temperature = %+v, want overlap 16-24", c)
+	}
+	if c := byKey["light_lux"]; c.overlaps {
+		t.Errorf("light should conflict, got %+v", c)
+	}
+	if got := byKey["light_lux"].verdict(names); !strings.Contains(got, "Fern needs up to 5000, Cactus at least 20000 (gap of 15000 lux)") {
+		t.Errorf("light verdict = %q", got)
+	}
+	if got := byKey["moisture"].verdict(names); !strings.Contains(got, "Cactus needs up to 20, Fern at least 40") {
+		t.Errorf("moisture verdict = %q, want the lower range named first", got)
+	}
+	if got := byKey["light_lux"].incompatibility(names); got != "Light ranges incompatible: Fern needs 1000-5000 lux, Cactus needs 20000-80000 lux" {
+		t.Errorf("light incompatibility = %q", got)
+	}
+	if c := byKey["soil_ec"]; c.has[0] || c.overlaps || !strings.Contains(c.verdict(names), "unknown") {
+		t.Errorf("EC without data for one plant should be unknown, got %+v", c)
+	}
+
+	// Ranges that only touch still share that value
+	touching := comparePlantRanges([]*openplantbook.PlantDetails{{MinTemp: 10, MaxTemp: 20}, {MinTemp: 20, MaxTemp: 30}})
+	if !touching[1].overlaps || touching[1].overlap != (valueRange{20, 20}) {
+		t.Errorf("touching ranges = %+v, want overlap at 20", touching[1])
+	}
+
+	// With three plants the shared range is the intersection of all of them, and a
+	// conflict names the two furthest apart
+	three := comparePlantRanges([]*openplantbook.PlantDetails{
+		{MinTemp: 10, MaxTemp: 30}, {MinTemp: 15, MaxTemp: 25}, {MinTemp: 18, MaxTemp: 35},
+	})
+	if c := three[1]; !c.overlaps || c.overlap != (valueRange{18, 25}) {
+		t.Errorf("three-way temperature = %+v, want overlap 18-25", c)
+	}
+	apart := comparePlantRanges([]*openplantbook.PlantDetails{
+		{MinTemp: 10, MaxTemp: 30}, {MinTemp: 5, MaxTemp: 12}, {MinTemp: 20, MaxTemp: 35},
+	})
+	if got := apart[1].verdict([]string{"A", "B", "C"}); !strings.Contains(got, "B needs up to 12, C at least 20 (gap of 8 °C)") {
+		t.Errorf("three-way conflict verdict = %q", got)
+	}
+}
+
+func TestHandleComparePlants(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MinTemp: 12, MaxTemp: 32, MinEnvHumid: 30, MaxEnvHumid: 80},
+		"epipremnum aureum|en":  {PID: "epipremnum aureum", Alias: "Pothos", MinTemp: 15, MaxTemp: 30, MinEnvHumid: 40, MaxEnvHumid: 70},
+		"opuntia|en":            {PID: "opuntia", Alias: "Prickly Pear", MinTemp: 10, MaxTemp: 40, MinEnvHumid: 10, MaxEnvHumid: 30},
+	}}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    []string
+	}{
+		{
+			name: "two plants",
+			args: map[string]interface{}{"pids": []interface{}{"monstera deliciosa", "epipremnum aureum"}},
+			want: []string{"# Monstera vs Pothos", "| Temperature (°C) | 12 - 32 | 15 - 30 | ✅ overlap 15 - 30 °C |", "compatible - all 2 comparable ranges overlap, so one spot can suit both"},
+		},
+		{
+			name: "three plants with a conflict",
+			args: map[string]interface{}{"pids": []interface{}{"monstera deliciosa", "epipremnum aureum", "opuntia"}},
+			want: []string{
+				"| Metric | Monstera | Pothos | Prickly Pear | Together |",
+				"| --- | --- | --- | --- | --- |",
+				"- Humidity ranges incompatible: Prickly Pear needs 10-30 %, Pothos needs 40-70 %",
+				"they conflict on humidity, so one spot can't suit all of them",
+			},
+		},
+		{
+			name: "unresolved pid is listed but the rest are compared",
+			args: map[string]interface{}{"pids": []interface{}{"monstera deliciosa", "ficus lyrata", "epipremnum aureum"}},
+			want: []string{"# Monstera vs Pothos", "## Errors", "- ficus lyrata: failed to get plant details"},
+		},
+		{
+			name: "deprecated pid_a and pid_b",
+			args: map[string]interface{}{"pid_a": "monstera deliciosa", "pid_b": "epipremnum aureum"},
+			want: []string{"# Monstera vs Pothos"},
+		},
+		{"one plant", map[string]interface{}{"pids": []interface{}{"monstera deliciosa"}}, "at least two plant IDs", nil},
+		{"too few resolve", map[string]interface{}{"pids": []interface{}{"monstera deliciosa", "ficus lyrata"}}, "at least two plants with care data", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleComparePlants(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleComparePlants() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("output missing %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -1,13 +1,26 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/spf13/viper"
 )
 
+var (
+	// ErrAuthConfig indicates missing or malformed credentials
+	ErrAuthConfig = errors.New("invalid authentication configuration")
+
+	// ErrClientInit indicates the SDK client failed to initialize for a non-credential reason
+	ErrClientInit = errors.New("openplantbook client initialization failed")
+)
+
 // Config holds the MCP server configuration
 type Config struct {
 	// API Key authentication (simpler, read-only endpoints)
@@ -23,6 +36,104 @@ type Config struct {
 	CacheEnabled bool
 	CacheTTL     int // hours
 	DefaultLang  string
+
+	// CacheBackend is where plant details are cached: "memory" (default) or "file",
+	// which also persists them in CacheDir so they survive restarts
+	CacheBackend string
+
+	// CacheDir is the file backend's directory; it defaults to openplantbook-mcp under
+	// the OS user cache dir
+	CacheDir string
+
+	// CacheMaxEntries caps each in-memory cache; the least recently used entry is evicted
+	// to make room. 0 means unbounded.
+	CacheMaxEntries int
+
+	// LanguageFallback is tried in order when the requested language lacks data
+	LanguageFallback []string
+
+	// BadgeCriticalDeviation is how far outside the ideal range (as a percentage of
+	// the range width) a reading must be before status_badge reports critical
+	BadgeCriticalDeviation float64
+
+	// IncludeTraceInErrors appends "(trace: <id>)" to tool error messages so users
+	// can quote the ID in bug reports
+	IncludeTraceInErrors bool
+
+	// Transport is how clients connect: "stdio" (default) or "http" (also "sse") for
+	// HTTP/SSE on ListenAddr
+	Transport  string
+	ListenAddr string
+
+	// ServerAuthToken, when set, is the bearer token every HTTP request must present
+	ServerAuthToken string
+
+	// RetryMaxAttempts is how many times an API call is tried when it fails with a 5xx
+	// or a network error, including the first try; 1 disables retries.
+	// RetryBaseDelay is the backoff before the first retry, doubling after each.
+	RetryMaxAttempts int
+	RetryBaseDelay   time.Duration
+
+	// RateLimitPerMinute caps outbound API calls across all tools; 0 is unlimited.
+	// RateLimitMode is what a call does when the budget is used up: "wait" (default)
+	// for a token, up to the call's deadline, or "reject" with a rate limited error.
+	RateLimitPerMinute int
+	RateLimitMode      string
+
+	// MaxRequestBytes caps HTTP request bodies; stdio is unaffected
+	MaxRequestBytes int64
+
+	// MaxBatchSize caps the plants accepted by bulk tools in one call
+	MaxBatchSize int
+
+	// FetchConcurrency caps the plant details bulk tools fetch at once, so a large batch
+	// doesn't burst past upstream rate limits
+	FetchConcurrency int
+
+	// SlowCallThresholdMs is the tool call duration, in milliseconds, above which a
+	// "slow tool call" warning is logged
+	SlowCallThresholdMs int
+
+	// Aggregation is how compare_conditions reduces arrays of samples when the
+	// call doesn't say: mean, median or latest
+	Aggregation string
+
+	// BaselineProfile overrides the "generic houseplant" ranges used for baseline
+	// comparisons, keyed by metric (light_lux, temperature, humidity, moisture, soil_ec)
+	BaselineProfile map[string][2]float64
+
+	// HealthWeights is the default relative weight of each metric (moisture,
+	// temperature, light_lux, humidity) in score_card and comfort_overlap scores;
+	// unlisted metrics weigh 1
+	HealthWeights map[string]float64
+
+	// SensorInAir* tune the "moisture sensor is not in the soil" heuristic in
+	// compare_conditions: the moisture reading at or below which it triggers, the
+	// sample-to-sample drop and the EC reading that corroborate it
+	SensorInAirMoistureMax float64
+	SensorInAirDropMin     float64
+	SensorInAirECMax       float64
+
+	// ECRepotFactor and ECFlushHorizonDays tune ec_drift_advice: the multiple of the
+	// plant's maximum EC that calls for a repot, and how many days ahead a rising trend
+	// may cross the maximum before a flush is advised
+	ECRepotFactor      float64
+	ECFlushHorizonDays float64
+
+	// BeginnerMode words care summaries and condition comparisons in plain language
+	// (qualitative light and fertilizer levels instead of lux and EC); those tools can
+	// override it per call
+	BeginnerMode bool
+
+	// Precision overrides the decimal places of numbers in care summaries and condition
+	// comparisons. Nil keeps the defaults (one decimal for temperature, none otherwise).
+	Precision *int
+
+	// ServerName is the name advertised to MCP clients during initialization
+	ServerName string
+
+	// ServerInstructions is an optional usage hint sent to MCP clients during initialization
+	ServerInstructions string
 }
 
 // LoadConfig loads configuration from environment, file, and flags
@@ -33,14 +144,37 @@ func LoadConfig(configPath string) (*Config, error) {
 	// Set defaults
 	v.SetDefault("cache_enabled", true)
 	v.SetDefault("cache_ttl_hours", 24)
+	v.SetDefault("cache_max_entries", defaultCacheMaxEntries)
+	v.SetDefault("cache_backend", cacheBackendMemory)
+	v.SetDefault("cache_dir", defaultCacheDir())
 	v.SetDefault("default_language", "en")
+	v.SetDefault("language_fallback", "en")
+	v.SetDefault("badge_critical_deviation", defaultBadgeCriticalDeviation)
+	v.SetDefault("include_trace_in_errors", false)
+	v.SetDefault("transport", transportStdio)
+	v.SetDefault("listen_addr", defaultListenAddr)
+	v.SetDefault("max_request_bytes", defaultMaxRequestBytes)
+	v.SetDefault("max_batch_size", defaultMaxBatchSize)
+	v.SetDefault("fetch_concurrency", defaultFetchConcurrency)
+	v.SetDefault("retry_max_attempts", defaultRetryMaxAttempts)
+	v.SetDefault("retry_base_delay", defaultRetryBaseDelay)
+	v.SetDefault("rate_limit_per_minute", 0)
+	v.SetDefault("rate_limit_mode", rateLimitModeWait)
+	v.SetDefault("slow_call_threshold_ms", defaultSlowCallThresholdMs)
+	v.SetDefault("aggregation", aggregateMean)
+	v.SetDefault("sensor_in_air_moisture_max", defaultSensorInAirMoistureMax)
+	v.SetDefault("sensor_in_air_drop_min", defaultSensorInAirDropMin)
+	v.SetDefault("sensor_in_air_ec_max", defaultSensorInAirECMax)
+	v.SetDefault("ec_repot_factor", defaultECRepotFactor)
+	v.SetDefault("ec_flush_horizon_days", defaultECFlushHorizonDays)
+	v.SetDefault("server_name", defaultServerName)
 	v.SetDefault("log_level", "info")
 
 	// Environment variables (highest priority)
 	v.SetEnvPrefix("OPENPLANTBOOK")
 	v.AutomaticEnv()
 
-	// Config file (if provided)
+	// Config file (if provided); viper picks the format from the extension
 	if configPath != "" {
 		v.SetConfigFile(configPath)
 		if err := v.ReadInConfig(); err != nil {
@@ -53,12 +187,11 @@ func LoadConfig(configPath string) (*Config, error) {
 		// Try default config locations
 		home, err := os.UserHomeDir()
 		if err == nil {
-			v.AddConfigPath(home + "/.config/openplantbook-mcp")
-			v.AddConfigPath(home)
-			v.SetConfigName("config")
-			v.SetConfigType("json")
-			// Ignore errors for optional config file
-			_ = v.ReadInConfig()
+			if path := findDefaultConfig(home); path != "" {
+				v.SetConfigFile(path)
+				// Ignore errors for optional config file
+				_ = v.ReadInConfig()
+			}
 		}
 	}
 
@@ -71,6 +204,36 @@ func LoadConfig(configPath string) (*Config, error) {
 		CacheEnabled: v.GetBool("cache_enabled"),
 		CacheTTL:     v.GetInt("cache_ttl_hours"),
 		DefaultLang:  v.GetString("default_language"),
+
+		CacheMaxEntries:        v.GetInt("cache_max_entries"),
+		CacheBackend:           strings.ToLower(v.GetString("cache_backend")),
+		CacheDir:               v.GetString("cache_dir"),
+		LanguageFallback:       parseLanguageList(v.Get("language_fallback")),
+		BadgeCriticalDeviation: v.GetFloat64("badge_critical_deviation"),
+		IncludeTraceInErrors:   v.GetBool("include_trace_in_errors"),
+		Transport:              strings.ToLower(strings.TrimSpace(v.GetString("transport"))),
+		ListenAddr:             v.GetString("listen_addr"),
+		ServerAuthToken:        v.GetString("server_auth_token"),
+		MaxRequestBytes:        v.GetInt64("max_request_bytes"),
+		MaxBatchSize:           v.GetInt("max_batch_size"),
+		FetchConcurrency:       v.GetInt("fetch_concurrency"),
+		RetryMaxAttempts:       v.GetInt("retry_max_attempts"),
+		RetryBaseDelay:         v.GetDuration("retry_base_delay"),
+		RateLimitPerMinute:     v.GetInt("rate_limit_per_minute"),
+		RateLimitMode:          strings.ToLower(v.GetString("rate_limit_mode")),
+		SlowCallThresholdMs:    v.GetInt("slow_call_threshold_ms"),
+		Aggregation:            strings.ToLower(v.GetString("aggregation")),
+		BaselineProfile:        parseBaselineProfile(v.Get("baseline_profile")),
+		HealthWeights:          parseConfiguredHealthWeights(v.Get("health_weights")),
+		SensorInAirMoistureMax: v.GetFloat64("sensor_in_air_moisture_max"),
+		SensorInAirDropMin:     v.GetFloat64("sensor_in_air_drop_min"),
+		SensorInAirECMax:       v.GetFloat64("sensor_in_air_ec_max"),
+		ECRepotFactor:          v.GetFloat64("ec_repot_factor"),
+		ECFlushHorizonDays:     v.GetFloat64("ec_flush_horizon_days"),
+		BeginnerMode:           v.GetBool("beginner_mode"),
+		Precision:              parsePrecision(v.Get("precision")),
+		ServerName:             v.GetString("server_name"),
+		ServerInstructions:     v.GetString("server_instructions"),
 	}
 
 	// Parse log level
@@ -101,3 +264,60 @@ func LoadConfig(configPath string) (*Config, error) {
 
 	return config, nil
 }
+
+// configExtensions lists the config file formats searched for in each default directory,
+// in priority order
+var configExtensions = []string{"json", "yaml", "yml", "toml"}
+
+// findDefaultConfig returns the first config file found in the default directories, or ""
+// when there is none. Directories are searched in order and, within one, config.json wins
+// over config.yaml, config.yml and config.toml.
+func findDefaultConfig(home string) string {
+	for _, dir := range defaultConfigDirs(home) {
+		for _, ext := range configExtensions {
+			path := filepath.Join(dir, "config."+ext)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path
+			}
+		}
+	}
+	return ""
+}
+
+// defaultConfigDirs lists the directories searched for a config file, in priority order.
+// filepath.Join keeps the paths valid with Windows separators or a trailing slash on home.
+func defaultConfigDirs(home string) []string {
+	return []string{
+		filepath.Join(home, ".config", "openplantbook-mcp"),
+		filepath.Clean(home),
+	}
+}
+
+// validateCredentials checks that the configured credentials are well formed.
+// The API key wins when both methods are set, matching New.
+func validateCredentials(config *Config) error {
+	if config.APIKey != "" {
+		if strings.IndexFunc(config.APIKey, unicode.IsSpace) >= 0 {
+			return errors.New("api_key contains whitespace (check for stray spaces or newlines when copying it)")
+		}
+		return nil
+	}
+
+	switch {
+	case config.ClientID == "" && config.ClientSecret == "":
+		return errors.New("provide either api_key OR (client_id and client_secret)")
+	case config.ClientID == "":
+		return errors.New("client_id is empty but client_secret is set; OAuth2 needs both")
+	case config.ClientSecret == "":
+		return errors.New("client_secret is empty but client_id is set; OAuth2 needs both")
+	}
+
+	if strings.IndexFunc(config.ClientID, unicode.IsSpace) >= 0 {
+		return errors.New("client_id contains whitespace (check for stray spaces or newlines when copying it)")
+	}
+	if strings.IndexFunc(config.ClientSecret, unicode.IsSpace) >= 0 {
+		return errors.New("client_secret contains whitespace (check for stray spaces or newlines when copying it)")
+	}
+
+	return nil
+}
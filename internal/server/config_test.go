@@ -0,0 +1,189 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDefaultConfigDirs(t *testing.T) {
+	home := filepath.Join(t.TempDir(), "user name")
+
+	expected := []string{
+		filepath.Join(home, ".config", "openplantbook-mcp"),
+		home,
+	}
+
+	for _, input := range []string{home, home + string(filepath.Separator), home + string(filepath.Separator) + string(filepath.Separator)} {
+		if dirs := defaultConfigDirs(input); !reflect.DeepEqual(dirs, expected) {
+			t.Errorf("defaultConfigDirs(%q) = %v, want %v", input, dirs, expected)
+		}
+	}
+}
+
+func TestLoadConfig_DefaultLocation(t *testing.T) {
+	home := filepath.Join(t.TempDir(), "user name")
+	dir := filepath.Join(home, ".config", "openplantbook-mcp")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config.json"), []byte(`{"api_key": "from-file"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	// A trailing separator must not break the search path
+	t.Setenv("HOME", home+string(filepath.Separator))
+	t.Setenv("USERPROFILE", home+string(filepath.Separator))
+	for _, key := range []string{"OPENPLANTBOOK_API_KEY", "OPENPLANTBOOK_CLIENT_ID", "OPENPLANTBOOK_CLIENT_SECRET"} {
+		t.Setenv(key, "")
+	}
+
+	config, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if config.APIKey != "from-file" {
+		t.Errorf("APIKey = %q, want value from %s", config.APIKey, dir)
+	}
+}
+
+// clearCredentialEnv stops credentials in the environment from overriding config files
+func clearCredentialEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{"OPENPLANTBOOK_API_KEY", "OPENPLANTBOOK_CLIENT_ID", "OPENPLANTBOOK_CLIENT_SECRET"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoadConfig_Formats(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{"json", "config.json", `{
+  "api_key": "from-file",
+  "cache_ttl_hours": 12,
+  "language_fallback": ["de", "en"],
+  "retry_base_delay": "1s",
+  "baseline_profile": {"humidity": {"min": 50, "max": 70}}
+}`},
+		{"yaml", "config.yaml", `api_key: from-file
+cache_ttl_hours: 12
+language_fallback: [de, en]
+retry_base_delay: 1s
+baseline_profile:
+  humidity: {min: 50, max: 70}
+`},
+		{"yml", "config.yml", `api_key: from-file
+cache_ttl_hours: 12
+language_fallback:
+  - de
+  - en
+retry_base_delay: 1s
+baseline_profile:
+  humidity: [50, 70]
+`},
+		{"toml", "config.toml", `api_key = "from-file"
+cache_ttl_hours = 12
+language_fallback = ["de", "en"]
+retry_base_delay = "1s"
+
+[baseline_profile]
+humidity = { min = 50, max = 70 }
+`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearCredentialEnv(t)
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			config, err := LoadConfig(path)
+			if err != nil {
+				t.Fatalf("LoadConfig() error = %v", err)
+			}
+			if config.APIKey != "from-file" {
+				t.Errorf("APIKey = %q, want from-file", config.APIKey)
+			}
+			if config.CacheTTL != 12 {
+				t.Errorf("CacheTTL = %d, want 12", config.CacheTTL)
+			}
+			if want := []string{"de", "en"}; !reflect.DeepEqual(config.LanguageFallback, want) {
+				t.Errorf("LanguageFallback = %v, want %v", config.LanguageFallback, want)
+			}
+			if config.RetryBaseDelay != time.Second {
+				t.Errorf("RetryBaseDelay = %v, want 1s", config.RetryBaseDelay)
+			}
+			if want := map[string][2]float64{"humidity": {50, 70}}; !reflect.DeepEqual(config.BaselineProfile, want) {
+				t.Errorf("BaselineProfile = %v, want %v", config.BaselineProfile, want)
+			}
+		})
+	}
+}
+
+func TestFindDefaultConfig(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string // relative to home
+		want  string
+	}{
+		{"none", nil, ""},
+		{"yaml only", []string{".config/openplantbook-mcp/config.yaml"}, ".config/openplantbook-mcp/config.yaml"},
+		{"json wins in the same directory", []string{".config/openplantbook-mcp/config.toml", ".config/openplantbook-mcp/config.yaml", ".config/openplantbook-mcp/config.json"}, ".config/openplantbook-mcp/config.json"},
+		{"yaml wins over toml", []string{".config/openplantbook-mcp/config.toml", ".config/openplantbook-mcp/config.yaml"}, ".config/openplantbook-mcp/config.yaml"},
+		{"config directory wins over home", []string{"config.json", ".config/openplantbook-mcp/config.toml"}, ".config/openplantbook-mcp/config.toml"},
+		{"home fallback", []string{"config.yml"}, "config.yml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			for _, f := range tt.files {
+				path := filepath.Join(home, filepath.FromSlash(f))
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte("api_key: x"), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			want := ""
+			if tt.want != "" {
+				want = filepath.Join(home, filepath.FromSlash(tt.want))
+			}
+			if got := findDefaultConfig(home); got != want {
+				t.Errorf("findDefaultConfig() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_DefaultLocationYAML(t *testing.T) {
+	home := t.TempDir()
+	dir := filepath.Join(home, ".config", "openplantbook-mcp")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("api_key: from-yaml\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	clearCredentialEnv(t)
+
+	config, err := LoadConfig("")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if config.APIKey != "from-yaml" {
+		t.Errorf("APIKey = %q, want value from config.yaml", config.APIKey)
+	}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// parameterDeprecation marks a tool parameter as superseded. The old parameter keeps
+// working; callers get a warning naming the replacement.
+type parameterDeprecation struct {
+	param       string
+	replacement string
+}
+
+// deprecatedParameters lists deprecated parameters per tool name.
+// Add an entry when a parameter is superseded, e.g.
+//
+//	"get_care_summary": {{param: "metric", replacement: "temp_unit"}},
+var deprecatedParameters = map[string][]parameterDeprecation{
+	"compare_plants": {{param: "pid_a", replacement: "pids"}, {param: "pid_b", replacement: "pids"}},
+}
+
+// deprecationNotice is the warning shown when a deprecated parameter is used
+func deprecationNotice(d parameterDeprecation) string {
+	return fmt.Sprintf("⚠️ Deprecated parameter: `%s` is deprecated and will be removed in a future release; use `%s` instead. It is still honored for now.", d.param, d.replacement)
+}
+
+// markDeprecatedProperties flags deprecated parameters in a tool's input schema so
+// clients can see them before calling
+func markDeprecatedProperties(tool *mcp.Tool, deprecations []parameterDeprecation) {
+	for _, d := range deprecations {
+		prop, ok := tool.InputSchema.Properties[d.param].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		marked := make(map[string]interface{}, len(prop)+1)
+		for k, v := range prop {
+			marked[k] = v
+		}
+		marked["deprecated"] = true
+		if desc, ok := prop["description"].(string); ok {
+			marked["description"] = fmt.Sprintf("%s (deprecated: use %s)", desc, d.replacement)
+		}
+		tool.InputSchema.Properties[d.param] = marked
+	}
+}
+
+// withDeprecations wraps a tool handler so using a deprecated parameter is logged and
+// a notice is appended to the result. The handler still sees the old parameter.
+func (s *Server) withDeprecations(toolName string, deprecations []parameterDeprecation, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	if len(deprecations) == 0 {
+		return handler
+	}
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		result, err := handler(ctx, request)
+		if result == nil {
+			return result, err
+		}
+
+		args := request.GetArguments()
+		for _, d := range deprecations {
+			if _, used := args[d.param]; !used {
+				continue
+			}
+			s.logger.Warn("deprecated parameter used",
+				"trace_id", traceIDFromContext(ctx), "tool", toolName, "param", d.param, "replacement", d.replacement)
+			result.Content = append(result.Content, mcp.NewTextContent(deprecationNotice(d)))
+		}
+		return result, err
+	}
+}
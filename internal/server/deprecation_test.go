@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestWithDeprecations(t *testing.T) {
+	// metric is superseded by temp_unit but must keep working
+	deprecations := []parameterDeprecation{{param: "metric", replacement: "temp_unit"}}
+
+	s := newTestServer(t, &fakeClient{})
+	handler := s.withDeprecations("get_care_summary", deprecations, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if request.GetBool("metric", true) {
+			return mcp.NewToolResultText("°C"), nil
+		}
+		return mcp.NewToolResultText("°F"), nil
+	})
+
+	tests := []struct {
+		name       string
+		args       map[string]interface{}
+		wantText   string
+		wantNotice bool
+	}{
+		{"old parameter honored with notice", map[string]interface{}{"metric": false}, "°F", true},
+		{"new parameter has no notice", map[string]interface{}{"temp_unit": "celsius"}, "°C", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+
+			result, err := handler(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if text := resultText(t, result); text != tt.wantText {
+				t.Errorf("result text = %q, want %q", text, tt.wantText)
+			}
+
+			hasNotice := len(result.Content) == 2
+			if hasNotice != tt.wantNotice {
+				t.Fatalf("notice present = %v, want %v (content: %v)", hasNotice, tt.wantNotice, result.Content)
+			}
+			if hasNotice {
+				notice := result.Content[1].(mcp.TextContent).Text
+				if !strings.Contains(notice, "`metric` is deprecated") || !strings.Contains(notice, "`temp_unit`") {
+					t.Errorf("unexpected notice: %s", notice)
+				}
+			}
+		})
+	}
+}
+
+func TestMarkDeprecatedProperties(t *testing.T) {
+	tool := mcp.Tool{InputSchema: mcp.ToolInputSchema{Properties: map[string]interface{}{
+		"metric": map[string]interface{}{"type": "boolean", "description": "Use metric units"},
+	}}}
+
+	markDeprecatedProperties(&tool, []parameterDeprecation{{param: "metric", replacement: "temp_unit"}})
+
+	prop := tool.InputSchema.Properties["metric"].(map[string]interface{})
+	if prop["deprecated"] != true {
+		t.Error("expected deprecated flag on schema property")
+	}
+	if prop["description"] != "Use metric units (deprecated: use temp_unit)" {
+		t.Errorf("description = %q", prop["description"])
+	}
+}
@@ -0,0 +1,342 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Symptom is an observed problem and the care issues that commonly cause it
+type Symptom struct {
+	Label  string
+	Causes []SymptomCause
+}
+
+// SymptomCause is one care issue behind a symptom: a metric (a careMetrics key or
+// soil_ec) being above or below the plant's ideal range
+type SymptomCause struct {
+	Metric string
+	High   bool    // true when the metric is above the range, false when below
+	Weight float64 // how often this cause is behind the symptom, 0-1
+	Cause  string
+	Action string // corrective action; %s is replaced with the plant's ideal range
+}
+
+// Symptoms is the vocabulary accepted by diagnose_symptoms, keyed by symptom name.
+// Add an entry here to teach the tool a new symptom.
+var Symptoms = map[string]Symptom{
+	"yellow_leaves": {
+		Label: "Yellow leaves",
+		Causes: []SymptomCause{
+			{"moisture", true, 1.0, "Overwatering", "Let the soil dry to the bottom of the %s band before watering again, and empty the saucer"},
+			{"moisture", false, 0.6, "Underwatering", "Water thoroughly whenever the soil nears the bottom of the %s band"},
+			{"light_lux", false, 0.5, "Too little light", "Move it somewhere brighter, aiming for %s"},
+			{"soil_ec", false, 0.4, "Nutrient deficiency", "Feed with a balanced fertilizer until the soil EC sits within %s"},
+		},
+	},
+	"brown_tips": {
+		Label: "Brown leaf tips",
+		Causes: []SymptomCause{
+			{"humidity", false, 1.0, "Low humidity", "Raise humidity to %s with a humidifier or pebble tray, away from heaters"},
+			{"soil_ec", true, 0.7, "Fertilizer salt build-up", "Flush the pot with plain water and feed less until the soil EC is back within %s"},
+			{"moisture", false, 0.6, "Underwatering", "Water before the soil drops below the %s band"},
+		},
+	},
+	"drooping": {
+		Label: "Drooping or wilting",
+		Causes: []SymptomCause{
+			{"moisture", false, 1.0, "Underwatering", "Water thoroughly now and keep the soil within %s"},
+			{"moisture", true, 0.8, "Overwatering", "Check the roots for rot and let the soil dry back to %s before watering"},
+			{"temperature", true, 0.5, "Heat stress", "Move it somewhere cooler, within %s"},
+		},
+	},
+	"leaf_drop": {
+		Label: "Leaf drop",
+		Causes: []SymptomCause{
+			{"temperature", false, 1.0, "Cold or drafts", "Keep it away from cold windows and drafts, within %s"},
+			{"moisture", true, 0.6, "Overwatering", "Let the soil dry to the bottom of the %s band before watering again"},
+			{"light_lux", false, 0.5, "Too little light", "Move it somewhere brighter, aiming for %s"},
+		},
+	},
+	"leggy_growth": {
+		Label: "Leggy, stretched growth",
+		Causes: []SymptomCause{
+			{"light_lux", false, 1.0, "Too little light", "Move it to a brighter spot or under a grow light, aiming for %s"},
+			{"temperature", true, 0.4, "Too warm for the light it gets", "Keep it within %s, toward the cooler end if light is limited"},
+		},
+	},
+	"leaf_scorch": {
+		Label: "Scorched or bleached patches",
+		Causes: []SymptomCause{
+			{"light_lux", true, 1.0, "Too much direct sun", "Move it out of direct midday sun or filter the light, aiming for %s"},
+			{"temperature", true, 0.6, "Heat stress", "Move it somewhere cooler, within %s"},
+			{"moisture", false, 0.4, "Underwatering", "Keep the soil within %s so the leaves can cool themselves"},
+		},
+	},
+	"pale_leaves": {
+		Label: "Pale or washed-out leaves",
+		Causes: []SymptomCause{
+			{"soil_ec", false, 0.8, "Nutrient deficiency", "Feed with a balanced fertilizer until the soil EC sits within %s"},
+			{"light_lux", false, 0.7, "Too little light", "Move it somewhere brighter, aiming for %s"},
+			{"light_lux", true, 0.5, "Too much direct sun", "Filter the light, aiming for %s"},
+		},
+	},
+	"mushy_stem": {
+		Label: "Soft or mushy stem",
+		Causes: []SymptomCause{
+			{"moisture", true, 1.0, "Overwatering", "Stop watering, cut away rotten tissue and let the soil dry back to %s"},
+			{"temperature", false, 0.5, "Cold, wet soil", "Move it somewhere warmer, within %s, until the soil dries"},
+		},
+	},
+	"white_crust": {
+		Label: "White crust on the soil",
+		Causes: []SymptomCause{
+			{"soil_ec", true, 1.0, "Fertilizer salt build-up", "Scrape off the crust, flush the pot with plain water and feed less until the soil EC is within %s"},
+		},
+	},
+	"mold_on_soil": {
+		Label: "Mold or fungus on the soil",
+		Causes: []SymptomCause{
+			{"moisture", true, 1.0, "Soil kept too wet", "Let the top of the soil dry out and keep moisture within %s"},
+			{"humidity", true, 0.6, "Stagnant, humid air", "Improve air flow and keep humidity within %s"},
+		},
+	},
+}
+
+// symptomNames returns the accepted symptom names, sorted
+func symptomNames() []string {
+	names := make([]string, 0, len(Symptoms))
+	for name := range Symptoms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// normalizeSymptom maps "Yellow leaves" or "yellow-leaves" to the yellow_leaves key
+func normalizeSymptom(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(s)
+}
+
+// Likelihood labels for ranked causes
+const (
+	likelihoodLikely   = "likely"
+	likelihoodPossible = "possible"
+	likelihoodLess     = "less likely"
+)
+
+const (
+	// symptomProfileBoost scales a cause's weight when the plant's range makes it more
+	// (or, inverted, less) plausible than for a typical houseplant
+	symptomProfileBoost = 1.5
+
+	// symptomNoDataFactor scales a cause's weight when the plant has no range for its metric
+	symptomNoDataFactor = 0.8
+)
+
+// rankedCause is a symptom cause scored for one plant
+type rankedCause struct {
+	cause     SymptomCause
+	score     float64
+	reason    string // why the plant's profile changed the score, or ""
+	rangeText string
+}
+
+// likelihood names the score band
+func (c rankedCause) likelihood() string {
+	switch {
+	case c.score >= 1:
+		return likelihoodLikely
+	case c.score >= 0.6:
+		return likelihoodPossible
+	}
+	return likelihoodLess
+}
+
+// symptomDiagnosis is the ranked causes of one symptom
+type symptomDiagnosis struct {
+	name    string
+	symptom Symptom
+	causes  []rankedCause
+}
+
+// diagnoseSymptoms ranks each symptom's causes for the plant. A cause is boosted when the
+// plant's range sits on the side that makes it easy to fall out of (a plant that needs
+// more humidity than average is more likely to suffer from low humidity) and damped on
+// the other side. Metrics without plant data fall back to the baseline range.
+func diagnoseSymptoms(details *openplantbook.PlantDetails, names []string, baseline map[string]valueRange) []symptomDiagnosis {
+	shifts := map[string]baselineDelta{}
+	for _, d := range computeBaselineDeltas(details, baseline) {
+		shifts[d.metric.key] = d
+	}
+
+	var diagnoses []symptomDiagnosis
+	for _, name := range names {
+		symptom := Symptoms[name]
+		diagnosis := symptomDiagnosis{name: name, symptom: symptom}
+		for _, cause := range symptom.Causes {
+			ranked := rankedCause{cause: cause, score: cause.Weight}
+			if lo, hi, ok := symptomMetricRange(details, cause.Metric); ok {
+				ranked.rangeText = symptomRangeText(cause.Metric, lo, hi)
+			} else {
+				ranked.score *= symptomNoDataFactor
+				if base, ok := baseline[cause.Metric]; ok {
+					ranked.rangeText = symptomRangeText(cause.Metric, base.min, base.max) + " (typical houseplant range; no data for this plant)"
+				} else {
+					ranked.rangeText = "the plant's ideal range"
+				}
+			}
+
+			if delta, ok := shifts[cause.Metric]; ok {
+				// A cause below the range is more likely for a plant that needs more
+				// than average, and vice versa
+				needsMore := delta.shift > baselineSimilarFraction
+				needsLess := delta.shift < -baselineSimilarFraction
+				switch {
+				case (needsMore && !cause.High) || (needsLess && cause.High):
+					ranked.score *= symptomProfileBoost
+					ranked.reason = delta.describe()
+				case (needsMore && cause.High) || (needsLess && !cause.High):
+					ranked.score /= symptomProfileBoost
+					ranked.reason = delta.describe()
+				}
+			}
+			diagnosis.causes = append(diagnosis.causes, ranked)
+		}
+		sort.SliceStable(diagnosis.causes, func(i, j int) bool {
+			return diagnosis.causes[i].score > diagnosis.causes[j].score
+		})
+		diagnoses = append(diagnoses, diagnosis)
+	}
+	return diagnoses
+}
+
+// symptomMetricRange returns the plant's ideal range for a cause's metric
+func symptomMetricRange(details *openplantbook.PlantDetails, metric string) (float64, float64, bool) {
+	if metric == "soil_ec" {
+		return float64(details.MinSoilEC), float64(details.MaxSoilEC), details.MaxSoilEC > 0
+	}
+	for _, m := range careMetrics {
+		if m.key == metric {
+			return m.ideal(details)
+		}
+	}
+	return 0, 0, false
+}
+
+// symptomRangeText renders a range with its metric's unit
+func symptomRangeText(metric string, lo, hi float64) string {
+	unit := " µS/cm"
+	for _, m := range careMetrics {
+		if m.key == metric {
+			unit = m.unit
+		}
+	}
+	return fmt.Sprintf("%g-%g%s", lo, hi, unit)
+}
+
+// commonCause returns the cause ranked likely for the most symptoms, when one explains
+// two or more of them
+func commonCause(diagnoses []symptomDiagnosis) (string, []string) {
+	explains := map[string][]string{}
+	var order []string
+	for _, d := range diagnoses {
+		for _, c := range d.causes {
+			if c.likelihood() != likelihoodLikely {
+				continue
+			}
+			if _, seen := explains[c.cause.Cause]; !seen {
+				order = append(order, c.cause.Cause)
+			}
+			explains[c.cause.Cause] = append(explains[c.cause.Cause], strings.ToLower(d.symptom.Label))
+		}
+	}
+	best := ""
+	for _, cause := range order {
+		if len(explains[cause]) >= 2 && (best == "" || len(explains[cause]) > len(explains[best])) {
+			best = cause
+		}
+	}
+	return best, explains[best]
+}
+
+// formatSymptomDiagnosis renders each symptom's ranked causes with actions tied to the
+// plant's ranges
+func formatSymptomDiagnosis(details *openplantbook.PlantDetails, diagnoses []symptomDiagnosis) string {
+	output := fmt.Sprintf("# Symptom Diagnosis for %s\n\n", details.Alias)
+	if cause, symptoms := commonCause(diagnoses); cause != "" {
+		output += fmt.Sprintf("**Common thread**: %s could explain %s - check it first.\n\n", cause, strings.Join(symptoms, " and "))
+	}
+
+	for _, d := range diagnoses {
+		output += fmt.Sprintf("## %s\n\n", d.symptom.Label)
+		for i, c := range d.causes {
+			output += fmt.Sprintf("%d. **%s** (%s)", i+1, c.cause.Cause, c.likelihood())
+			if c.reason != "" {
+				output += fmt.Sprintf(" - this plant %s", c.reason)
+			}
+			output += "\n"
+			output += fmt.Sprintf("   - **Fix**: %s\n", fmt.Sprintf(c.cause.Action, c.rangeText))
+		}
+		output += "\n"
+	}
+
+	output += "_Causes are ranked by how often they produce each symptom, adjusted for this plant's ideal ranges. Confirm with compare_conditions before changing care._\n"
+	return output
+}
+
+// handleDiagnoseSymptoms handles the diagnose_symptoms tool
+func (s *Server) handleDiagnoseSymptoms(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "diagnose_symptoms")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	raw := request.GetStringSlice("symptoms", nil)
+	if len(raw) == 0 {
+		logger.Warn("missing symptoms parameter")
+		return mcp.NewToolResultError(fmt.Sprintf("symptoms parameter is required: a list of one or more of %s", strings.Join(symptomNames(), ", "))), nil
+	}
+	var names []string
+	seen := map[string]bool{}
+	for _, r := range raw {
+		name := normalizeSymptom(r)
+		if _, ok := Symptoms[name]; !ok {
+			logger.Warn("unknown symptom", "symptom", r)
+			return mcp.NewToolResultError(fmt.Sprintf("unknown symptom %q: use one of %s", r, strings.Join(symptomNames(), ", "))), nil
+		}
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	logger.Info("diagnosing symptoms", "pid", pid, "symptoms", names)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	diagnoses := diagnoseSymptoms(details, names, s.baselineProfile())
+
+	logger.Info("symptoms diagnosed", "pid", details.PID, "symptoms", len(diagnoses))
+
+	return mcp.NewToolResultText(formatSymptomDiagnosis(details, diagnoses)), nil
+}
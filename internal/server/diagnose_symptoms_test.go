@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestSymptomsVocabulary(t *testing.T) {
+	for name, symptom := range Symptoms {
+		if name != normalizeSymptom(name) {
+			t.Errorf("symptom %q is not in normalized form", name)
+		}
+		if symptom.Label == "" || len(symptom.Causes) == 0 {
+			t.Errorf("symptom %q needs a label and at least one cause", name)
+		}
+		for _, c := range symptom.Causes {
+			if _, ok := defaultBaselineProfile[c.Metric]; !ok {
+				t.Errorf("symptom %q cause %q has unknown metric %q", name, c.Cause, c.Metric)
+			}
+			if c.Weight <= 0 || c.Weight > 1 {
+				t.Errorf("symptom %q cause %q weight = %v, want (0, 1]", name, c.Cause, c.Weight)
+			}
+			if strings.Count(c.Action, "%s") != 1 {
+				t.Errorf("symptom %q cause %q action must contain exactly one %%s: %q", name, c.Cause, c.Action)
+			}
+		}
+	}
+}
+
+func TestDiagnoseSymptoms(t *testing.T) {
+	fern := &openplantbook.PlantDetails{Alias: "Boston Fern", MinEnvHumid: 60, MaxEnvHumid: 90, MinSoilMoist: 30, MaxSoilMoist: 60}
+	cactus := &openplantbook.PlantDetails{Alias: "Cactus", MinEnvHumid: 10, MaxEnvHumid: 40, MinSoilMoist: 5, MaxSoilMoist: 30}
+
+	tests := []struct {
+		name       string
+		details    *openplantbook.PlantDetails
+		symptom    string
+		top        string
+		likelihood string
+		want       []string
+	}{
+		{"humid plant brown tips", fern, "brown_tips", "Low humidity", likelihoodLikely, []string{"this plant needs more humidity than average", "Raise humidity to 60-90%"}},
+		{"dry plant brown tips", cactus, "brown_tips", "Low humidity", likelihoodPossible, []string{"this plant prefers drier air than average", "Raise humidity to 10-40%"}},
+		{"dry plant yellow leaves", cactus, "yellow_leaves", "Overwatering", likelihoodLikely, []string{"bottom of the 5-30% band"}},
+		{"no data falls back to baseline", fern, "white_crust", "Fertilizer salt build-up", likelihoodPossible, []string{"350-1500 µS/cm (typical houseplant range; no data for this plant)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diagnoses := diagnoseSymptoms(tt.details, []string{tt.symptom}, defaultBaselineProfile)
+			top := diagnoses[0].causes[0]
+			if top.cause.Cause != tt.top || top.likelihood() != tt.likelihood {
+				t.Errorf("top cause = %q (%s), want %q (%s)", top.cause.Cause, top.likelihood(), tt.top, tt.likelihood)
+			}
+			output := formatSymptomDiagnosis(tt.details, diagnoses)
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleDiagnoseSymptoms(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"nephrolepis exaltata|en": {PID: "nephrolepis exaltata", Alias: "Boston Fern", MinEnvHumid: 60, MaxEnvHumid: 90, MinSoilMoist: 30, MaxSoilMoist: 60, MinTemp: 15, MaxTemp: 27},
+	}}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    []string
+	}{
+		{"ranked causes", map[string]interface{}{"pid": "nephrolepis exaltata", "symptoms": []interface{}{"Brown Tips", "drooping"}}, "", []string{"## Brown leaf tips", "## Drooping or wilting", "1. **Low humidity** (likely)"}},
+		{"common thread", map[string]interface{}{"pid": "nephrolepis exaltata", "symptoms": []interface{}{"yellow_leaves", "mushy-stem"}}, "", []string{"**Common thread**: Overwatering"}},
+		{"missing symptoms", map[string]interface{}{"pid": "nephrolepis exaltata"}, "symptoms parameter is required", nil},
+		{"unknown symptom", map[string]interface{}{"pid": "nephrolepis exaltata", "symptoms": []interface{}{"sad"}}, `unknown symptom "sad": use one of brown_tips`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleDiagnoseSymptoms(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleDiagnoseSymptoms() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("result missing %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// defaultDuplicateThreshold is the care similarity at or above which two plants are
+// considered interchangeable
+const defaultDuplicateThreshold = 0.8
+
+// rangeOverlap returns the intersection over union of two ranges: 1 for identical
+// ranges, 0 for disjoint ones. Two identical single values count as identical.
+func rangeOverlap(aMin, aMax, bMin, bMax float64) float64 {
+	union := math.Max(aMax, bMax) - math.Min(aMin, bMin)
+	if union == 0 {
+		return 1
+	}
+	intersection := math.Min(aMax, bMax) - math.Max(aMin, bMin)
+	return math.Max(intersection, 0) / union
+}
+
+// careSimilarity scores two plants from 0 to 1 as the mean range overlap across the
+// metrics either has data for. A metric only one plant has data for scores 0, so
+// plants with different coverage aren't called duplicates.
+func careSimilarity(a, b *openplantbook.PlantDetails) float64 {
+	total, count := 0.0, 0
+	for _, m := range baselineMetrics {
+		aMin, aMax, aOK := m.ideal(a)
+		bMin, bMax, bOK := m.ideal(b)
+		switch {
+		case aOK && bOK:
+			total += rangeOverlap(aMin, aMax, bMin, bMax)
+		case !aOK && !bOK:
+			continue
+		}
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / float64(count)
+}
+
+// careCluster is a group of plants whose care is interchangeable
+type careCluster struct {
+	plants        []plantFetch
+	minSimilarity float64 // lowest pairwise similarity within the cluster
+}
+
+// clusterByCare groups plants so every pair within a cluster is at least threshold
+// similar. Plants join the first cluster they fit, in input order, so the result is
+// deterministic. Plants that failed to load are skipped.
+func clusterByCare(plants []plantFetch, threshold float64) []careCluster {
+	var clusters []careCluster
+	for _, p := range plants {
+		if p.err != nil {
+			continue
+		}
+
+		placed := false
+		for i := range clusters {
+			lowest := clusters[i].minSimilarity
+			fits := true
+			for _, member := range clusters[i].plants {
+				sim := careSimilarity(p.details, member.details)
+				if sim < threshold {
+					fits = false
+					break
+				}
+				lowest = math.Min(lowest, sim)
+			}
+			if fits {
+				clusters[i].plants = append(clusters[i].plants, p)
+				clusters[i].minSimilarity = lowest
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			clusters = append(clusters, careCluster{plants: []plantFetch{p}, minSimilarity: 1})
+		}
+	}
+	return clusters
+}
+
+// formatCareDuplicates renders the clusters with more than one plant, then the rest
+func formatCareDuplicates(plants []plantFetch, clusters []careCluster, threshold float64) string {
+	label := func(p plantFetch) string {
+		return fmt.Sprintf("%s (%s)", p.details.Alias, p.pid)
+	}
+
+	output := "# Care Duplicates\n\n"
+	output += fmt.Sprintf("Plants are grouped when every pair's care ranges overlap at least %.0f%%.\n\n", threshold*100)
+
+	groups := 0
+	var unique []string
+	for _, c := range clusters {
+		if len(c.plants) == 1 {
+			unique = append(unique, label(c.plants[0]))
+			continue
+		}
+		groups++
+		output += fmt.Sprintf("## Group %d - %d plants, %.0f%% similar\n\n", groups, len(c.plants), c.minSimilarity*100)
+		for _, p := range c.plants {
+			output += fmt.Sprintf("- %s\n", label(p))
+		}
+		output += "\nThese can share a spot and a watering and feeding routine.\n\n"
+	}
+	if groups == 0 {
+		output += "No plants have near-identical care; each needs its own routine.\n\n"
+	}
+
+	if len(unique) > 0 {
+		output += fmt.Sprintf("**Distinct care**: %s\n\n", strings.Join(unique, ", "))
+	}
+
+	var failed []string
+	for _, p := range plants {
+		if p.err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", p.pid, p.err))
+		}
+	}
+	if len(failed) > 0 {
+		output += fmt.Sprintf("**Could not load**: %s\n", strings.Join(failed, ", "))
+	}
+	return output
+}
+
+// handleFindCareDuplicates handles the find_care_duplicates tool
+func (s *Server) handleFindCareDuplicates(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "find_care_duplicates")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) < 2 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be an array of at least two strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	threshold := request.GetFloat("threshold", defaultDuplicateThreshold)
+	if threshold <= 0 || threshold > 1 {
+		logger.Warn("invalid threshold parameter", "threshold", threshold)
+		return mcp.NewToolResultError("threshold parameter must be a number between 0 (exclusive) and 1"), nil
+	}
+
+	logger.Info("finding care duplicates", "pids", len(pids), "threshold", threshold)
+
+	plants := s.fetchPlantsConcurrently(ctx, pids)
+	clusters := clusterByCare(plants, threshold)
+
+	logger.Info("care duplicates found", "pids", len(pids), "clusters", len(clusters))
+
+	return mcp.NewToolResultText(formatCareDuplicates(plants, clusters, threshold)), nil
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestRangeOverlap(t *testing.T) {
+	tests := []struct {
+		name                   string
+		aMin, aMax, bMin, bMax float64
+		want                   float64
+	}{
+		{"identical", 10, 20, 10, 20, 1},
+		{"disjoint", 10, 20, 30, 40, 0},
+		{"touching", 10, 20, 20, 30, 0},
+		{"half overlap", 10, 30, 20, 40, 1.0 / 3},
+		{"nested", 0, 100, 25, 75, 0.5},
+		{"same point", 5, 5, 5, 5, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rangeOverlap(tt.aMin, tt.aMax, tt.bMin, tt.bMax); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("rangeOverlap = %g, want %g", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCareSimilarity(t *testing.T) {
+	base := &openplantbook.PlantDetails{MinTemp: 15, MaxTemp: 30, MinEnvHumid: 40, MaxEnvHumid: 80}
+	same := *base
+	shifted := &openplantbook.PlantDetails{MinTemp: 15, MaxTemp: 30, MinEnvHumid: 60, MaxEnvHumid: 100}
+	extra := &openplantbook.PlantDetails{MinTemp: 15, MaxTemp: 30, MinEnvHumid: 40, MaxEnvHumid: 80, MinSoilEC: 300, MaxSoilEC: 900}
+
+	tests := []struct {
+		name string
+		b    *openplantbook.PlantDetails
+		want float64
+	}{
+		{"identical", &same, 1},
+		{"humidity half-shifted", shifted, (1 + 1.0/3) / 2},
+		{"metric only one has", extra, 2.0 / 3},
+		{"no shared data", &openplantbook.PlantDetails{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := careSimilarity(base, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("careSimilarity = %g, want %g", got, tt.want)
+			}
+			if got, rev := careSimilarity(base, tt.b), careSimilarity(tt.b, base); got != rev {
+				t.Errorf("similarity not symmetric: %g vs %g", got, rev)
+			}
+		})
+	}
+}
+
+func TestClusterByCare(t *testing.T) {
+	plant := func(pid, alias string, minT, maxT float64) plantFetch {
+		return plantFetch{pid: pid, details: &openplantbook.PlantDetails{PID: pid, Alias: alias, MinTemp: minT, MaxTemp: maxT}}
+	}
+	plants := []plantFetch{
+		plant("a", "Pothos", 15, 30),
+		plant("b", "Cactus", 5, 40),
+		plant("c", "Philodendron", 16, 30),
+		{pid: "x", err: errors.New("not found")},
+		plant("d", "Scindapsus", 15, 29),
+	}
+
+	clusters := clusterByCare(plants, 0.8)
+	if len(clusters) != 2 {
+		t.Fatalf("got %d clusters, want 2", len(clusters))
+	}
+	if got := len(clusters[0].plants); got != 3 {
+		t.Errorf("first cluster has %d plants, want Pothos, Philodendron and Scindapsus", got)
+	}
+	if clusters[0].minSimilarity < 0.8 {
+		t.Errorf("cluster similarity %g below threshold", clusters[0].minSimilarity)
+	}
+
+	output := formatCareDuplicates(plants, clusters, 0.8)
+	for _, want := range []string{"## Group 1 - 3 plants", "**Distinct care**: Cactus (b)", "**Could not load**: x (not found)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+}
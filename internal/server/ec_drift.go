@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Defaults for the EC drift decision
+const (
+	defaultECRepotFactor      = 1.5  // trend EC at this multiple of the maximum calls for a repot
+	defaultECFlushHorizonDays = 14.0 // a rising trend reaching the maximum within this many days calls for a flush
+)
+
+// EC drift actions, mildest last
+const (
+	ecActionRepot = "repot"
+	ecActionFlush = "flush"
+	ecActionFine  = "fine"
+)
+
+// ecDriftThresholds configures decideECAction
+type ecDriftThresholds struct {
+	repotFactor      float64
+	flushHorizonDays float64
+}
+
+// ecDriftThresholds returns the configured thresholds, falling back to the defaults
+func (s *Server) ecDriftThresholds() ecDriftThresholds {
+	t := ecDriftThresholds{
+		repotFactor:      defaultECRepotFactor,
+		flushHorizonDays: defaultECFlushHorizonDays,
+	}
+	if s.config.ECRepotFactor > 1 {
+		t.repotFactor = s.config.ECRepotFactor
+	}
+	if s.config.ECFlushHorizonDays > 0 {
+		t.flushHorizonDays = s.config.ECFlushHorizonDays
+	}
+	return t
+}
+
+// ecDecision is the outcome of the EC drift check
+type ecDecision struct {
+	action string
+	reason string
+	trend  linearTrend
+}
+
+// decideECAction turns an EC trend into flush, repot or fine. It looks at the fitted
+// trend value rather than the last reading, so one noisy sample doesn't force a repot:
+//
+//	trend at or above repotFactor x max        -> repot: salts are beyond what a flush clears
+//	trend above max                            -> flush
+//	rising, projected past max within horizon  -> flush before it gets there
+//	otherwise                                  -> fine
+func decideECAction(trend linearTrend, min, max float64, t ecDriftThresholds) ecDecision {
+	d := ecDecision{action: ecActionFine, trend: trend}
+	p := projectCrossing(trend, min, max)
+
+	switch {
+	case trend.latestFit >= max*t.repotFactor:
+		d.action = ecActionRepot
+		d.reason = fmt.Sprintf("EC is at %.0f µS/cm, %.1fx the plant's maximum; salts this concentrated are hard to flush out, so fresh soil is the reliable fix", trend.latestFit, trend.latestFit/max)
+	case p.status == "outside" && p.boundary == "maximum":
+		d.action = ecActionFlush
+		d.reason = fmt.Sprintf("EC is at %.0f µS/cm, above the %.0f µS/cm maximum; water through thoroughly to wash out excess salts", trend.latestFit, max)
+	case p.status == "crossing" && p.boundary == "maximum" && p.hours/24 <= t.flushHorizonDays:
+		d.action = ecActionFlush
+		d.reason = fmt.Sprintf("EC is rising %+.0f µS/cm/day and will pass the %.0f µS/cm maximum in about %s; flush now to get ahead of it", trend.slopePerHour*24, max, formatDuration(p.hours))
+	case p.status == "outside":
+		d.reason = fmt.Sprintf("EC is at %.0f µS/cm, below the %.0f µS/cm minimum; no salt buildup, but the plant may want feeding", trend.latestFit, min)
+	default:
+		d.reason = fmt.Sprintf("EC is at %.0f µS/cm, within the %.0f - %.0f µS/cm range with no buildup on the horizon", trend.latestFit, min, max)
+	}
+	return d
+}
+
+// formatECDrift renders the decision
+func formatECDrift(alias string, readings []timedReading, d ecDecision, min, max float64) string {
+	badge := map[string]string{ecActionRepot: "🔴", ecActionFlush: "🟡", ecActionFine: "🟢"}[d.action]
+
+	output := fmt.Sprintf("# EC Drift for %s\n\n", alias)
+	output += fmt.Sprintf("**Recommendation**: %s %s\n\n", badge, d.action)
+	output += d.reason + ".\n\n"
+	output += fmt.Sprintf("**Ideal range**: %.0f - %.0f µS/cm\n\n", min, max)
+	output += fmt.Sprintf("**Trend**: %+.1f µS/cm/day over %d readings (latest reading %.0f µS/cm)\n\n", d.trend.slopePerHour*24, len(readings), readings[len(readings)-1].value)
+	output += "_The trend is a linear fit of the supplied readings; fertilizing or flushing resets it._\n"
+	return output
+}
+
+// handleECDriftAdvice handles the ec_drift_advice tool
+func (s *Server) handleECDriftAdvice(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "ec_drift_advice")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	readings, err := parseTimedReadings(request.GetArguments()["readings"])
+	if err != nil {
+		logger.Warn("invalid readings parameter", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("readings %v", err)), nil
+	}
+	trend, ok := fitLinearTrend(readings)
+	if !ok {
+		logger.Warn("not enough readings", "readings", len(readings))
+		return mcp.NewToolResultError("readings needs at least two EC readings at different times"), nil
+	}
+
+	logger.Info("checking EC drift", "pid", pid, "readings", len(readings))
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxSoilEC <= 0 {
+		logger.Warn("plant lacks soil EC data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("EC drift needs a soil EC range, which is not available for this plant (%s)", pid)), nil
+	}
+
+	min, max := float64(details.MinSoilEC), float64(details.MaxSoilEC)
+	decision := decideECAction(trend, min, max, s.ecDriftThresholds())
+
+	logger.Info("EC drift checked", "pid", details.PID, "action", decision.action)
+
+	return mcp.NewToolResultText(formatECDrift(details.Alias, readings, decision, min, max)), nil
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestDecideECAction(t *testing.T) {
+	defaults := ecDriftThresholds{repotFactor: defaultECRepotFactor, flushHorizonDays: defaultECFlushHorizonDays}
+	day := func(perDay float64) float64 { return perDay / 24 }
+
+	tests := []struct {
+		name       string
+		latest     float64
+		perDay     float64
+		thresholds ecDriftThresholds
+		want       string
+	}{
+		{name: "stable in range", latest: 800, perDay: 0, thresholds: defaults, want: ecActionFine},
+		{name: "slow rise beyond horizon", latest: 800, perDay: 20, thresholds: defaults, want: ecActionFine},
+		{name: "rise within horizon", latest: 1800, perDay: 30, thresholds: defaults, want: ecActionFlush},
+		{name: "just above max", latest: 2100, perDay: 0, thresholds: defaults, want: ecActionFlush},
+		{name: "far above max", latest: 3000, perDay: 0, thresholds: defaults, want: ecActionRepot},
+		{name: "below min", latest: 200, perDay: -5, thresholds: defaults, want: ecActionFine},
+		{name: "stricter repot factor", latest: 2300, perDay: 0, thresholds: ecDriftThresholds{repotFactor: 1.1, flushHorizonDays: 14}, want: ecActionRepot},
+		{name: "longer horizon", latest: 800, perDay: 20, thresholds: ecDriftThresholds{repotFactor: 1.5, flushHorizonDays: 180}, want: ecActionFlush},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trend := linearTrend{slopePerHour: day(tt.perDay), latestFit: tt.latest}
+			if got := decideECAction(trend, 350, 2000, tt.thresholds); got.action != tt.want {
+				t.Errorf("action = %s (%s), want %s", got.action, got.reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestECDriftThresholds_Config(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	if got := srv.ecDriftThresholds(); got.repotFactor != defaultECRepotFactor || got.flushHorizonDays != defaultECFlushHorizonDays {
+		t.Errorf("defaults = %+v", got)
+	}
+
+	srv.config.ECRepotFactor = 2
+	srv.config.ECFlushHorizonDays = 30
+	if got := srv.ecDriftThresholds(); got.repotFactor != 2 || got.flushHorizonDays != 30 {
+		t.Errorf("configured = %+v", got)
+	}
+}
+
+func TestHandleECDriftAdvice(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MinSoilEC: 350, MaxSoilEC: 2000},
+	}})
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	reading := func(days int, value float64) map[string]interface{} {
+		return map[string]interface{}{"timestamp": start.AddDate(0, 0, days).Format(time.RFC3339), "value": value}
+	}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"pid":      "monstera deliciosa",
+		"readings": []interface{}{reading(0, 1200), reading(7, 1500), reading(14, 1800)},
+	}
+	result, err := srv.handleECDriftAdvice(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("ec_drift_advice failed: %v %s", err, resultText(t, result))
+	}
+	if text := resultText(t, result); !strings.Contains(text, "🟡 flush") {
+		t.Errorf("expected a flush recommendation:\n%s", text)
+	}
+
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa", "readings": []interface{}{reading(0, 1200)}}
+	result, _ = srv.handleECDriftAdvice(context.Background(), request)
+	if !result.IsError {
+		t.Error("expected a single reading to be rejected")
+	}
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// ecSevereFactor is how far above the plant's maximum EC a reading must be to count as severe
+const ecSevereFactor = 1.5
+
+// ecRiskLevel classifies a measured soil EC against the plant's range
+type ecRiskLevel string
+
+const (
+	ecRiskNone   ecRiskLevel = "none"   // no EC data for this plant
+	ecRiskLow    ecRiskLevel = "low"    // below range: underfed, no burn risk
+	ecRiskOK     ecRiskLevel = "ok"     // within range
+	ecRiskHigh   ecRiskLevel = "high"   // above range: salts building up
+	ecRiskSevere ecRiskLevel = "severe" // well above range: fertilizer burn likely
+)
+
+// assessECRisk compares a measured EC (µS/cm) to the plant's EC range
+func assessECRisk(details *openplantbook.PlantDetails, measured float64) ecRiskLevel {
+	if details.MaxSoilEC <= 0 {
+		return ecRiskNone
+	}
+	min, max := float64(details.MinSoilEC), float64(details.MaxSoilEC)
+	switch {
+	case measured > max*ecSevereFactor:
+		return ecRiskSevere
+	case measured > max:
+		return ecRiskHigh
+	case measured < min:
+		return ecRiskLow
+	}
+	return ecRiskOK
+}
+
+// ecRiskAdvice returns the headline and advice lines for a risk level
+func ecRiskAdvice(level ecRiskLevel) (string, []string) {
+	switch level {
+	case ecRiskNone:
+		return "ℹ️ **No EC data**: OpenPlantbook has no fertilizer (EC) range for this plant, so the reading can't be judged.",
+			[]string{"As a general guide, most houseplants are comfortable between 350 and 2000 µS/cm."}
+	case ecRiskLow:
+		return "🟡 **Below range**: the soil is low in nutrients. There is no burn risk.",
+			[]string{"Feed with a diluted balanced fertilizer during the growing season."}
+	case ecRiskHigh:
+		return "⚠️ **Above range**: fertilizer salts are building up in the soil.",
+			[]string{
+				"Pause fertilizing until EC is back in range.",
+				"Flush the pot: water slowly with about three times the pot's volume of plain water and let it drain fully.",
+				"Watch for brown leaf tips or a white crust on the soil surface.",
+			}
+	case ecRiskSevere:
+		return "❌ **Well above range**: high risk of fertilizer burn to the roots.",
+			[]string{
+				"Stop fertilizing immediately.",
+				"Flush the pot thoroughly with about three times the pot's volume of plain (ideally low-mineral) water, then re-measure.",
+				"If EC stays high after flushing, or roots look brown and mushy, repot into fresh substrate.",
+			}
+	}
+	return "✅ **Within range**: fertilizer level suits this plant.",
+		[]string{"Keep your current feeding routine."}
+}
+
+// handleECRisk handles the ec_risk tool
+func (s *Server) handleECRisk(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "ec_risk")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	measured, err := request.RequireFloat("soil_ec")
+	if err != nil || measured < 0 {
+		logger.Warn("invalid soil_ec parameter", "error", err)
+		return mcp.NewToolResultError("soil_ec parameter is required and must be a non-negative number (µS/cm)"), nil
+	}
+
+	logger.Info("assessing EC risk", "pid", pid, "soil_ec", measured)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	level := assessECRisk(details, measured)
+	headline, advice := ecRiskAdvice(level)
+
+	output := fmt.Sprintf("# Fertilizer Risk for %s\n\n", details.Alias)
+	output += fmt.Sprintf("**Measured EC**: %g µS/cm\n\n", measured)
+	if level != ecRiskNone {
+		output += fmt.Sprintf("**Ideal EC**: %d - %d µS/cm\n\n", details.MinSoilEC, details.MaxSoilEC)
+	}
+	output += headline + "\n\n"
+	for _, line := range advice {
+		output += fmt.Sprintf("- %s\n", line)
+	}
+
+	logger.Info("EC risk assessed", "pid", details.PID, "level", level)
+
+	return mcp.NewToolResultText(output), nil
+}
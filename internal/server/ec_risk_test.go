@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestAssessECRisk(t *testing.T) {
+	succulent := &openplantbook.PlantDetails{MinSoilEC: 200, MaxSoilEC: 800}
+
+	tests := []struct {
+		name     string
+		details  *openplantbook.PlantDetails
+		measured float64
+		expected ecRiskLevel
+	}{
+		{"no data", &openplantbook.PlantDetails{}, 1500, ecRiskNone},
+		{"below range", succulent, 100, ecRiskLow},
+		{"at minimum", succulent, 200, ecRiskOK},
+		{"at maximum", succulent, 800, ecRiskOK},
+		{"just above", succulent, 900, ecRiskHigh},
+		{"at severe boundary", succulent, 1200, ecRiskHigh},
+		{"severe", succulent, 1201, ecRiskSevere},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := assessECRisk(tt.details, tt.measured); got != tt.expected {
+				t.Errorf("assessECRisk(%v) = %s, want %s", tt.measured, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestECRiskAdvice_FlushWhenHigh(t *testing.T) {
+	for _, level := range []ecRiskLevel{ecRiskHigh, ecRiskSevere} {
+		_, advice := ecRiskAdvice(level)
+		if !strings.Contains(strings.Join(advice, " "), "Flush") {
+			t.Errorf("%s advice should recommend flushing: %v", level, advice)
+		}
+	}
+	for _, level := range []ecRiskLevel{ecRiskNone, ecRiskLow, ecRiskOK} {
+		_, advice := ecRiskAdvice(level)
+		if strings.Contains(strings.Join(advice, " "), "Flush") {
+			t.Errorf("%s advice should not recommend flushing: %v", level, advice)
+		}
+	}
+}
+
+func TestHandleECRisk(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"aloe vera|": {PID: "aloe vera", Alias: "Aloe", MinSoilEC: 200, MaxSoilEC: 800},
+	}}
+	s := newTestServer(t, client)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{"pid": "aloe vera", "soil_ec": 1000.0}
+
+	result, err := s.handleECRisk(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handleECRisk() error = %v", err)
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"**Ideal EC**: 200 - 800 µS/cm", "Above range", "salts"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+}
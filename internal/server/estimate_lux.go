@@ -0,0 +1,102 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// lightPlacement maps a qualitative placement description to an approximate lux range
+type lightPlacement struct {
+	phrases []string // lowercase phrases that identify the placement
+	name    string
+	min     int
+	max     int
+}
+
+// lightPlacements is the curated placement table behind estimate_lux. Ranges are typical
+// daytime values and stay inside one lightBands band, so estimating and interpreting agree.
+// Window directions assume the Northern Hemisphere.
+var lightPlacements = []lightPlacement{
+	{
+		phrases: []string{"direct sun", "full sun", "south-facing window", "south facing window", "south window", "outdoors", "outside"},
+		name:    "Direct sun",
+		min:     25000,
+		max:     60000,
+	},
+	{
+		phrases: []string{"west-facing window", "west facing window", "west window", "bright indirect", "bright light", "east-facing window", "east facing window", "east window", "near a window", "near window", "windowsill", "window sill"},
+		name:    "Bright indirect light",
+		min:     10000,
+		max:     20000,
+	},
+	{
+		phrases: []string{"north-facing window", "north facing window", "north window", "medium light", "medium indirect", "indirect", "few feet from a window", "across the room", "filtered light", "sheer curtain"},
+		name:    "Medium indirect light",
+		min:     2500,
+		max:     8000,
+	},
+	{
+		phrases: []string{"low light", "shade", "shady", "dim", "dark corner", "corner", "interior room", "hallway", "office", "no window", "windowless"},
+		name:    "Low light",
+		min:     200,
+		max:     1500,
+	},
+}
+
+// estimateLux finds the placement whose longest matching phrase appears in the description.
+// Longest match wins so "bright indirect" beats "indirect".
+func estimateLux(description string) (lightPlacement, bool) {
+	text := strings.ToLower(description)
+
+	var best lightPlacement
+	bestLen := 0
+	for _, p := range lightPlacements {
+		for _, phrase := range p.phrases {
+			if len(phrase) > bestLen && strings.Contains(text, phrase) {
+				best, bestLen = p, len(phrase)
+			}
+		}
+	}
+	return best, bestLen > 0
+}
+
+// typicalLux is a single representative value for a placement: the geometric midpoint,
+// rounded to the nearest 100 lux
+func typicalLux(p lightPlacement) int {
+	mid := math.Sqrt(float64(p.min) * float64(p.max))
+	return int(math.Round(mid/100) * 100)
+}
+
+// handleEstimateLux handles the estimate_lux tool
+func (s *Server) handleEstimateLux(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "estimate_lux")
+
+	// Extract parameters
+	description, err := request.RequireString("description")
+	if err != nil {
+		logger.Warn("invalid description parameter", "error", err)
+		return mcp.NewToolResultError("description parameter is required and must be a string"), nil
+	}
+
+	logger.Info("estimating lux", "description", description)
+
+	placement, ok := estimateLux(description)
+	if !ok {
+		return mcp.NewToolResultError("could not recognize the placement; try phrases like 'south-facing window', 'bright indirect', 'north window', or 'dark corner'"), nil
+	}
+
+	typical := typicalLux(placement)
+
+	output := fmt.Sprintf("# Estimated Light: %s\n\n", placement.name)
+	output += fmt.Sprintf("**Approximate range**: %d - %d lux%s\n\n", placement.min, placement.max, interpretLightLevel(placement.min, placement.max))
+	output += fmt.Sprintf("**Typical value**: %d lux\n\n", typical)
+	output += fmt.Sprintf("Use `\"light_lux\": %d` with compare_conditions, or `\"light_lux\": {\"min\": %d, \"max\": %d}` with comfort_overlap.\n\n", typical, placement.min, placement.max)
+	output += "_Rough estimate for daytime light. Season, weather, window size and obstructions change real values a lot; a light meter or phone lux app is more accurate. Window directions assume the Northern Hemisphere._\n"
+
+	return mcp.NewToolResultText(output), nil
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimateLux(t *testing.T) {
+	tests := []struct {
+		description string
+		expected    string
+		ok          bool
+	}{
+		{"right in a South-Facing Window", "Direct sun", true},
+		{"bright indirect light", "Bright indirect light", true},
+		{"indirect light", "Medium indirect light", true},
+		{"north-facing window", "Medium indirect light", true},
+		{"dark corner of the living room", "Low light", true},
+		{"on the moon", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			p, ok := estimateLux(tt.description)
+			if ok != tt.ok || p.name != tt.expected {
+				t.Errorf("estimateLux(%q) = %q, %v; want %q, %v", tt.description, p.name, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestLightPlacements_MatchInterpretation(t *testing.T) {
+	// Each placement must interpret back to a single band named like the placement
+	for _, p := range lightPlacements {
+		minBand := interpretLightLevel(p.min, p.min)
+		maxBand := interpretLightLevel(p.max, p.max)
+		if minBand != maxBand {
+			t.Errorf("%s: range %d-%d spans bands %q and %q", p.name, p.min, p.max, minBand, maxBand)
+		}
+		if p.name != "Direct sun" && !strings.Contains(maxBand, p.name) {
+			t.Errorf("%s: interprets as %q", p.name, maxBand)
+		}
+		if typical := typicalLux(p); typical < p.min || typical > p.max {
+			t.Errorf("%s: typical %d outside %d-%d", p.name, typical, p.min, p.max)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// metricExplanation is a plain-language description of a care metric
+type metricExplanation struct {
+	Name    string
+	What    string
+	Units   string
+	Measure string
+	Matters string
+}
+
+// explainableMetrics lists every metric explain_metric must cover, in display order.
+// These are the keys accepted in current_conditions.
+var explainableMetrics = []string{"moisture", "temperature", "light_lux", "humidity", "soil_ec"}
+
+// metricExplanations is the knowledge table behind explain_metric, keyed by language
+// and then metric key. Every language must cover every entry in explainableMetrics.
+var metricExplanations = map[string]map[string]metricExplanation{
+	"en": {
+		"moisture": {
+			Name:    "Soil Moisture",
+			What:    "How much water the potting mix holds, as a share of what it can hold when fully wet.",
+			Units:   "Percent (%), where 0 is bone dry and 100 is saturated.",
+			Measure: "Push a capacitive soil moisture probe into the root zone, roughly halfway down the pot. A finger test is a rough substitute: the top 2-3 cm feeling dry is usually around 20-30%.",
+			Matters: "Roots need both water and air. Too dry and the plant wilts; too wet for too long and roots suffocate and rot.",
+		},
+		"temperature": {
+			Name:    "Temperature",
+			What:    "The air temperature around the plant's leaves.",
+			Units:   "Degrees Celsius (°C); some sensors report Fahrenheit (°F).",
+			Measure: "Use a thermometer at leaf height, out of direct sun, away from heaters, radiators and air vents.",
+			Matters: "Temperature sets the pace of growth and water use. Cold slows or damages tropical plants, while heat raises water demand and stress.",
+		},
+		"light_lux": {
+			Name:    "Light",
+			What:    "How bright the light reaching the leaves is.",
+			Units:   "Lux; foot-candles are the imperial equivalent (1 fc ≈ 10.76 lux).",
+			Measure: "Hold a light meter or phone lux app at leaf level facing the light source, ideally around midday.",
+			Matters: "Light is the plant's food supply. Too little gives leggy, pale growth; too much can scorch leaves.",
+		},
+		"humidity": {
+			Name:    "Humidity",
+			What:    "How much moisture the surrounding air holds, relative to the maximum at that temperature.",
+			Units:   "Relative humidity percent (%RH).",
+			Measure: "Place a hygrometer near the plant, away from humidifier outlets and fresh-watered soil.",
+			Matters: "Dry air pulls water out of leaves, causing crispy edges on tropical plants; very humid air with poor airflow invites fungal problems.",
+		},
+		"soil_ec": {
+			Name:    "Soil EC (Electrical Conductivity)",
+			What:    "How much dissolved fertilizer salt is in the soil water, measured by how well it conducts electricity.",
+			Units:   "Microsiemens per centimeter (µS/cm).",
+			Measure: "Use an EC probe in moist soil, or test the water draining out of the pot after watering.",
+			Matters: "EC is a stand-in for fertilizer level. Low EC means the plant is hungry; high EC means salt build-up that can burn roots.",
+		},
+	},
+}
+
+// metricAliases maps common names for a metric onto its canonical key
+var metricAliases = map[string]string{
+	"soil_moisture": "moisture",
+	"temp":          "temperature",
+	"light":         "light_lux",
+	"lux":           "light_lux",
+	"ec":            "soil_ec",
+	"conductivity":  "soil_ec",
+	"fertilizer":    "soil_ec",
+}
+
+// lookupMetricExplanation resolves a metric and language to an explanation.
+// Unknown languages fall back to English; the returned language is the one used.
+func lookupMetricExplanation(metric, language string) (metricExplanation, string, error) {
+	key := strings.ToLower(strings.TrimSpace(metric))
+	if alias, ok := metricAliases[key]; ok {
+		key = alias
+	}
+
+	lang := baseLanguage(language)
+	table, ok := metricExplanations[lang]
+	if !ok {
+		lang = defaultInterpretationLang
+		table = metricExplanations[lang]
+	}
+
+	explanation, ok := table[key]
+	if !ok {
+		return metricExplanation{}, "", fmt.Errorf("unknown metric %q (supported: %s)", metric, strings.Join(explainableMetrics, ", "))
+	}
+	return explanation, lang, nil
+}
+
+// formatMetricExplanation renders an explanation as markdown
+func formatMetricExplanation(e metricExplanation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", e.Name)
+	fmt.Fprintf(&b, "**What it is**: %s\n\n", e.What)
+	fmt.Fprintf(&b, "**Units**: %s\n\n", e.Units)
+	fmt.Fprintf(&b, "**How to measure**: %s\n\n", e.Measure)
+	fmt.Fprintf(&b, "**Why it matters**: %s\n", e.Matters)
+	return b.String()
+}
+
+// explanationLanguages returns the languages the knowledge table covers
+func explanationLanguages() []string {
+	langs := make([]string, 0, len(metricExplanations))
+	for lang := range metricExplanations {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// handleExplainMetric handles the explain_metric tool
+func (s *Server) handleExplainMetric(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "explain_metric")
+
+	// Extract parameters
+	metric, err := request.RequireString("metric")
+	if err != nil {
+		logger.Warn("invalid metric parameter", "error", err)
+		return mcp.NewToolResultError("metric parameter is required and must be a string"), nil
+	}
+
+	language := request.GetString("language", "en")
+
+	logger.Info("explaining metric", "metric", metric, "language", language)
+
+	explanation, lang, err := lookupMetricExplanation(metric, language)
+	if err != nil {
+		logger.Warn("unknown metric", "metric", metric)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	text := formatMetricExplanation(explanation)
+	if lang != baseLanguage(language) {
+		text += fmt.Sprintf("\n_No explanation is available in %q yet; showing %s._\n", language, lang)
+	}
+
+	return mcp.NewToolResultText(text), nil
+}
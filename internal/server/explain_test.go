@@ -0,0 +1,65 @@
+package server
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMetricExplanations_Complete(t *testing.T) {
+	if _, ok := metricExplanations[defaultInterpretationLang]; !ok {
+		t.Fatalf("knowledge table has no %q entries", defaultInterpretationLang)
+	}
+
+	for _, lang := range explanationLanguages() {
+		for _, metric := range explainableMetrics {
+			e, ok := metricExplanations[lang][metric]
+			if !ok {
+				t.Errorf("%s: missing explanation for %q", lang, metric)
+				continue
+			}
+			for field, value := range map[string]string{"Name": e.Name, "What": e.What, "Units": e.Units, "Measure": e.Measure, "Matters": e.Matters} {
+				if strings.TrimSpace(value) == "" {
+					t.Errorf("%s/%s: %s is empty", lang, metric, field)
+				}
+			}
+		}
+	}
+
+	// Every sensor-comparable metric must be explainable
+	for _, m := range careMetrics {
+		if _, ok := metricExplanations[defaultInterpretationLang][m.key]; !ok {
+			t.Errorf("careMetrics key %q has no explanation", m.key)
+		}
+	}
+}
+
+func TestLookupMetricExplanation(t *testing.T) {
+	tests := []struct {
+		name     string
+		metric   string
+		language string
+		wantName string
+		wantLang string
+		wantErr  bool
+	}{
+		{"canonical key", "soil_ec", "en", "Soil EC (Electrical Conductivity)", "en", false},
+		{"alias and casing", "Lux", "en", "Light", "en", false},
+		{"unknown language falls back", "humidity", "de", "Humidity", "en", false},
+		{"unknown metric", "co2", "en", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, lang, err := lookupMetricExplanation(tt.metric, tt.language)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("lookupMetricExplanation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if e.Name != tt.wantName || lang != tt.wantLang {
+				t.Errorf("got %q (%s), want %q (%s)", e.Name, lang, tt.wantName, tt.wantLang)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Cache backends selectable with cache_backend
+const (
+	cacheBackendMemory = "memory"
+	cacheBackendFile   = "file"
+)
+
+// cacheBackends lists the accepted cache_backend values
+var cacheBackends = []string{cacheBackendMemory, cacheBackendFile}
+
+// cacheDirName is the directory created under the user cache dir
+const cacheDirName = "openplantbook-mcp"
+
+// defaultCacheDir is where the file backend stores plant details unless cache_dir says
+// otherwise: openplantbook-mcp under the OS user cache dir (~/.cache on Linux)
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, cacheDirName)
+}
+
+// cacheBackend names the cache backend in use
+func (s *Server) cacheBackend() string {
+	if s.fileCache != nil {
+		return cacheBackendFile
+	}
+	return cacheBackendMemory
+}
+
+// fileCache persists plant details as one JSON file per plant, so a server that is
+// started for each session doesn't begin with a cold cache. Files hold every cached
+// language for the plant. Missing, unreadable or stale files are treated as misses.
+type fileCache struct {
+	mu  sync.Mutex // serializes the read-modify-write of a plant's file
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// fileCacheDocument is the on-disk format of one plant's file
+type fileCacheDocument struct {
+	PID       string                            `json:"pid"`
+	Languages map[string]fileCacheLanguageEntry `json:"languages"`
+}
+
+// fileCacheLanguageEntry is one language's details and when they were fetched. ExpiresAt
+// is set when the API's max-age gave the entry its own lifetime; otherwise it lasts the
+// cache TTL.
+type fileCacheLanguageEntry struct {
+	FetchedAt time.Time                  `json:"fetched_at"`
+	ExpiresAt time.Time                  `json:"expires_at,omitzero"`
+	Details   openplantbook.PlantDetails `json:"details"`
+}
+
+// expiry is when the entry goes stale
+func (e fileCacheLanguageEntry) expiry(ttl time.Duration) time.Time {
+	if !e.ExpiresAt.IsZero() {
+		return e.ExpiresAt
+	}
+	return e.FetchedAt.Add(ttl)
+}
+
+// newFileCache creates a file cache in dir, creating the directory if needed
+func newFileCache(dir string, ttl time.Duration) (*fileCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache_dir is empty and the user cache directory is unknown")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create cache dir: %w", err)
+	}
+	return &fileCache{dir: dir, ttl: ttl, now: time.Now}, nil
+}
+
+// sanitizeCacheFileName turns a pid into a safe file name. Letters, digits, '-' and '.'
+// are kept, spaces become '_', and everything else (including '_' and a leading '.') is
+// percent-escaped, so distinct pids never share a file and none can leave the directory.
+func sanitizeCacheFileName(pid string) string {
+	var b strings.Builder
+	for i := 0; i < len(pid); i++ {
+		c := pid[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-':
+			b.WriteByte(c)
+		case c == '.' && i > 0:
+			b.WriteByte(c)
+		case c == ' ':
+			b.WriteByte('_')
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// path is the file holding a plant's details
+func (c *fileCache) path(pid string) string {
+	return filepath.Join(c.dir, sanitizeCacheFileName(pid)+".json")
+}
+
+// read loads a plant's file; a missing or corrupt file reads as empty
+func (c *fileCache) read(pid string) fileCacheDocument {
+	doc := fileCacheDocument{PID: pid, Languages: map[string]fileCacheLanguageEntry{}}
+	data, err := os.ReadFile(c.path(pid))
+	if err != nil {
+		return doc
+	}
+	var stored fileCacheDocument
+	if json.Unmarshal(data, &stored) != nil || stored.PID != pid || stored.Languages == nil {
+		return doc
+	}
+	return stored
+}
+
+// get returns fresh details for a plant in one language, when they were fetched and when
+// they go stale
+func (c *fileCache) get(pid, language string) (openplantbook.PlantDetails, time.Time, time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.read(pid).Languages[language]
+	if !ok || !c.now().Before(entry.expiry(c.ttl)) {
+		return openplantbook.PlantDetails{}, time.Time{}, time.Time{}, false
+	}
+	return entry.Details, entry.FetchedAt, entry.expiry(c.ttl), true
+}
+
+// set stores details for a plant in one language for the cache TTL; see setTTL
+func (c *fileCache) set(pid, language string, details openplantbook.PlantDetails) error {
+	return c.setTTL(pid, language, details, 0)
+}
+
+// setTTL stores details for a plant in one language, dropping stale languages from the
+// file. A positive ttl, such as the API's max-age, replaces the cache TTL for this entry.
+// The file is written to a temporary name and renamed so readers never see half a file.
+func (c *fileCache) setTTL(pid, language string, details openplantbook.PlantDetails, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	doc := c.read(pid)
+	for lang, entry := range doc.Languages {
+		if !now.Before(entry.expiry(c.ttl)) {
+			delete(doc.Languages, lang)
+		}
+	}
+	entry := fileCacheLanguageEntry{FetchedAt: now.UTC(), Details: details}
+	if ttl > 0 {
+		entry.ExpiresAt = now.Add(ttl).UTC()
+	}
+	doc.Languages[language] = entry
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), c.path(pid)); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// entries counts the plant files in the cache directory
+func (c *fileCache) entries() int {
+	matches, err := fs.Glob(os.DirFS(c.dir), "*.json")
+	if err != nil {
+		return 0
+	}
+	return len(matches)
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestSanitizeCacheFileName(t *testing.T) {
+	tests := []struct {
+		pid  string
+		want string
+	}{
+		{"monstera deliciosa", "monstera_deliciosa"},
+		{"ficus lyrata 'bambino'", "ficus_lyrata_%27bambino%27"},
+		{"../etc/passwd", "%2E.%2Fetc%2Fpasswd"},
+		{"a/b", "a%2Fb"},
+		{"a_b", "a%5Fb"}, // must not collide with "a b"
+		{"aloe-vera", "aloe-vera"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pid, func(t *testing.T) {
+			if got := sanitizeCacheFileName(tt.pid); got != tt.want {
+				t.Errorf("sanitizeCacheFileName(%q) = %q, want %q", tt.pid, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileCache_RoundTripAndExpiry(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := newFileCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("newFileCache() error = %v", err)
+	}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	if _, _, _, ok := cache.get("monstera deliciosa", "en"); ok {
+		t.Fatal("empty cache should miss")
+	}
+
+	if err := cache.set("monstera deliciosa", "en", openplantbook.PlantDetails{PID: "monstera deliciosa", Alias: "Monstera"}); err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+	if err := cache.set("monstera deliciosa", "de", openplantbook.PlantDetails{PID: "monstera deliciosa", Alias: "Fensterblatt"}); err != nil {
+		t.Fatalf("set() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "monstera_deliciosa.json")); err != nil {
+		t.Fatalf("expected a file named after the sanitized pid: %v", err)
+	}
+
+	details, fetchedAt, _, ok := cache.get("monstera deliciosa", "de")
+	if !ok || details.Alias != "Fensterblatt" || !fetchedAt.Equal(now) {
+		t.Errorf("get(de) = %+v, %v, %v; want the German details fetched at %v", details, fetchedAt, ok, now)
+	}
+	if cache.entries() != 1 {
+		t.Errorf("entries() = %d, want one file for both languages", cache.entries())
+	}
+
+	now = now.Add(time.Hour)
+	if _, _, _, ok := cache.get("monstera deliciosa", "en"); ok {
+		t.Error("entry should be stale after the TTL")
+	}
+}
+
+func TestFileCache_MaxAgeExpiresBeforeTTL(t *testing.T) {
+	cache, err := newFileCache(t.TempDir(), time.Hour)
+	if err != nil {
+		t.Fatalf("newFileCache() error = %v", err)
+	}
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	if err := cache.setTTL("monstera deliciosa", "en", openplantbook.PlantDetails{PID: "monstera deliciosa"}, 10*time.Minute); err != nil {
+		t.Fatalf("setTTL() error = %v", err)
+	}
+	if _, _, expiresAt, ok := cache.get("monstera deliciosa", "en"); !ok || !expiresAt.Equal(now.Add(10*time.Minute)) {
+		t.Errorf("get() = expires %v, hit %v; want a hit expiring at %v", expiresAt, ok, now.Add(10*time.Minute))
+	}
+
+	now = now.Add(10 * time.Minute)
+	if _, _, _, ok := cache.get("monstera deliciosa", "en"); ok {
+		t.Error("entry should be stale after its max-age, before the cache TTL")
+	}
+}
+
+func TestFileCache_CorruptFileIsAMiss(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := newFileCache(dir, time.Hour)
+	if err != nil {
+		t.Fatalf("newFileCache() error = %v", err)
+	}
+	if err := os.WriteFile(cache.path("monstera deliciosa"), []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, _, ok := cache.get("monstera deliciosa", "en"); ok {
+		t.Error("corrupt file should read as a miss")
+	}
+	if err := cache.set("monstera deliciosa", "en", openplantbook.PlantDetails{PID: "monstera deliciosa"}); err != nil {
+		t.Fatalf("set() should replace a corrupt file, got %v", err)
+	}
+	if _, _, _, ok := cache.get("monstera deliciosa", "en"); !ok {
+		t.Error("expected a hit after rewriting the file")
+	}
+}
+
+func TestServer_FileCacheSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	plant := &openplantbook.PlantDetails{PID: "monstera deliciosa", Alias: "Monstera"}
+
+	// First launch fetches from the API and persists the details
+	first := &fakeClient{details: map[string]*openplantbook.PlantDetails{"monstera deliciosa|en": plant}}
+	srv := newTestServer(t, first)
+	srv.cache = newResponseCache(time.Hour, 0)
+	if srv.fileCache, _ = newFileCache(dir, time.Hour); srv.fileCache == nil {
+		t.Fatal("newFileCache() failed")
+	}
+	if _, err := srv.fetchDetails(ctx, "monstera deliciosa", "en"); err != nil {
+		t.Fatalf("fetchDetails() error = %v", err)
+	}
+
+	// A fresh process with an empty memory cache is served from disk
+	second := &fakeClient{}
+	srv = newTestServer(t, second)
+	srv.cache = newResponseCache(time.Hour, 0)
+	srv.fileCache, _ = newFileCache(dir, time.Hour)
+	details, err := srv.fetchDetails(ctx, "monstera deliciosa", "en")
+	if err != nil {
+		t.Fatalf("fetchDetails() after restart error = %v", err)
+	}
+	if details.Alias != "Monstera" || len(second.detailCalls) != 0 {
+		t.Errorf("expected the persisted details without an API call, got %+v and calls %v", details, second.detailCalls)
+	}
+	if _, ok := srv.cache.fetchedAt(detailsCacheKey("monstera deliciosa", "en")); !ok {
+		t.Error("a file hit should warm the memory cache")
+	}
+
+	// no_cache skips the file too
+	if _, err := srv.fetchDetails(withNoCache(ctx), "monstera deliciosa", "en"); err == nil {
+		t.Error("expected no_cache to go to the API, which doesn't know the plant")
+	}
+}
+
+func TestNew_CacheBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		backend  string
+		wantFile bool
+		wantErr  bool
+	}{
+		{"default", "", false, false},
+		{"memory", cacheBackendMemory, false, false},
+		{"file", cacheBackendFile, true, false},
+		{"unknown", "redis", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := New(&Config{
+				APIKey:       "test-key",
+				CacheEnabled: true,
+				CacheTTL:     24,
+				CacheBackend: tt.backend,
+				CacheDir:     t.TempDir(),
+			}, "test")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (srv.fileCache != nil) != tt.wantFile {
+				t.Errorf("file cache enabled = %v, want %v", srv.fileCache != nil, tt.wantFile)
+			}
+		})
+	}
+}
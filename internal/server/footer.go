@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Data source credited in passports and provenance footers
+const (
+	dataSourceName = "OpenPlantbook"
+	dataSourceURL  = "https://open.plantbook.io"
+)
+
+// provenance records where and when a plant's data came from
+type provenance struct {
+	languages []string  // language chain the details were resolved through
+	fetchedAt time.Time // when the primary-language details were fetched
+	cached    bool      // fetchedAt is known from the cache
+}
+
+// detailsProvenance describes the details most recently returned by getPlantDetails for pid.
+// The fetch time comes from the cache entry of the first language in the chain; without a
+// cache the data was just fetched.
+func (s *Server) detailsProvenance(ctx context.Context, pid, language string) provenance {
+	pid, _ = s.resolvePin(ctx, pid)
+	p := provenance{languages: s.languageChain(ctx, language), fetchedAt: time.Now()}
+	if s.cache != nil && len(p.languages) > 0 {
+		if at, ok := s.cache.fetchedAt(detailsCacheKey(pid, p.languages[0])); ok {
+			p.fetchedAt, p.cached = at, true
+		}
+	}
+	return p
+}
+
+// formatAge renders a duration as a coarse "how long ago"
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%d minutes ago", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%d hours ago", int(age.Hours()))
+	}
+	return fmt.Sprintf("%d days ago", int(age.Hours()/24))
+}
+
+// formatProvenanceFooter renders the opt-in footer noting source, freshness and language
+func formatProvenanceFooter(p provenance, now time.Time) string {
+	fetched := "live"
+	if p.cached {
+		fetched = "cached, " + formatAge(now.Sub(p.fetchedAt))
+	}
+	return fmt.Sprintf("\n---\n_Source: [%s](%s) · Fetched: %s (%s) · Language: %s_\n",
+		dataSourceName, dataSourceURL, p.fetchedAt.UTC().Format(time.RFC3339), fetched, strings.Join(p.languages, " → "))
+}
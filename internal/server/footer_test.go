@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{30 * time.Second, "just now"},
+		{5 * time.Minute, "5 minutes ago"},
+		{3*time.Hour + 20*time.Minute, "3 hours ago"},
+		{72 * time.Hour, "3 days ago"},
+	}
+	for _, tt := range tests {
+		if got := formatAge(tt.age); got != tt.expected {
+			t.Errorf("formatAge(%v) = %q, want %q", tt.age, got, tt.expected)
+		}
+	}
+}
+
+func TestGetCareSummary_Footer(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"ocimum basilicum|": {PID: "ocimum basilicum", DisplayPID: "Ocimum basilicum", Alias: "basil", MinTemp: 10, MaxTemp: 35},
+	}}
+	srv := newTestServer(t, client)
+	srv.config.LanguageFallback = []string{"de", "en"}
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	srv.cache = newResponseCache(time.Hour*24, 0)
+	srv.cache.now = func() time.Time { return now.Add(-2 * time.Hour) }
+
+	summary := func(args map[string]interface{}) string {
+		t.Helper()
+		args["pid"] = "ocimum basilicum"
+		result, err := srv.handleGetCareSummary(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "get_care_summary", Arguments: args},
+		})
+		if err != nil || result.IsError {
+			t.Fatalf("handleGetCareSummary() = %v, %v", result, err)
+		}
+		return resultText(t, result)
+	}
+
+	if text := summary(map[string]interface{}{}); strings.Contains(text, "Source:") {
+		t.Errorf("footer should be off by default, got:\n%s", text)
+	}
+
+	text := summary(map[string]interface{}{"include_footer": true})
+	for _, want := range []string{"Source: [OpenPlantbook](https://open.plantbook.io)", "2024-05-01T10:00:00Z (cached", "Language: en → de"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in footer, got:\n%s", want, text)
+		}
+	}
+
+	t.Run("uncached is live", func(t *testing.T) {
+		p := provenance{languages: []string{"en"}, fetchedAt: now}
+		if footer := formatProvenanceFooter(p, now); !strings.Contains(footer, "(live)") {
+			t.Errorf("expected live fetch, got %q", footer)
+		}
+	})
+}
@@ -0,0 +1,212 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// gardenExportFormat names the export format; gardenExportVersion is bumped whenever a
+// field is renamed, removed or changes meaning. Additive, optional fields don't bump it.
+const (
+	gardenExportFormat  = "openplantbook-garden-planner"
+	gardenExportVersion = "1"
+)
+
+// gardenExport is the app-neutral document produced by export_garden_planner
+type gardenExport struct {
+	Format        string              `json:"format"`
+	FormatVersion string              `json:"format_version"`
+	GeneratedAt   string              `json:"generated_at"`
+	Source        string              `json:"source"`
+	Plants        []gardenPlantRecord `json:"plants"`
+	Errors        []gardenExportError `json:"errors,omitempty"`
+}
+
+// gardenPlantRecord is one plant. Sections without source data are omitted.
+type gardenPlantRecord struct {
+	PID            string             `json:"pid"`
+	CommonName     string             `json:"common_name,omitempty"`
+	ScientificName string             `json:"scientific_name,omitempty"`
+	Family         string             `json:"family,omitempty"`
+	Watering       *gardenWatering    `json:"watering,omitempty"`
+	Light          *gardenLight       `json:"light,omitempty"`
+	Temperature    *gardenTemperature `json:"temperature,omitempty"`
+	SeasonNotes    []string           `json:"season_notes,omitempty"`
+}
+
+// gardenWatering is the watering interval
+type gardenWatering struct {
+	IntervalDays int    `json:"interval_days"`
+	Note         string `json:"note"`
+}
+
+// gardenLight is the light requirement category with the lux range behind it
+type gardenLight struct {
+	Category string  `json:"category"` // full_sun, part_sun, part_shade or shade
+	MinLux   float64 `json:"min_lux"`
+	MaxLux   float64 `json:"max_lux"`
+}
+
+// gardenTemperature is the temperature range in both unit systems
+type gardenTemperature struct {
+	MinC float64 `json:"min_c"`
+	MaxC float64 `json:"max_c"`
+	MinF float64 `json:"min_f"`
+	MaxF float64 `json:"max_f"`
+}
+
+// gardenExportError records a plant that could not be exported
+type gardenExportError struct {
+	PID   string `json:"pid"`
+	Error string `json:"error"`
+}
+
+// gardenLightCategories maps the average of the lux range to a planner light category,
+// using the same boundaries as the care summary's light bands
+var gardenLightCategories = []struct {
+	below    float64 // the category covers averages below this; the last is open-ended
+	category string
+}{
+	{2000, "shade"},
+	{10000, "part_shade"},
+	{25000, "part_sun"},
+	{math.Inf(1), "full_sun"},
+}
+
+// gardenLightCategory returns the planner light category for a lux range
+func gardenLightCategory(minLux, maxLux float64) string {
+	avg := (minLux + maxLux) / 2
+	for _, c := range gardenLightCategories {
+		if avg < c.below {
+			return c.category
+		}
+	}
+	return gardenLightCategories[len(gardenLightCategories)-1].category
+}
+
+// gardenWateringInterval is how many days a medium pot takes to dry from the top to the
+// bottom of the moisture range, at the collection_watering_plan drying rate. At least 1.
+func gardenWateringInterval(minMoist, maxMoist int) int {
+	days := math.Round(float64(maxMoist-minMoist) / potDryingRates[defaultPotSize])
+	return int(math.Max(days, 1))
+}
+
+// Temperature thresholds (°C) behind the season notes
+const (
+	gardenFrostTenderMinC = 10 // minimum at or above this: bring indoors well before frost
+	gardenHardyMinC       = 5  // minimum below this: copes with cold nights
+	gardenHeatTolerantC   = 32 // maximum at or above this: fine in summer heat
+	gardenHeatSensitiveC  = 26 // maximum at or below this: protect from summer heat
+)
+
+// gardenSeasonNotes derives season notes from the temperature range
+func gardenSeasonNotes(minC, maxC float64) []string {
+	var notes []string
+	switch {
+	case minC >= gardenFrostTenderMinC:
+		notes = append(notes, fmt.Sprintf("Frost-tender: bring indoors or protect before nights drop below %.0f°C (%.0f°F).", minC, celsiusToFahrenheit(minC)))
+	case minC < gardenHardyMinC:
+		notes = append(notes, fmt.Sprintf("Tolerates cold nights down to %.0f°C (%.0f°F); can stay out through mild autumns.", minC, celsiusToFahrenheit(minC)))
+	default:
+		notes = append(notes, fmt.Sprintf("Move to shelter when nights fall below %.0f°C (%.0f°F).", minC, celsiusToFahrenheit(minC)))
+	}
+	switch {
+	case maxC >= gardenHeatTolerantC:
+		notes = append(notes, "Handles summer heat; water more often in hot spells.")
+	case maxC <= gardenHeatSensitiveC:
+		notes = append(notes, fmt.Sprintf("Give afternoon shade when summer days pass %.0f°C (%.0f°F).", maxC, celsiusToFahrenheit(maxC)))
+	}
+	return notes
+}
+
+// buildGardenPlantRecord maps OpenPlantbook fields to the export fields:
+//
+//	alias / display_pid / category      -> common_name / scientific_name / family
+//	min/max_soil_moist                  -> watering.interval_days (medium-pot drying time)
+//	min/max_light_lux                   -> light.category (by range average) + lux range
+//	min/max_temp                        -> temperature (°C and °F) + season_notes
+func buildGardenPlantRecord(details *openplantbook.PlantDetails) gardenPlantRecord {
+	record := gardenPlantRecord{
+		PID:            details.PID,
+		CommonName:     details.Alias,
+		ScientificName: details.DisplayPID,
+		Family:         details.Category,
+	}
+
+	if details.MaxSoilMoist > 0 {
+		record.Watering = &gardenWatering{
+			IntervalDays: gardenWateringInterval(details.MinSoilMoist, details.MaxSoilMoist),
+			Note:         stripInterpretation(interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist)),
+		}
+	}
+	if details.MaxLightLux > 0 {
+		minLux, maxLux := float64(details.MinLightLux), float64(details.MaxLightLux)
+		record.Light = &gardenLight{Category: gardenLightCategory(minLux, maxLux), MinLux: minLux, MaxLux: maxLux}
+	}
+	if details.MaxTemp > 0 {
+		record.Temperature = &gardenTemperature{
+			MinC: details.MinTemp,
+			MaxC: details.MaxTemp,
+			MinF: roundTo(celsiusToFahrenheit(details.MinTemp), 1),
+			MaxF: roundTo(celsiusToFahrenheit(details.MaxTemp), 1),
+		}
+		record.SeasonNotes = gardenSeasonNotes(details.MinTemp, details.MaxTemp)
+	}
+	return record
+}
+
+// buildGardenExport assembles the export document from fetched plants
+func buildGardenExport(plants []plantFetch, generatedAt time.Time) gardenExport {
+	export := gardenExport{
+		Format:        gardenExportFormat,
+		FormatVersion: gardenExportVersion,
+		GeneratedAt:   generatedAt.UTC().Format(time.RFC3339),
+		Source:        dataSourceURL,
+		Plants:        []gardenPlantRecord{},
+	}
+	for _, p := range plants {
+		if p.err != nil {
+			export.Errors = append(export.Errors, gardenExportError{PID: p.pid, Error: p.err.Error()})
+			continue
+		}
+		export.Plants = append(export.Plants, buildGardenPlantRecord(p.details))
+	}
+	return export
+}
+
+// handleExportGardenPlanner handles the export_garden_planner tool
+func (s *Server) handleExportGardenPlanner(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "export_garden_planner")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("exporting for garden planners", "pids", len(pids))
+
+	export := buildGardenExport(s.fetchPlantsConcurrently(ctx, pids), time.Now())
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		logger.Error("marshal export failed", "error", err)
+		return mcp.NewToolResultError("failed to format garden planner export"), nil
+	}
+
+	logger.Info("garden planner export built", "plants", len(export.Plants), "errors", len(export.Errors))
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestGardenLightCategory(t *testing.T) {
+	tests := []struct {
+		min, max float64
+		want     string
+	}{
+		{500, 2500, "shade"},
+		{1500, 15000, "part_shade"},
+		{10000, 30000, "part_sun"},
+		{30000, 80000, "full_sun"},
+	}
+
+	for _, tt := range tests {
+		if got := gardenLightCategory(tt.min, tt.max); got != tt.want {
+			t.Errorf("gardenLightCategory(%g, %g) = %s, want %s", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestGardenWateringInterval(t *testing.T) {
+	tests := []struct {
+		min, max int
+		want     int
+	}{
+		{15, 60, 9}, // 45 points at 5/day
+		{40, 55, 3},
+		{50, 52, 1}, // never below one day
+	}
+
+	for _, tt := range tests {
+		if got := gardenWateringInterval(tt.min, tt.max); got != tt.want {
+			t.Errorf("gardenWateringInterval(%d, %d) = %d, want %d", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestGardenSeasonNotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max float64
+		want     []string
+	}{
+		{"frost tender and heat tolerant", 12, 35, []string{"Frost-tender", "summer heat"}},
+		{"hardy and heat sensitive", 2, 24, []string{"cold nights down to 2°C", "afternoon shade"}},
+		{"in between", 7, 29, []string{"Move to shelter"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notes := gardenSeasonNotes(tt.min, tt.max)
+			if len(notes) != len(tt.want) {
+				t.Fatalf("got %d notes %q, want %d", len(notes), notes, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !strings.Contains(notes[i], want) {
+					t.Errorf("note %d = %q, want it to mention %q", i, notes[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildGardenExport(t *testing.T) {
+	basil := &openplantbook.PlantDetails{
+		PID: "ocimum basilicum", Alias: "basil", DisplayPID: "Ocimum basilicum", Category: "Lamiaceae",
+		MinLightLux: 10000, MaxLightLux: 50000, MinTemp: 10, MaxTemp: 35, MinSoilMoist: 15, MaxSoilMoist: 60,
+	}
+	partial := &openplantbook.PlantDetails{PID: "mystery plant"}
+
+	export := buildGardenExport([]plantFetch{
+		{pid: "ocimum basilicum", details: basil},
+		{pid: "mystery plant", details: partial},
+		{pid: "bogus", err: errors.New("not found")},
+	}, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+
+	if export.Format != gardenExportFormat || export.FormatVersion != gardenExportVersion || export.GeneratedAt != "2024-05-01T10:00:00Z" {
+		t.Errorf("unexpected header: %+v", export)
+	}
+
+	want := gardenPlantRecord{
+		PID: "ocimum basilicum", CommonName: "basil", ScientificName: "Ocimum basilicum", Family: "Lamiaceae",
+		Watering:    &gardenWatering{IntervalDays: 9, Note: "Slightly moist - let soil dry between waterings"},
+		Light:       &gardenLight{Category: "full_sun", MinLux: 10000, MaxLux: 50000},
+		Temperature: &gardenTemperature{MinC: 10, MaxC: 35, MinF: 50, MaxF: 95},
+		SeasonNotes: gardenSeasonNotes(10, 35),
+	}
+	if !reflect.DeepEqual(export.Plants[0], want) {
+		t.Errorf("basil record = %+v, want %+v", export.Plants[0], want)
+	}
+
+	if got := export.Plants[1]; got.Watering != nil || got.Light != nil || got.Temperature != nil || got.SeasonNotes != nil {
+		t.Errorf("sections without data should be omitted: %+v", got)
+	}
+	if len(export.Errors) != 1 || export.Errors[0].PID != "bogus" {
+		t.Errorf("expected bogus in errors, got %+v", export.Errors)
+	}
+}
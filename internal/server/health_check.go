@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// healthCheckQuery is searched for by health_check; any common plant works
+	healthCheckQuery = "monstera"
+
+	// healthCheckTimeout bounds the probe so a hung connection reports as a failure
+	// instead of stalling the tool call
+	healthCheckTimeout = 10 * time.Second
+)
+
+// Categories health_check sorts a failed probe into
+const (
+	healthFailureAuth       = "auth"
+	healthFailureRateLimit  = "rate_limit"
+	healthFailureNetwork    = "network"
+	healthFailureServer     = "server"
+	healthFailureClientInit = "client_init"
+	healthFailureUnknown    = "unknown"
+)
+
+// healthFailureHints tell the user what to look at for each failure category
+var healthFailureHints = map[string]string{
+	healthFailureAuth:       "Credentials are missing or were rejected. Check OPENPLANTBOOK_API_KEY, or OPENPLANTBOOK_CLIENT_ID and OPENPLANTBOOK_CLIENT_SECRET, and set only one method.",
+	healthFailureRateLimit:  "The request was rate limited, by OpenPlantbook's daily quota or the server's rate_limit_per_minute. Wait and try again; api_usage shows recent calls.",
+	healthFailureNetwork:    "OpenPlantbook could not be reached. Check the network connection, proxy and firewall.",
+	healthFailureServer:     "OpenPlantbook returned a server error. It is likely temporary; try again later.",
+	healthFailureClientInit: "The API client could not be created. Check the server configuration and logs.",
+	healthFailureUnknown:    "The request failed for an unexpected reason. Check the server logs for details.",
+}
+
+// healthReport is the health_check response. It names the auth method but never
+// includes credentials.
+type healthReport struct {
+	Status     string         `json:"status"`
+	AuthMethod string         `json:"auth_method"`
+	API        healthAPIProbe `json:"api"`
+	Config     healthConfig   `json:"config"`
+}
+
+// healthAPIProbe is the outcome of the authenticated test request
+type healthAPIProbe struct {
+	Request   string `json:"request"`
+	Succeeded bool   `json:"succeeded"`
+	LatencyMs int64  `json:"latency_ms"`
+	Failure   string `json:"failure,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Hint      string `json:"hint,omitempty"`
+}
+
+// healthConfig are the cache and transport settings that shape API traffic
+type healthConfig struct {
+	Transport          string `json:"transport"`
+	CacheEnabled       bool   `json:"cache_enabled"`
+	CacheBackend       string `json:"cache_backend"`
+	CacheTTLHours      int    `json:"cache_ttl_hours"`
+	CacheMaxEntries    int    `json:"cache_max_entries"`
+	RateLimitPerMinute int    `json:"rate_limit_per_minute"`
+	RetryMaxAttempts   int    `json:"retry_max_attempts"`
+}
+
+// classifyHealthFailure sorts a probe error into one of the health failure categories
+func classifyHealthFailure(err error) string {
+	var apiErr *openplantbook.APIError
+	switch {
+	case errors.Is(err, ErrAuthConfig), errors.Is(err, openplantbook.ErrUnauthorized):
+		return healthFailureAuth
+	case errors.Is(err, ErrRateLimited), errors.Is(err, openplantbook.ErrRateLimitExceeded):
+		return healthFailureRateLimit
+	case errors.Is(err, ErrClientInit):
+		return healthFailureClientInit
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode == 401 || apiErr.StatusCode == 403:
+			return healthFailureAuth
+		case apiErr.StatusCode == 429:
+			return healthFailureRateLimit
+		case apiErr.IsServerError():
+			return healthFailureServer
+		}
+		return healthFailureUnknown
+	}
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) {
+		return healthFailureNetwork
+	}
+	return healthFailureUnknown
+}
+
+// probeAPI makes one authenticated search, bypassing the cache and retries so the
+// result and latency reflect the API right now. It still counts towards api_usage and
+// the rate limit like any other call.
+func (s *Server) probeAPI(ctx context.Context) healthAPIProbe {
+	probe := healthAPIProbe{Request: "search_plants " + healthCheckQuery}
+
+	client, err := s.apiClient()
+	if err == nil {
+		ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		defer cancel()
+
+		start := time.Now()
+		_, err = client.SearchPlants(ctx, healthCheckQuery, &openplantbook.SearchOptions{Limit: 1})
+		probe.LatencyMs = time.Since(start).Milliseconds()
+		s.usage.recordCall(&s.usage.searchCalls, err)
+	}
+	if err != nil {
+		probe.Failure = classifyHealthFailure(err)
+		probe.Hint = healthFailureHints[probe.Failure]
+		probe.Error = err.Error()
+		if redactor := newSecretRedactor(s.config.APIKey, s.config.ClientSecret); redactor != nil {
+			probe.Error = redactor.Replace(probe.Error)
+		}
+		return probe
+	}
+	probe.Succeeded = true
+	return probe
+}
+
+// handleHealthCheck handles the health_check tool
+func (s *Server) handleHealthCheck(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "health_check")
+
+	logger.Info("running health check")
+
+	report := healthReport{
+		Status:     "ok",
+		AuthMethod: getAuthMethod(s.config),
+		API:        s.probeAPI(withTraceID(ctx, traceID)),
+		Config: healthConfig{
+			Transport:          s.transport(),
+			CacheEnabled:       s.config.CacheEnabled,
+			CacheBackend:       s.cacheBackend(),
+			CacheTTLHours:      s.config.CacheTTL,
+			CacheMaxEntries:    s.cacheMaxEntries(),
+			RateLimitPerMinute: s.config.RateLimitPerMinute,
+			RetryMaxAttempts:   s.retryMaxAttempts(),
+		},
+	}
+	if !report.API.Succeeded {
+		report.Status = "failed"
+	}
+
+	// Format response as pretty JSON
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.Error("marshal health report failed", "error", err)
+		return mcp.NewToolResultError("failed to format health report"), nil
+	}
+
+	if report.API.Succeeded {
+		logger.Info("health check passed", "latency_ms", report.API.LatencyMs)
+	} else {
+		logger.Warn("health check failed", "failure", report.API.Failure, "latency_ms", report.API.LatencyMs, "error", report.API.Error)
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestClassifyHealthFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"missing credentials", fmt.Errorf("%w: %w", ErrAuthConfig, openplantbook.ErrNoAuthProvided), healthFailureAuth},
+		{"rejected credentials", fmt.Errorf("search: %w", openplantbook.ErrUnauthorized), healthFailureAuth},
+		{"forbidden", &openplantbook.APIError{StatusCode: 403}, healthFailureAuth},
+		{"local rate limit", ErrRateLimited, healthFailureRateLimit},
+		{"api quota", openplantbook.ErrRateLimitExceeded, healthFailureRateLimit},
+		{"too many requests", &openplantbook.APIError{StatusCode: 429}, healthFailureRateLimit},
+		{"server error", &openplantbook.APIError{StatusCode: 503}, healthFailureServer},
+		{"client init", fmt.Errorf("%w: boom", ErrClientInit), healthFailureClientInit},
+		{"connection refused", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, healthFailureNetwork},
+		{"timeout", context.DeadlineExceeded, healthFailureNetwork},
+		{"other", errors.New("something odd"), healthFailureUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyHealthFailure(tt.err); got != tt.want {
+				t.Errorf("classifyHealthFailure(%v) = %q, want %q", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleHealthCheck(t *testing.T) {
+	tests := []struct {
+		name        string
+		client      *fakeClient
+		newClient   func() (plantClient, error)
+		wantStatus  string
+		wantFailure string
+	}{
+		{"healthy", &fakeClient{}, nil, "ok", ""},
+		{"rejected credentials", &fakeClient{searchErr: fmt.Errorf("search failed for key test-key: %w", openplantbook.ErrUnauthorized)}, nil, "failed", healthFailureAuth},
+		{"no client", nil, func() (plantClient, error) {
+			return nil, classifyClientError(openplantbook.ErrNoAuthProvided)
+		}, "failed", healthFailureAuth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, nil)
+			if tt.client != nil {
+				srv.client = tt.client
+			}
+			srv.newClient = tt.newClient
+
+			result, err := srv.handleHealthCheck(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatalf("handleHealthCheck() error = %v", err)
+			}
+			text := resultText(t, result)
+			if result.IsError {
+				t.Fatalf("result = %q, want a report", text)
+			}
+			if strings.Contains(text, srv.config.APIKey) {
+				t.Errorf("report leaks the API key: %s", text)
+			}
+
+			var report healthReport
+			if err := json.Unmarshal([]byte(text), &report); err != nil {
+				t.Fatalf("decode report: %v", err)
+			}
+			if report.Status != tt.wantStatus || report.API.Failure != tt.wantFailure || report.AuthMethod != "api_key" {
+				t.Errorf("report = %+v, want status %q, failure %q, auth api_key", report, tt.wantStatus, tt.wantFailure)
+			}
+			if tt.wantFailure != "" && report.API.Hint == "" {
+				t.Errorf("report = %+v, want a hint", report)
+			}
+			if tt.client != nil && len(tt.client.searchCalls) != 1 {
+				t.Errorf("search calls = %v, want one probe", tt.client.searchCalls)
+			}
+		})
+	}
+}
+
+func TestHandleHealthCheck_BypassesCache(t *testing.T) {
+	client := &fakeClient{}
+	srv := newTestServer(t, client)
+	srv.cache = newResponseCache(time.Hour, 0)
+
+	for range 2 {
+		if _, err := srv.handleHealthCheck(context.Background(), mcp.CallToolRequest{}); err != nil {
+			t.Fatalf("handleHealthCheck() error = %v", err)
+		}
+	}
+	if len(client.searchCalls) != 2 {
+		t.Errorf("search calls = %v, want every check to reach the API", client.searchCalls)
+	}
+}
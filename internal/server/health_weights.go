@@ -0,0 +1,172 @@
+package server
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// healthWeights is the relative importance of each care metric in aggregate scores
+// (score_card's overall score and comfort_overlap's suitability), keyed by careMetric key.
+// Metrics without an entry weigh 1, so a nil map weighs every metric equally. Weights are
+// relative: they are normalized over the metrics actually scored, so {moisture: 2} and
+// {moisture: 4, temperature: 2, light_lux: 2, humidity: 2} mean the same thing.
+type healthWeights map[string]float64
+
+// weight returns the weight for a metric key
+func (w healthWeights) weight(key string) float64 {
+	if v, ok := w[key]; ok {
+		return v
+	}
+	return 1
+}
+
+// equal reports whether every metric weighs the same, i.e. the weights change nothing
+func (w healthWeights) equal() bool {
+	first := w.weight(careMetrics[0].key)
+	for _, m := range careMetrics[1:] {
+		if w.weight(m.key) != first {
+			return false
+		}
+	}
+	return true
+}
+
+// normalized returns each scored metric's share of the total weight. When every scored
+// metric weighs zero the shares fall back to equal.
+func (w healthWeights) normalized(keys []string) []float64 {
+	total := 0.0
+	for _, key := range keys {
+		total += w.weight(key)
+	}
+	shares := make([]float64, len(keys))
+	for i, key := range keys {
+		if total > 0 {
+			shares[i] = w.weight(key) / total
+		} else {
+			shares[i] = 1 / float64(len(keys))
+		}
+	}
+	return shares
+}
+
+// describe renders the normalized weights of the scored metrics, e.g. "Soil Moisture 50%, Light 25%"
+func (w healthWeights) describe(metrics []careMetric) string {
+	keys := make([]string, len(metrics))
+	for i, m := range metrics {
+		keys[i] = m.key
+	}
+	parts := make([]string, len(metrics))
+	for i, share := range w.normalized(keys) {
+		parts[i] = fmt.Sprintf("%s %.0f%%", metrics[i].label, share*100)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// weightedMean averages values by their normalized weights
+func weightedMean(values, shares []float64) float64 {
+	total := 0.0
+	for i, v := range values {
+		total += v * shares[i]
+	}
+	return total
+}
+
+// weightedGeometricMean combines fractions by their normalized weights. Any fully
+// unsuitable metric with a non-zero weight pulls the total to zero.
+func weightedGeometricMean(values, shares []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	logSum := 0.0
+	for i, v := range values {
+		if shares[i] == 0 {
+			continue
+		}
+		if v <= 0 {
+			return 0
+		}
+		logSum += shares[i] * math.Log(v)
+	}
+	return math.Exp(logSum)
+}
+
+// parseHealthWeights validates a weights object keyed by care metric. Weights must be
+// non-negative numbers and at least one must be positive.
+func parseHealthWeights(raw interface{}) (healthWeights, error) {
+	entries, ok := normalizeNumbers(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("weights must be an object keyed by metric (%s)", strings.Join(careMetricKeys(), ", "))
+	}
+
+	weights := healthWeights{}
+	positive := false
+	for key, value := range entries {
+		key = strings.ToLower(key)
+		if !isCareMetricKey(key) {
+			return nil, fmt.Errorf("weights.%s is not a metric; use %s", key, strings.Join(careMetricKeys(), ", "))
+		}
+		w, ok := value.(float64)
+		if !ok || w < 0 || math.IsInf(w, 0) || math.IsNaN(w) {
+			return nil, fmt.Errorf("weights.%s must be a non-negative number", key)
+		}
+		weights[key] = w
+		positive = positive || w > 0
+	}
+	if !positive && len(weights) == len(careMetrics) {
+		return nil, fmt.Errorf("weights must give at least one metric a positive weight")
+	}
+	return weights, nil
+}
+
+// parseConfiguredHealthWeights reads health_weights from config; invalid settings are ignored
+func parseConfiguredHealthWeights(raw interface{}) map[string]float64 {
+	if raw == nil {
+		return nil
+	}
+	weights, err := parseHealthWeights(raw)
+	if err != nil {
+		return nil
+	}
+	return weights
+}
+
+// healthWeights merges per-call weights over the configured defaults
+func (s *Server) healthWeights(override interface{}) (healthWeights, error) {
+	weights := healthWeights{}
+	for key, w := range s.config.HealthWeights {
+		weights[key] = w
+	}
+	if override == nil {
+		return weights, nil
+	}
+	perCall, err := parseHealthWeights(override)
+	if err != nil {
+		return nil, err
+	}
+	for key, w := range perCall {
+		weights[key] = w
+	}
+	return weights, nil
+}
+
+// careMetricKeys lists the metric keys, sorted
+func careMetricKeys() []string {
+	keys := make([]string, len(careMetrics))
+	for i, m := range careMetrics {
+		keys[i] = m.key
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isCareMetricKey reports whether key names a care metric
+func isCareMetricKey(key string) bool {
+	for _, m := range careMetrics {
+		if m.key == key {
+			return true
+		}
+	}
+	return false
+}
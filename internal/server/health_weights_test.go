@@ -0,0 +1,79 @@
+package server
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestHealthWeights_Normalized(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights healthWeights
+		keys    []string
+		want    []float64
+	}{
+		{"nil is equal", nil, []string{"moisture", "light_lux"}, []float64{0.5, 0.5}},
+		{"relative", healthWeights{"moisture": 3}, []string{"moisture", "light_lux"}, []float64{0.75, 0.25}},
+		{"only scored metrics count", healthWeights{"moisture": 2, "humidity": 100}, []string{"moisture", "temperature"}, []float64{2.0 / 3, 1.0 / 3}},
+		{"all zero falls back to equal", healthWeights{"moisture": 0, "light_lux": 0}, []string{"moisture", "light_lux"}, []float64{0.5, 0.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.weights.normalized(tt.keys)
+			sum := 0.0
+			for i := range got {
+				sum += got[i]
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("normalized = %v, want %v", got, tt.want)
+				}
+			}
+			if math.Abs(sum-1) > 1e-9 {
+				t.Errorf("shares sum to %v, want 1", sum)
+			}
+		})
+	}
+}
+
+func TestWeightedMeans(t *testing.T) {
+	if got := weightedMean([]float64{100, 0}, []float64{0.75, 0.25}); got != 75 {
+		t.Errorf("weightedMean = %v, want 75", got)
+	}
+	if got := weightedGeometricMean([]float64{0.25, 1}, []float64{0.5, 0.5}); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("weightedGeometricMean = %v, want 0.5", got)
+	}
+	if got := weightedGeometricMean([]float64{0, 1}, []float64{0, 1}); got != 1 {
+		t.Errorf("a zero-weight unsuitable metric should be ignored, got %v", got)
+	}
+	if got := weightedGeometricMean([]float64{0, 1}, []float64{0.1, 0.9}); got != 0 {
+		t.Errorf("a weighted unsuitable metric should zero the score, got %v", got)
+	}
+}
+
+func TestServerHealthWeights(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.HealthWeights = map[string]float64{"moisture": 3, "light_lux": 2}
+
+	weights, err := srv.healthWeights(map[string]interface{}{"light_lux": 1.0, "Humidity": 0})
+	if err != nil {
+		t.Fatalf("healthWeights failed: %v", err)
+	}
+	want := map[string]float64{"moisture": 3, "light_lux": 1, "humidity": 0, "temperature": 1}
+	for key, w := range want {
+		if got := weights.weight(key); got != w {
+			t.Errorf("weight(%s) = %v, want %v", key, got, w)
+		}
+	}
+
+	for _, bad := range []interface{}{
+		"moisture=2",
+		map[string]interface{}{"soil": 1.0},
+		map[string]interface{}{"moisture": -1.0},
+		map[string]interface{}{"moisture": 0.0, "temperature": 0.0, "light_lux": 0.0, "humidity": 0.0},
+	} {
+		if _, err := srv.healthWeights(bad); err == nil || !strings.Contains(err.Error(), "weights") {
+			t.Errorf("expected %v to be rejected, got %v", bad, err)
+		}
+	}
+}
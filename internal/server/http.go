@@ -0,0 +1,55 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// defaultMaxRequestBytes caps HTTP request bodies when max_request_bytes is not configured.
+// Tool calls are small JSON-RPC messages; 1 MiB leaves ample room for batch requests.
+const defaultMaxRequestBytes = 1 << 20
+
+// limitRequestBody rejects HTTP requests whose body exceeds maxBytes before the MCP
+// handler parses them. At most maxBytes+1 bytes are ever read into memory.
+func limitRequestBody(maxBytes int64, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > maxBytes {
+			rejectOversizedRequest(w, maxBytes)
+			return
+		}
+
+		if r.Body != nil && r.Body != http.NoBody {
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBytes))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					rejectOversizedRequest(w, maxBytes)
+					return
+				}
+				http.Error(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rejectOversizedRequest writes a 413 response with a JSON-RPC error body
+func rejectOversizedRequest(w http.ResponseWriter, maxBytes int64) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"request body exceeds the %d byte limit"}}`, maxBytes)
+}
+
+// maxRequestBytes returns the configured body limit or the default
+func (s *Server) maxRequestBytes() int64 {
+	if s.config.MaxRequestBytes > 0 {
+		return s.config.MaxRequestBytes
+	}
+	return defaultMaxRequestBytes
+}
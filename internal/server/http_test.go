@@ -0,0 +1,59 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLimitRequestBody(t *testing.T) {
+	var received string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := limitRequestBody(64, next)
+
+	tests := []struct {
+		name          string
+		body          string
+		unknownLength bool
+		wantStatus    int
+	}{
+		{"within limit", `{"jsonrpc":"2.0","method":"ping","id":1}`, false, http.StatusOK},
+		{"oversized with content length", strings.Repeat("x", 65), false, http.StatusRequestEntityTooLarge},
+		{"oversized without content length", strings.Repeat("x", 1000), true, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = ""
+			req := httptest.NewRequest(http.MethodPost, "/mcp", strings.NewReader(tt.body))
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK {
+				if received != tt.body {
+					t.Errorf("handler received %q, want %q", received, tt.body)
+				}
+				return
+			}
+			if received != "" {
+				t.Error("oversized body reached the handler")
+			}
+			if !strings.Contains(rec.Body.String(), "exceeds the 64 byte limit") {
+				t.Errorf("unexpected error body: %s", rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// interpretationLanguages lists the languages the interpretation helpers are written in.
+// Add a language here once interpretLightLevel and friends have translations for it.
+var interpretationLanguages = map[string]bool{
+	"en": true,
+}
+
+// defaultInterpretationLang is used when the requested interpretation language is unavailable
+const defaultInterpretationLang = "en"
+
+// baseLanguage reduces a language tag like "de-AT" to its primary subtag
+func baseLanguage(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+// resolveInterpretationLang picks the interpretation language for a summary.
+// It returns the language to use and whether the request had to fall back.
+func resolveInterpretationLang(requested string) (string, bool) {
+	if requested == "" {
+		return defaultInterpretationLang, false
+	}
+	if lang := baseLanguage(requested); interpretationLanguages[lang] {
+		return lang, false
+	}
+	return defaultInterpretationLang, true
+}
+
+// summaryLanguageOptions decides whether plant data and interpretation must be shown
+// in separate sections: they are split whenever their languages differ, so a German
+// alias is never followed by an English interpretation on the same line.
+func summaryLanguageOptions(metric bool, dataLang, interpretationLang string) summaryOptions {
+	return summaryOptions{
+		metric:                 metric,
+		separateInterpretation: baseLanguage(dataLang) != interpretationLang,
+		dataLang:               dataLang,
+		interpretationLang:     interpretationLang,
+	}
+}
+
+// formatInterpretationSection renders the interpretive text as its own section
+func formatInterpretationSection(details *openplantbook.PlantDetails, lang string, metric bool) string {
+	var lines []string
+	if details.MaxLightLux > 0 {
+		lines = append(lines, "**Light**: "+bandInterpretation(details, "light_lux", metric))
+	}
+	if details.MaxTemp > 0 {
+		lines = append(lines, "**Temperature**: "+bandInterpretation(details, "temperature", metric))
+	}
+	if details.MaxEnvHumid > 0 {
+		lines = append(lines, "**Humidity**: "+bandInterpretation(details, "humidity", metric))
+	}
+	if details.MaxSoilMoist > 0 {
+		lines = append(lines, "**Soil Moisture**: "+bandInterpretation(details, "moisture", metric))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	section := fmt.Sprintf("## Interpretation (%s)\n\n", lang)
+	for _, line := range lines {
+		section += line + "\n\n"
+	}
+	return section
+}
+
+// stripInterpretation removes the inline " (...)" wrapping from an interpretation string
+func stripInterpretation(text string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(text), "("), ")")
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestResolveInterpretationLang(t *testing.T) {
+	tests := []struct {
+		requested    string
+		expected     string
+		wantFallback bool
+	}{
+		{"", "en", false},
+		{"en", "en", false},
+		{"EN-us", "en", false},
+		{"de", "en", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requested, func(t *testing.T) {
+			lang, fellBack := resolveInterpretationLang(tt.requested)
+			if lang != tt.expected || fellBack != tt.wantFallback {
+				t.Errorf("resolveInterpretationLang(%q) = %q, %v; want %q, %v", tt.requested, lang, fellBack, tt.expected, tt.wantFallback)
+			}
+		})
+	}
+}
+
+func TestRenderCareSummary_SeparateInterpretation(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "Basilikum", DisplayPID: "Ocimum basilicum", Category: "Lamiaceae",
+		MinLightLux: 2500, MaxLightLux: 30000, MinSoilMoist: 15, MaxSoilMoist: 60,
+	}
+
+	t.Run("german data splits sections", func(t *testing.T) {
+		summary := renderCareSummary(details, summaryLanguageOptions(true, "de", "en"))
+		for _, want := range []string{"## Plant data (de)", "## Interpretation (en)", "**Light**: 2500 - 30000 lux\n", "**Light**: Bright indirect light - near windows"} {
+			if !strings.Contains(summary, want) {
+				t.Errorf("expected %q in summary:\n%s", want, summary)
+			}
+		}
+		if strings.Contains(summary, "lux (") {
+			t.Errorf("interpretation should not be inline:\n%s", summary)
+		}
+	})
+
+	t.Run("english data stays inline", func(t *testing.T) {
+		summary := renderCareSummary(details, summaryLanguageOptions(true, "en", "en"))
+		if summary != formatCareSummary(details, true) {
+			t.Errorf("english summary should match formatCareSummary:\n%s", summary)
+		}
+	})
+}
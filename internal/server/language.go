@@ -0,0 +1,233 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// fallbackLanguage is used when no default language is configured, so the API is never
+// called with an empty language
+const fallbackLanguage = "en"
+
+// defaultLanguage returns DefaultLang, or fallbackLanguage when it is empty. New
+// normalizes DefaultLang and warns once about an empty one, so this stays side-effect free.
+func (s *Server) defaultLanguage() string {
+	if lang := strings.TrimSpace(s.config.DefaultLang); lang != "" {
+		return lang
+	}
+	return fallbackLanguage
+}
+
+// languageChain returns the ordered, de-duplicated languages to try for a request.
+// The requested language comes first; when it is empty, the session languages
+// (from Accept-Language on HTTP transports) are used instead, or DefaultLang when
+// there are none. The configured fallback chain follows. The chain is never empty.
+func (s *Server) languageChain(ctx context.Context, requested string) []string {
+	preferred := []string{requested}
+	if requested == "" {
+		preferred = sessionLanguages(ctx)
+		if len(preferred) == 0 {
+			preferred = []string{s.defaultLanguage()}
+		}
+	}
+
+	var chain []string
+	seen := map[string]bool{}
+	for _, lang := range append(preferred, s.config.LanguageFallback...) {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if lang == "" || seen[lang] {
+			continue
+		}
+		seen[lang] = true
+		chain = append(chain, lang)
+	}
+	if len(chain) == 0 {
+		s.logger.Warn("empty language chain, falling back", "language", fallbackLanguage)
+		chain = []string{fallbackLanguage}
+	}
+	return chain
+}
+
+// sessionLanguagesKey is the context key for the per-session language preference
+type sessionLanguagesKey struct{}
+
+// withSessionLanguages sets the session's preferred languages, most preferred first.
+// Tool calls that don't specify a language use these instead of DefaultLang.
+func withSessionLanguages(ctx context.Context, langs []string) context.Context {
+	if len(langs) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, sessionLanguagesKey{}, langs)
+}
+
+// sessionLanguages returns the session's preferred languages, if any
+func sessionLanguages(ctx context.Context) []string {
+	langs, _ := ctx.Value(sessionLanguagesKey{}).([]string)
+	return langs
+}
+
+// parseAcceptLanguage parses an Accept-Language header into primary language
+// subtags ordered by quality, e.g. "de-AT,de;q=0.9,en;q=0.5" gives [de en].
+// Wildcards, q=0 entries and malformed entries are skipped.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+
+	var entries []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		lang := baseLanguage(fields[0])
+		if lang == "" || lang == "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if !strings.HasPrefix(param, "q=") {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+			if err != nil {
+				q = 0
+				break
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		entries = append(entries, weighted{lang, q})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].q > entries[j].q
+	})
+
+	var langs []string
+	seen := map[string]bool{}
+	for _, e := range entries {
+		if !seen[e.lang] {
+			seen[e.lang] = true
+			langs = append(langs, e.lang)
+		}
+	}
+	return langs
+}
+
+// getPlantDetails fetches plant details, walking the language fallback chain.
+// Fields missing from the first language that answers are filled from later
+// languages in order, until the alias and core care ranges are present; if every
+// language fails, the first error is returned.
+// pid may be a pin_plant token.
+func (s *Server) getPlantDetails(ctx context.Context, pid string, language string) (*openplantbook.PlantDetails, error) {
+	pid, err := s.resolvePin(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Imported bundles stand in for the API entirely
+	if details, ok := s.imported.get(pid); ok {
+		return details, nil
+	}
+
+	var details *openplantbook.PlantDetails
+	var firstErr error
+
+	for _, lang := range s.languageChain(ctx, language) {
+		fetched, err := s.fetchDetails(ctx, pid, lang)
+		if err != nil {
+			s.logger.Debug("details fetch failed, trying next language", "pid", pid, "language", lang, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		if details == nil {
+			details = fetched
+		} else {
+			s.logger.Debug("filling missing fields from fallback language", "pid", pid, "language", lang)
+			fillMissingDetails(details, fetched)
+		}
+
+		if !hasMissingDetails(details) {
+			break
+		}
+	}
+
+	if details == nil {
+		return nil, firstErr
+	}
+	return details, nil
+}
+
+// hasMissingDetails reports whether the alias or a core care range is empty, which is
+// worth another upstream call. Other fields, such as the image and EC range, are often
+// absent in every language, so they are only filled from languages already fetched.
+func hasMissingDetails(d *openplantbook.PlantDetails) bool {
+	return d.Alias == "" || d.MaxLightLux == 0 || d.MaxTemp == 0 || d.MaxEnvHumid == 0 || d.MaxSoilMoist == 0
+}
+
+// fillMissingDetails copies fields that are empty in dst from src.
+// Ranges are copied as min/max pairs so a range never mixes two sources.
+func fillMissingDetails(dst, src *openplantbook.PlantDetails) {
+	if dst.Alias == "" {
+		dst.Alias = src.Alias
+	}
+	if dst.DisplayPID == "" {
+		dst.DisplayPID = src.DisplayPID
+	}
+	if dst.Category == "" {
+		dst.Category = src.Category
+	}
+	if dst.ImageURL == "" {
+		dst.ImageURL = src.ImageURL
+	}
+	if dst.MaxLightLux == 0 {
+		dst.MinLightLux, dst.MaxLightLux = src.MinLightLux, src.MaxLightLux
+	}
+	if dst.MaxTemp == 0 {
+		dst.MinTemp, dst.MaxTemp = src.MinTemp, src.MaxTemp
+	}
+	if dst.MaxEnvHumid == 0 {
+		dst.MinEnvHumid, dst.MaxEnvHumid = src.MinEnvHumid, src.MaxEnvHumid
+	}
+	if dst.MaxSoilMoist == 0 {
+		dst.MinSoilMoist, dst.MaxSoilMoist = src.MinSoilMoist, src.MaxSoilMoist
+	}
+	if dst.MaxSoilEC == 0 {
+		dst.MinSoilEC, dst.MaxSoilEC = src.MinSoilEC, src.MaxSoilEC
+	}
+}
+
+// parseLanguageList accepts a comma-separated string ("de,en") or a list of strings
+func parseLanguageList(value interface{}) []string {
+	var items []string
+	switch v := value.(type) {
+	case string:
+		items = strings.Split(v, ",")
+	case []string:
+		items = v
+	case []interface{}:
+		for _, item := range v {
+			if str, ok := item.(string); ok {
+				items = append(items, str)
+			}
+		}
+	}
+
+	var langs []string
+	for _, item := range items {
+		if lang := strings.ToLower(strings.TrimSpace(item)); lang != "" {
+			langs = append(langs, lang)
+		}
+	}
+	return langs
+}
@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestLanguageChain(t *testing.T) {
+	tests := []struct {
+		name      string
+		defLang   string
+		fallback  []string
+		session   []string
+		requested string
+		expected  []string
+	}{
+		{"requested first", "en", []string{"en"}, nil, "de", []string{"de", "en"}},
+		{"default when empty", "fr", []string{"de", "en"}, nil, "", []string{"fr", "de", "en"}},
+		{"deduplicated", "en", []string{"de", "en", "DE"}, nil, "de", []string{"de", "en"}},
+		{"no fallback", "en", nil, nil, "es", []string{"es"}},
+		{"session overrides default", "fr", []string{"en"}, []string{"de", "nl"}, "", []string{"de", "nl", "en"}},
+		{"requested beats session", "fr", []string{"en"}, []string{"de"}, "es", []string{"es", "en"}},
+		{"empty default falls back to en", "", nil, nil, "", []string{"en"}},
+		{"blank default before fallback chain", " ", []string{"de"}, nil, "", []string{"en", "de"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, &fakeClient{})
+			srv.config.DefaultLang = tt.defLang
+			srv.config.LanguageFallback = tt.fallback
+
+			ctx := withSessionLanguages(context.Background(), tt.session)
+			if got := srv.languageChain(ctx, tt.requested); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("languageChain(%q) = %v, want %v", tt.requested, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetPlantDetails_EmptyDefaultLang(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"basil|en": {PID: "basil", Alias: "Basil"},
+	}}
+	srv := newTestServer(t, client)
+	srv.config.DefaultLang = ""
+	srv.config.LanguageFallback = nil
+
+	if _, err := srv.getPlantDetails(context.Background(), "basil", ""); err != nil {
+		t.Fatalf("getPlantDetails() error = %v", err)
+	}
+	for _, call := range client.detailCalls {
+		if strings.HasSuffix(call, "|") {
+			t.Errorf("API called with an empty language: %v", client.detailCalls)
+		}
+	}
+	if len(client.detailCalls) == 0 || client.detailCalls[0] != "basil|en" {
+		t.Errorf("detail calls = %v, want basil|en first", client.detailCalls)
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header   string
+		expected []string
+	}{
+		{"", nil},
+		{"de", []string{"de"}},
+		{"de-AT,de;q=0.9,en;q=0.5", []string{"de", "en"}},
+		{"en;q=0.3, fr;q=0.8, *;q=0.1", []string{"fr", "en"}},
+		{"nl;q=0, es", []string{"es"}},
+		{"ja;q=bogus, pt-BR", []string{"pt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := parseAcceptLanguage(tt.header); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseAcceptLanguage(%q) = %v, want %v", tt.header, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetPlantDetails_FallbackChain(t *testing.T) {
+	full := &openplantbook.PlantDetails{
+		PID: "ocimum basilicum", DisplayPID: "Ocimum basilicum", Alias: "basil", Category: "Lamiaceae",
+		ImageURL: "https://example.com/basil.jpg", MinLightLux: 2500, MaxLightLux: 30000,
+		MinTemp: 10, MaxTemp: 35, MinEnvHumid: 20, MaxEnvHumid: 70,
+		MinSoilMoist: 15, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000,
+	}
+
+	t.Run("missing fields walk the chain in order", func(t *testing.T) {
+		client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			// German has only the alias
+			"ocimum basilicum|de": {PID: "ocimum basilicum", Alias: "Basilikum"},
+			// French adds a category but still lacks ranges
+			"ocimum basilicum|fr": {PID: "ocimum basilicum", Alias: "basilic", Category: "Lamiacées"},
+			"ocimum basilicum|en": full,
+		}}
+		srv := newTestServer(t, client)
+		srv.config.LanguageFallback = []string{"fr", "en"}
+
+		details, err := srv.getPlantDetails(context.Background(), "ocimum basilicum", "de")
+		if err != nil {
+			t.Fatalf("getPlantDetails() error = %v", err)
+		}
+
+		expectedCalls := []string{"ocimum basilicum|de", "ocimum basilicum|fr", "ocimum basilicum|en"}
+		if !reflect.DeepEqual(client.detailCalls, expectedCalls) {
+			t.Errorf("calls = %v, want %v", client.detailCalls, expectedCalls)
+		}
+		if details.Alias != "Basilikum" {
+			t.Errorf("alias = %q, want German alias kept", details.Alias)
+		}
+		if details.Category != "Lamiacées" {
+			t.Errorf("category = %q, want French category before English", details.Category)
+		}
+		if details.MaxTemp != 35 || details.MaxSoilEC != 2000 || details.ImageURL != full.ImageURL {
+			t.Errorf("expected ranges and image filled from English, got %+v", details)
+		}
+	})
+
+	t.Run("missing image and EC do not extend the walk", func(t *testing.T) {
+		german := *full
+		german.Alias, german.ImageURL, german.MinSoilEC, german.MaxSoilEC = "Basilikum", "", 0, 0
+		client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			"ocimum basilicum|de": &german,
+			"ocimum basilicum|en": full,
+		}}
+		srv := newTestServer(t, client)
+
+		details, err := srv.getPlantDetails(context.Background(), "ocimum basilicum", "de")
+		if err != nil {
+			t.Fatalf("getPlantDetails() error = %v", err)
+		}
+		if len(client.detailCalls) != 1 {
+			t.Errorf("expected 1 call, got %v", client.detailCalls)
+		}
+		if details.ImageURL != "" || details.MaxSoilEC != 0 {
+			t.Errorf("image and EC should stay empty without another fetch, got %+v", details)
+		}
+	})
+
+	t.Run("complete first answer stops the walk", func(t *testing.T) {
+		client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			"ocimum basilicum|de": full,
+		}}
+		srv := newTestServer(t, client)
+		srv.config.LanguageFallback = []string{"en"}
+
+		if _, err := srv.getPlantDetails(context.Background(), "ocimum basilicum", "de"); err != nil {
+			t.Fatalf("getPlantDetails() error = %v", err)
+		}
+		if len(client.detailCalls) != 1 {
+			t.Errorf("expected 1 call, got %v", client.detailCalls)
+		}
+	})
+
+	t.Run("failed language falls through", func(t *testing.T) {
+		client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			"ocimum basilicum|en": full,
+		}}
+		srv := newTestServer(t, client)
+
+		details, err := srv.getPlantDetails(context.Background(), "ocimum basilicum", "de")
+		if err != nil {
+			t.Fatalf("getPlantDetails() error = %v", err)
+		}
+		if details.Alias != "basil" {
+			t.Errorf("alias = %q, want English fallback", details.Alias)
+		}
+	})
+
+	t.Run("all languages fail", func(t *testing.T) {
+		srv := newTestServer(t, &fakeClient{})
+
+		if _, err := srv.getPlantDetails(context.Background(), "missing", "de"); err == nil {
+			t.Error("expected error when no language resolves")
+		}
+	})
+}
+
+func TestLanguageParameter(t *testing.T) {
+	english := &openplantbook.PlantDetails{
+		PID: "ocimum basilicum", DisplayPID: "Ocimum basilicum", Alias: "basil",
+		MinTemp: 10, MaxTemp: 35, MinSoilMoist: 15, MaxSoilMoist: 60,
+		MinLightLux: 2500, MaxLightLux: 30000, MinEnvHumid: 20, MaxEnvHumid: 70,
+	}
+	german := *english
+	german.Alias = "Basilikum"
+
+	tools := []struct {
+		name      string
+		handler   func(*Server, context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		arguments map[string]interface{}
+	}{
+		{"get_care_summary", (*Server).handleGetCareSummary, map[string]interface{}{}},
+		{"compare_conditions", (*Server).handleCompareConditions, map[string]interface{}{"current_conditions": map[string]interface{}{"temperature": 20.0}}},
+	}
+
+	for _, tool := range tools {
+		for _, tt := range []struct {
+			language string
+			wantCall string
+		}{
+			{"de", "ocimum basilicum|de"},
+			{"", "ocimum basilicum|en"},
+		} {
+			t.Run(tool.name+"/"+tt.wantCall, func(t *testing.T) {
+				client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+					"ocimum basilicum|en": english,
+					"ocimum basilicum|de": &german,
+				}}
+				srv := newTestServer(t, client)
+
+				arguments := map[string]interface{}{"pid": "ocimum basilicum"}
+				for k, v := range tool.arguments {
+					arguments[k] = v
+				}
+				if tt.language != "" {
+					arguments["language"] = tt.language
+				}
+
+				result, err := tool.handler(srv, context.Background(), mcp.CallToolRequest{
+					Params: mcp.CallToolParams{Name: tool.name, Arguments: arguments},
+				})
+				if err != nil || result.IsError {
+					t.Fatalf("handler failed: err=%v text=%s", err, resultText(t, result))
+				}
+				if len(client.detailCalls) == 0 || client.detailCalls[0] != tt.wantCall {
+					t.Errorf("first details call = %v, want %s", client.detailCalls, tt.wantCall)
+				}
+				if tool.name == "get_care_summary" && tt.language == "de" && !strings.Contains(resultText(t, result), "Basilikum") {
+					t.Errorf("expected German alias in summary, got:\n%s", resultText(t, result))
+				}
+			})
+		}
+	}
+}
+
+func TestParseLanguageList(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected []string
+	}{
+		{"comma string", "de, EN", []string{"de", "en"}},
+		{"list", []interface{}{"fr", " en "}, []string{"fr", "en"}},
+		{"empty", "", nil},
+		{"unsupported", 42, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseLanguageList(tt.value); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseLanguageList(%v) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSessionLanguage_LookupTools(t *testing.T) {
+	german := &openplantbook.PlantDetails{
+		PID: "ocimum basilicum", DisplayPID: "Ocimum basilicum", Alias: "Basilikum",
+		MinTemp: 10, MaxTemp: 35, MinSoilMoist: 15, MaxSoilMoist: 60,
+		MinLightLux: 2500, MaxLightLux: 30000, MinEnvHumid: 20, MaxEnvHumid: 70,
+	}
+
+	tools := []struct {
+		name      string
+		handler   func(*Server, context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		arguments map[string]interface{}
+		want      string
+	}{
+		{"get_plant_care", (*Server).handleGetPlantCare, map[string]interface{}{"pid": "ocimum basilicum"}, "Basilikum"},
+		{"get_plant_care_batch", (*Server).handleGetPlantCareBatch, map[string]interface{}{"pids": []interface{}{"ocimum basilicum"}}, "Basilikum"},
+		{"get_care_summary", (*Server).handleGetCareSummary, map[string]interface{}{"pid": "ocimum basilicum", "interpretation_lang": "en"}, "## Interpretation"},
+	}
+
+	for _, tool := range tools {
+		t.Run(tool.name, func(t *testing.T) {
+			client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+				"ocimum basilicum|de": german,
+			}}
+			srv := newTestServer(t, client)
+			srv.config.DefaultLang = "en"
+
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tool.arguments
+			ctx := withSessionLanguages(context.Background(), []string{"de"})
+			result, err := tool.handler(srv, ctx, request)
+			if err != nil {
+				t.Fatalf("%s error = %v", tool.name, err)
+			}
+			text := resultText(t, result)
+			if result.IsError || !strings.Contains(text, tool.want) {
+				t.Errorf("result = %q, want it to contain %q", text, tool.want)
+			}
+			if len(client.detailCalls) == 0 || client.detailCalls[0] != "ocimum basilicum|de" {
+				t.Errorf("detail calls = %v, want the session language first", client.detailCalls)
+			}
+		})
+	}
+}
+
+func TestNew_EmptyDefaultLangWarnsOnce(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	srv, err := New(&Config{APIKey: "test-key", LogFile: logFile, DefaultLang: " "}, "test")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if srv.config.DefaultLang != fallbackLanguage {
+		t.Errorf("DefaultLang = %q, want it normalized to %q", srv.config.DefaultLang, fallbackLanguage)
+	}
+
+	// Resolving the default again, as every lookup does, must not log
+	for range 3 {
+		srv.defaultLanguage()
+		srv.languageChain(context.Background(), "")
+	}
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(string(data), "no default language configured"); n != 1 {
+		t.Errorf("warning logged %d times, want once:\n%s", n, data)
+	}
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// careMetric describes a care metric that can be compared against a sensor reading
+type careMetric struct {
+	key   string // key used in current_conditions
+	label string // human-readable name
+	unit  string // display unit appended to values
+	// ideal returns the plant's ideal range; ok is false when the plant has no data
+	ideal func(details *openplantbook.PlantDetails) (min, max float64, ok bool)
+}
+
+// careMetrics lists the sensor-comparable metrics in the same order compare_conditions reports them
+var careMetrics = []careMetric{
+	{
+		key:   "moisture",
+		label: "Soil Moisture",
+		unit:  "%",
+		ideal: func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+			return float64(d.MinSoilMoist), float64(d.MaxSoilMoist), d.MaxSoilMoist > 0
+		},
+	},
+	{
+		key:   "temperature",
+		label: "Temperature",
+		unit:  "°C",
+		ideal: func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+			return d.MinTemp, d.MaxTemp, d.MaxTemp > 0
+		},
+	},
+	{
+		key:   "light_lux",
+		label: "Light",
+		unit:  " lux",
+		ideal: func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+			return float64(d.MinLightLux), float64(d.MaxLightLux), d.MaxLightLux > 0
+		},
+	},
+	{
+		key:   "humidity",
+		label: "Humidity",
+		unit:  "%",
+		ideal: func(d *openplantbook.PlantDetails) (float64, float64, bool) {
+			return float64(d.MinEnvHumid), float64(d.MaxEnvHumid), d.MaxEnvHumid > 0
+		},
+	},
+}
+
+// valueRange is a closed numeric interval; a single reading has min == max
+type valueRange struct {
+	min, max float64
+}
+
+// parseConditionRange accepts a number, a {"min": x, "max": y} object, or a [x, y] array
+func parseConditionRange(value interface{}) (valueRange, bool) {
+	switch v := value.(type) {
+	case float64:
+		return valueRange{v, v}, true
+	case int:
+		return valueRange{float64(v), float64(v)}, true
+	case map[string]interface{}:
+		min, minOK := v["min"].(float64)
+		max, maxOK := v["max"].(float64)
+		if !minOK || !maxOK {
+			return valueRange{}, false
+		}
+		return orderedRange(min, max), true
+	case []interface{}:
+		if len(v) != 2 {
+			return valueRange{}, false
+		}
+		min, minOK := v[0].(float64)
+		max, maxOK := v[1].(float64)
+		if !minOK || !maxOK {
+			return valueRange{}, false
+		}
+		return orderedRange(min, max), true
+	}
+	return valueRange{}, false
+}
+
+// orderedRange builds a range regardless of argument order
+func orderedRange(a, b float64) valueRange {
+	return valueRange{math.Min(a, b), math.Max(a, b)}
+}
+
+// hasCareData reports whether the details carry at least one usable care range.
+// The API sometimes returns entry stubs with a name but no ranges.
+func hasCareData(details *openplantbook.PlantDetails) bool {
+	if details == nil {
+		return false
+	}
+	for _, m := range careMetrics {
+		if _, _, ok := m.ideal(details); ok {
+			return true
+		}
+	}
+	return details.MaxSoilEC > 0
+}
+
+// noCareDataMessage is the tool error returned for plants without usable ranges
+func noCareDataMessage(pid string) string {
+	return fmt.Sprintf("care data is unavailable for this plant (%s): OpenPlantbook has an entry but no care ranges for it", pid)
+}
@@ -0,0 +1,120 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Humidity gaps (percentage points below the plant's minimum) that misting can close.
+// A mist raises the humidity around the leaves for only 15-30 minutes, so beyond
+// mistingMaxGap a humidifier is the only reliable fix.
+const (
+	mistingLightGap = 10
+	mistingMaxGap   = 20
+)
+
+// mistingPlan is the misting recommendation for one plant and ambient humidity
+type mistingPlan struct {
+	gap          float64 // points below the plant's minimum; 0 or less when none is needed
+	timesPerDay  int     // 0 when misting isn't recommended
+	humidifier   bool    // the gap is too large for misting
+	tooHumid     bool    // ambient is already above the plant's maximum
+	avoidMisting bool    // the plant's leaves suffer when wet
+}
+
+// planMisting compares ambient humidity with the plant's humidity range
+func planMisting(details *openplantbook.PlantDetails, ambient float64) mistingPlan {
+	p := mistingPlan{
+		gap:          float64(details.MinEnvHumid) - ambient,
+		tooHumid:     ambient > float64(details.MaxEnvHumid),
+		avoidMisting: categoryHasAny(strings.ToLower(details.Category), "gesneriaceae", "saintpaulia", "african violet"),
+	}
+
+	switch {
+	case p.gap <= 0:
+	case p.gap > mistingMaxGap:
+		p.humidifier = true
+	case p.avoidMisting:
+		// Fuzzy leaves spot and rot when misted; the advice points to other fixes
+	case p.gap <= mistingLightGap:
+		p.timesPerDay = 1
+	default:
+		p.timesPerDay = 3
+	}
+	return p
+}
+
+// formatMistingSchedule renders the plan
+func formatMistingSchedule(details *openplantbook.PlantDetails, ambient float64, p mistingPlan) string {
+	min, max := details.MinEnvHumid, details.MaxEnvHumid
+	output := fmt.Sprintf("# Misting Schedule for %s (%s)\n\n", details.Alias, details.DisplayPID)
+	output += fmt.Sprintf("**Ideal humidity**: %d - %d%% (%s) · **Ambient**: %.0f%%\n\n",
+		min, max, humidityPhrase(float64(min+max)/2), ambient)
+
+	switch {
+	case p.tooHumid:
+		output += fmt.Sprintf("✅ **No misting**: the air is already above this plant's maximum (%d%%). Don't mist; improve air circulation instead.\n", max)
+	case p.gap <= 0:
+		output += "✅ **No misting needed**: ambient humidity is within the plant's range.\n"
+	case p.humidifier:
+		output += fmt.Sprintf("❌ **Misting won't be enough**: the air is %.0f points below the minimum. ", p.gap)
+		output += "A mist only raises humidity for 15-30 minutes, so no schedule closes a gap this large. "
+		output += fmt.Sprintf("Use a humidifier set to about %d%%, or move the plant to a terrarium or cabinet.\n", min)
+	case p.avoidMisting:
+		output += fmt.Sprintf("⚠️ **Don't mist this plant**: the air is %.0f points below the minimum, but its leaves spot and rot when wet. ", p.gap)
+		output += "Use a pebble tray, group it with other plants or run a humidifier instead.\n"
+	case p.timesPerDay == 1:
+		output += fmt.Sprintf("💧 **Mist once a day**, in the morning: the air is only %.0f points below the minimum. ", p.gap)
+		output += "A pebble tray would do the same job without the daily chore.\n"
+	default:
+		output += fmt.Sprintf("💧 **Mist %d times a day** (morning, midday, early afternoon): the air is %.0f points below the minimum. ", p.timesPerDay, p.gap)
+		output += "Misting alone is borderline at this gap; add a pebble tray or group plants together, and consider a humidifier if the leaf edges brown.\n"
+	}
+
+	if p.timesPerDay > 0 {
+		output += "\n_Mist with room-temperature water and finish early enough for the leaves to dry before night, which keeps fungal problems away._\n"
+	}
+	return output
+}
+
+// handleMistingSchedule handles the misting_schedule tool
+func (s *Server) handleMistingSchedule(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "misting_schedule")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	ambient, err := request.RequireFloat("ambient_humidity")
+	if err != nil || ambient < 0 || ambient > 100 {
+		logger.Warn("invalid ambient_humidity parameter", "error", err)
+		return mcp.NewToolResultError("ambient_humidity parameter is required and must be a percentage (0-100)"), nil
+	}
+
+	logger.Info("planning misting", "pid", pid, "ambient_humidity", ambient)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxEnvHumid <= 0 {
+		logger.Warn("plant has no humidity data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("no humidity range is available for this plant (%s)", pid)), nil
+	}
+
+	plan := planMisting(details, ambient)
+
+	logger.Info("misting planned", "pid", details.PID, "gap", plan.gap, "times_per_day", plan.timesPerDay, "humidifier", plan.humidifier)
+
+	return mcp.NewToolResultText(formatMistingSchedule(details, ambient, plan)), nil
+}
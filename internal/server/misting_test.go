@@ -0,0 +1,42 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestPlanMisting(t *testing.T) {
+	calathea := &openplantbook.PlantDetails{Category: "Marantaceae", MinEnvHumid: 60, MaxEnvHumid: 80}
+	violet := &openplantbook.PlantDetails{Category: "Gesneriaceae", MinEnvHumid: 50, MaxEnvHumid: 70}
+
+	tests := []struct {
+		name        string
+		details     *openplantbook.PlantDetails
+		ambient     float64
+		timesPerDay int
+		humidifier  bool
+		want        string
+	}{
+		{"within range", calathea, 65, 0, false, "No misting needed"},
+		{"above range", calathea, 90, 0, false, "above this plant's maximum"},
+		{"small gap", calathea, 52, 1, false, "Mist once a day"},
+		{"medium gap", calathea, 45, 3, false, "Mist 3 times a day"},
+		{"gap too large", calathea, 35, 0, true, "Misting won't be enough"},
+		{"fuzzy leaves", violet, 40, 0, false, "Don't mist this plant"},
+		{"fuzzy leaves, large gap", violet, 25, 0, true, "humidifier"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := planMisting(tt.details, tt.ambient)
+			if p.timesPerDay != tt.timesPerDay || p.humidifier != tt.humidifier {
+				t.Errorf("plan = %d/day humidifier=%v, want %d/day humidifier=%v", p.timesPerDay, p.humidifier, tt.timesPerDay, tt.humidifier)
+			}
+			if output := formatMistingSchedule(tt.details, tt.ambient, p); !strings.Contains(output, tt.want) {
+				t.Errorf("expected %q in output:\n%s", tt.want, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// hemispheres lists the accepted hemisphere values
+var hemispheres = []string{"north", "south"}
+
+// seasonForMonth returns the meteorological season for a month
+func seasonForMonth(month time.Month, hemisphere string) string {
+	seasons := []string{"winter", "spring", "summer", "autumn"}
+	index := (int(month) % 12) / 3 // Dec-Feb 0, Mar-May 1, Jun-Aug 2, Sep-Nov 3
+	if hemisphere == "south" {
+		index = (index + 2) % 4
+	}
+	return seasons[index]
+}
+
+// seasonalAdjustment scales routine care for a season
+type seasonalAdjustment struct {
+	wateringFactor float64 // multiplies the growing-season watering interval
+	feed           bool    // whether to fertilize at all
+}
+
+// seasonalAdjustments applies to most plants. Growth slows in autumn and nearly stops in
+// winter, so soil dries more slowly and feeding pauses.
+var seasonalAdjustments = map[string]seasonalAdjustment{
+	"spring": {wateringFactor: 1, feed: true},
+	"summer": {wateringFactor: 0.75, feed: true},
+	"autumn": {wateringFactor: 1.5, feed: false},
+	"winter": {wateringFactor: 2, feed: false},
+}
+
+// dormantSeasonalAdjustments overrides the table for cacti and succulents, which are kept
+// almost dry through their winter rest
+var dormantSeasonalAdjustments = map[string]seasonalAdjustment{
+	"autumn": {wateringFactor: 2, feed: false},
+	"winter": {wateringFactor: 4, feed: false},
+}
+
+// seasonalAdjustmentFor returns the adjustment for a plant in a season
+func seasonalAdjustmentFor(details *openplantbook.PlantDetails, season string) seasonalAdjustment {
+	category := strings.ToLower(details.Category + " " + details.PID)
+	if categoryHasAny(category, "cactaceae", "crassulaceae", "aizoaceae", "cact", "succulent") {
+		if adj, ok := dormantSeasonalAdjustments[season]; ok {
+			return adj
+		}
+	}
+	return seasonalAdjustments[season]
+}
+
+// feedingIntervalDays is how often to fertilize in the growing season, by fertilizerLevel
+var feedingIntervalDays = map[string]int{
+	"low":    28,
+	"medium": 14,
+	"high":   7,
+}
+
+// humidityLovingMin is the minimum ideal humidity (%) at which a plant gets weekly
+// humidity checks; drier plants get one check a month
+const humidityLovingMin = 60
+
+// checklistPlant is one plant's recurring tasks for the month
+type checklistPlant struct {
+	name            string
+	waterEveryDays  int // 0 when there is no moisture data
+	feedEveryDays   int // 0 when not feeding this month
+	weeklyHumidity  bool
+	monthlyHumidity bool
+}
+
+// planChecklistPlant derives a plant's recurring tasks for a season
+func planChecklistPlant(details *openplantbook.PlantDetails, season string) checklistPlant {
+	plant := checklistPlant{name: details.Alias}
+	if plant.name == "" {
+		plant.name = details.PID
+	}
+	adj := seasonalAdjustmentFor(details, season)
+
+	if details.MaxSoilMoist > 0 {
+		interval := float64(gardenWateringInterval(details.MinSoilMoist, details.MaxSoilMoist))
+		plant.waterEveryDays = int(math.Max(math.Round(interval*adj.wateringFactor), 1))
+	}
+	if adj.feed && details.MaxSoilEC > 0 {
+		plant.feedEveryDays = feedingIntervalDays[fertilizerLevel(float64(details.MinSoilEC+details.MaxSoilEC)/2)]
+	}
+	if details.MaxEnvHumid > 0 {
+		plant.weeklyHumidity = details.MinEnvHumid >= humidityLovingMin
+		plant.monthlyHumidity = !plant.weeklyHumidity
+	}
+	return plant
+}
+
+// taskDays returns the days of the month (1-based) a task falls on, starting on day 1
+func taskDays(everyDays, daysInMonth int) []int {
+	if everyDays <= 0 {
+		return nil
+	}
+	var days []int
+	for day := 1; day <= daysInMonth; day += everyDays {
+		days = append(days, day)
+	}
+	return days
+}
+
+// checklistWeek is one printable week of the checklist
+type checklistWeek struct {
+	first, last int      // days of the month
+	water       []string // "Name (days 1, 4)"
+	feed        []string
+	humidity    []string
+}
+
+// empty reports whether the week has no tasks
+func (w checklistWeek) empty() bool {
+	return len(w.water)+len(w.feed)+len(w.humidity) == 0
+}
+
+// buildChecklistWeeks spreads each plant's tasks over the weeks of the month. Weeks are
+// days 1-7, 8-14, 15-21, 22-28 and whatever remains.
+func buildChecklistWeeks(plants []checklistPlant, daysInMonth int) []checklistWeek {
+	var weeks []checklistWeek
+	for first := 1; first <= daysInMonth; first += 7 {
+		weeks = append(weeks, checklistWeek{first: first, last: int(math.Min(float64(first+6), float64(daysInMonth)))})
+	}
+
+	inWeek := func(days []int, w checklistWeek) []int {
+		var matched []int
+		for _, d := range days {
+			if d >= w.first && d <= w.last {
+				matched = append(matched, d)
+			}
+		}
+		return matched
+	}
+	entry := func(name string, days []int) string {
+		parts := make([]string, len(days))
+		for i, d := range days {
+			parts[i] = fmt.Sprintf("%d", d)
+		}
+		label := "day"
+		if len(days) > 1 {
+			label = "days"
+		}
+		return fmt.Sprintf("%s (%s %s)", name, label, strings.Join(parts, ", "))
+	}
+
+	for i := range weeks {
+		w := &weeks[i]
+		for _, p := range plants {
+			if days := inWeek(taskDays(p.waterEveryDays, daysInMonth), *w); len(days) > 0 {
+				w.water = append(w.water, entry(p.name, days))
+			}
+			if days := inWeek(taskDays(p.feedEveryDays, daysInMonth), *w); len(days) > 0 {
+				w.feed = append(w.feed, entry(p.name, days))
+			}
+			if p.weeklyHumidity || (p.monthlyHumidity && i == 0) {
+				w.humidity = append(w.humidity, p.name)
+			}
+		}
+	}
+	return weeks
+}
+
+// seasonChecklistNotes are reminders printed under the checklist for each season
+var seasonChecklistNotes = map[string]string{
+	"spring": "Growth is picking up: resume feeding and check whether any plant has outgrown its pot.",
+	"summer": "Soil dries faster in heat; check the top of the soil between scheduled waterings.",
+	"autumn": "Growth is slowing: watering is spaced out and feeding paused until spring.",
+	"winter": "Most plants rest in winter; heating dries the air, so keep an eye on humidity.",
+}
+
+// formatMonthlyChecklist renders the printable checklist
+func formatMonthlyChecklist(month time.Month, season, hemisphere string, plants []checklistPlant, weeks []checklistWeek, skipped []string) string {
+	output := fmt.Sprintf("# Monthly Care Checklist: %s\n\n", month)
+	output += fmt.Sprintf("_Season: %s (%s hemisphere) · %d plant(s)_\n\n", season, hemisphere, len(plants))
+
+	for i, w := range weeks {
+		output += fmt.Sprintf("## Week %d (days %d-%d)\n\n", i+1, w.first, w.last)
+		if w.empty() {
+			output += "- Nothing scheduled\n\n"
+			continue
+		}
+		for _, water := range w.water {
+			output += fmt.Sprintf("- [ ] Water %s\n", water)
+		}
+		for _, feed := range w.feed {
+			output += fmt.Sprintf("- [ ] Feed %s\n", feed)
+		}
+		for _, name := range w.humidity {
+			output += fmt.Sprintf("- [ ] Check humidity around %s\n", name)
+		}
+		output += "\n"
+	}
+
+	output += "## Plants\n\n"
+	output += "| Plant | Water every | Feed every | Humidity check |\n"
+	output += "|---|---|---|---|\n"
+	for _, p := range plants {
+		water, feed, humidity := "-", "not this month", "-"
+		if p.waterEveryDays > 0 {
+			water = fmt.Sprintf("%d day(s)", p.waterEveryDays)
+		}
+		if p.feedEveryDays > 0 {
+			feed = fmt.Sprintf("%d day(s)", p.feedEveryDays)
+		}
+		if p.weeklyHumidity {
+			humidity = "weekly"
+		} else if p.monthlyHumidity {
+			humidity = "monthly"
+		}
+		output += fmt.Sprintf("| %s | %s | %s | %s |\n", p.name, water, feed, humidity)
+	}
+	output += "\n"
+
+	if len(skipped) > 0 {
+		output += "## Not Included\n\n"
+		for _, s := range skipped {
+			output += fmt.Sprintf("- %s\n", s)
+		}
+		output += "\n"
+	}
+
+	output += fmt.Sprintf("_%s Intervals assume a medium pot and are estimates; check the soil before watering._\n", seasonChecklistNotes[season])
+	return output
+}
+
+// handleMonthlyChecklist handles the monthly_checklist tool
+func (s *Server) handleMonthlyChecklist(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "monthly_checklist")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	now := time.Now()
+	month := request.GetInt("month", int(now.Month()))
+	if month < 1 || month > 12 {
+		logger.Warn("invalid month parameter", "month", month)
+		return mcp.NewToolResultError("month must be a number from 1 (January) to 12 (December)"), nil
+	}
+
+	hemisphere := request.GetString("hemisphere", "north")
+	if !containsString(hemispheres, hemisphere) {
+		logger.Warn("invalid hemisphere parameter", "hemisphere", hemisphere)
+		return mcp.NewToolResultError(fmt.Sprintf("hemisphere must be one of: %s", strings.Join(hemispheres, ", "))), nil
+	}
+
+	season := seasonForMonth(time.Month(month), hemisphere)
+	daysInMonth := time.Date(now.Year(), time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	logger.Info("building monthly checklist", "pids", len(pids), "month", month, "season", season)
+
+	var plants []checklistPlant
+	var skipped []string
+	for _, f := range s.fetchPlantsConcurrently(ctx, pids) {
+		if f.err != nil {
+			skipped = append(skipped, fmt.Sprintf("%s: %v", f.pid, f.err))
+			continue
+		}
+		plants = append(plants, planChecklistPlant(f.details, season))
+	}
+	if len(plants) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no plants could be fetched: %s", strings.Join(skipped, "; "))), nil
+	}
+
+	weeks := buildChecklistWeeks(plants, daysInMonth)
+
+	logger.Info("monthly checklist built", "plants", len(plants), "skipped", len(skipped))
+
+	return mcp.NewToolResultText(formatMonthlyChecklist(time.Month(month), season, hemisphere, plants, weeks, skipped)), nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestSeasonForMonth(t *testing.T) {
+	tests := []struct {
+		month      time.Month
+		hemisphere string
+		want       string
+	}{
+		{time.December, "north", "winter"},
+		{time.February, "north", "winter"},
+		{time.April, "north", "spring"},
+		{time.July, "north", "summer"},
+		{time.October, "north", "autumn"},
+		{time.January, "south", "summer"},
+		{time.April, "south", "autumn"},
+	}
+
+	for _, tt := range tests {
+		if got := seasonForMonth(tt.month, tt.hemisphere); got != tt.want {
+			t.Errorf("seasonForMonth(%s, %s) = %s, want %s", tt.month, tt.hemisphere, got, tt.want)
+		}
+	}
+}
+
+func TestPlanChecklistPlant(t *testing.T) {
+	basil := &openplantbook.PlantDetails{PID: "ocimum basilicum", Alias: "Basil", Category: "Lamiaceae",
+		MinSoilMoist: 20, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000, MinEnvHumid: 40, MaxEnvHumid: 70}
+	cactus := &openplantbook.PlantDetails{PID: "echinopsis", Alias: "Echinopsis", Category: "Cactaceae",
+		MinSoilMoist: 10, MaxSoilMoist: 30, MinSoilEC: 100, MaxSoilEC: 600, MinEnvHumid: 60, MaxEnvHumid: 80}
+
+	tests := []struct {
+		name    string
+		details *openplantbook.PlantDetails
+		season  string
+		want    checklistPlant
+	}{
+		{"growing season", basil, "spring", checklistPlant{name: "Basil", waterEveryDays: 8, feedEveryDays: 14, monthlyHumidity: true}},
+		{"summer waters more often", basil, "summer", checklistPlant{name: "Basil", waterEveryDays: 6, feedEveryDays: 14, monthlyHumidity: true}},
+		{"winter rest", basil, "winter", checklistPlant{name: "Basil", waterEveryDays: 16, monthlyHumidity: true}},
+		{"cactus dormancy", cactus, "winter", checklistPlant{name: "Echinopsis", waterEveryDays: 16, weeklyHumidity: true}},
+		{"cactus growing season", cactus, "spring", checklistPlant{name: "Echinopsis", waterEveryDays: 4, feedEveryDays: 28, weeklyHumidity: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := planChecklistPlant(tt.details, tt.season); got != tt.want {
+				t.Errorf("planChecklistPlant() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildChecklistWeeks(t *testing.T) {
+	weeks := buildChecklistWeeks([]checklistPlant{
+		{name: "Basil", waterEveryDays: 8, feedEveryDays: 14, monthlyHumidity: true},
+		{name: "Fern", waterEveryDays: 3, weeklyHumidity: true},
+	}, 30)
+
+	if len(weeks) != 5 || weeks[4].first != 29 || weeks[4].last != 30 {
+		t.Fatalf("unexpected weeks: %+v", weeks)
+	}
+	if want := []string{"Basil (day 1)", "Fern (days 1, 4, 7)"}; !reflect.DeepEqual(weeks[0].water, want) {
+		t.Errorf("week 1 water = %v, want %v", weeks[0].water, want)
+	}
+	if want := []string{"Basil (day 15)"}; !reflect.DeepEqual(weeks[2].feed, want) {
+		t.Errorf("week 3 feed = %v, want %v", weeks[2].feed, want)
+	}
+	if want := []string{"Basil", "Fern"}; !reflect.DeepEqual(weeks[0].humidity, want) {
+		t.Errorf("week 1 humidity = %v, want %v", weeks[0].humidity, want)
+	}
+	if want := []string{"Fern"}; !reflect.DeepEqual(weeks[1].humidity, want) {
+		t.Errorf("week 2 humidity = %v, want %v", weeks[1].humidity, want)
+	}
+}
+
+func TestHandleMonthlyChecklist(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"ocimum basilicum|en": {PID: "ocimum basilicum", Alias: "Basil", MinSoilMoist: 20, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 2000},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pids": []interface{}{"ocimum basilicum", "missing"}, "month": 4.0}
+	result, err := srv.handleMonthlyChecklist(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("monthly_checklist failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Monthly Care Checklist: April", "Season: spring (north hemisphere)", "## Week 5 (days 29-30)", "- [ ] Water Basil (day 25)", "- [ ] Feed Basil (day 15)", "## Not Included", "missing"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	for _, args := range []map[string]interface{}{
+		{"pids": []interface{}{"ocimum basilicum"}, "month": 13.0},
+		{"pids": []interface{}{"ocimum basilicum"}, "hemisphere": "east"},
+	} {
+		request.Params.Arguments = args
+		if result, _ := srv.handleMonthlyChecklist(context.Background(), request); !result.IsError {
+			t.Errorf("expected %v to be rejected", args)
+		}
+	}
+}
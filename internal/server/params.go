@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// parameterAliases maps alternative parameter names clients send onto the canonical
+// schema key. An alias only applies to tools whose schema declares the canonical key.
+// Keys here are already folded with foldParameterKey.
+var parameterAliases = map[string]string{
+	"plantid":    "pid",
+	"plant":      "pid",
+	"q":          "query",
+	"conditions": "current_conditions",
+	"lang":       "language",
+}
+
+// foldParameterKey reduces a parameter name to a case- and separator-insensitive form,
+// so "PID", "Pid", "current-conditions" and "currentConditions" match their schema keys
+func foldParameterKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	return strings.NewReplacer("_", "", "-", "", " ", "").Replace(key)
+}
+
+// normalizeArguments renames argument keys to the schema's canonical names.
+// Exact matches always win; a variant is only renamed when the canonical key is absent.
+// Keys that match nothing are passed through untouched.
+func normalizeArguments(args map[string]interface{}, properties map[string]interface{}) map[string]interface{} {
+	canonical := make(map[string]string, len(properties))
+	for name := range properties {
+		canonical[foldParameterKey(name)] = name
+	}
+
+	normalized := make(map[string]interface{}, len(args))
+	for key, value := range args {
+		if _, ok := properties[key]; ok {
+			normalized[key] = value
+		}
+	}
+	for key, value := range args {
+		if _, ok := properties[key]; ok {
+			continue
+		}
+		folded := foldParameterKey(key)
+		name, ok := canonical[folded]
+		if !ok {
+			if alias, isAlias := parameterAliases[folded]; isAlias {
+				if _, declared := properties[alias]; declared {
+					name, ok = alias, true
+				}
+			}
+		}
+		if _, taken := normalized[name]; ok && !taken {
+			normalized[name] = value
+			continue
+		}
+		normalized[key] = value
+	}
+	return normalized
+}
+
+// withNormalizedArguments wraps a tool handler so argument keys are normalized
+// against the tool's input schema before the handler reads them
+func withNormalizedArguments(properties map[string]interface{}, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if args := request.GetArguments(); len(args) > 0 {
+			request.Params.Arguments = normalizeArguments(args, properties)
+		}
+		return handler(ctx, request)
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestNormalizeArguments(t *testing.T) {
+	properties := map[string]interface{}{
+		"pid":                map[string]interface{}{"type": "string"},
+		"current_conditions": map[string]interface{}{"type": "object"},
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{"lowercase", map[string]interface{}{"pid": "a"}, map[string]interface{}{"pid": "a"}},
+		{"uppercase", map[string]interface{}{"PID": "a"}, map[string]interface{}{"pid": "a"}},
+		{"title case", map[string]interface{}{"Pid": "a"}, map[string]interface{}{"pid": "a"}},
+		{"camel case", map[string]interface{}{"currentConditions": "c"}, map[string]interface{}{"current_conditions": "c"}},
+		{"alias", map[string]interface{}{"plant_id": "a"}, map[string]interface{}{"pid": "a"}},
+		{"exact key wins", map[string]interface{}{"pid": "a", "PID": "b"}, map[string]interface{}{"pid": "a", "PID": "b"}},
+		{"alias for undeclared key passes through", map[string]interface{}{"q": "x"}, map[string]interface{}{"q": "x"}},
+		{"unknown key passes through", map[string]interface{}{"Extra": 1}, map[string]interface{}{"Extra": 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeArguments(tt.args, properties)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("normalizeArguments() = %v, want %v", got, tt.expected)
+			}
+			for k, v := range tt.expected {
+				if got[k] != v {
+					t.Errorf("normalizeArguments()[%q] = %v, want %v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestWithNormalizedArguments(t *testing.T) {
+	properties := map[string]interface{}{"pid": map[string]interface{}{"type": "string"}}
+	handler := withNormalizedArguments(properties, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		pid, err := request.RequireString("pid")
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		return mcp.NewToolResultText(pid), nil
+	})
+
+	for _, key := range []string{"pid", "Pid", "PID"} {
+		t.Run(key, func(t *testing.T) {
+			req := mcp.CallToolRequest{}
+			req.Params.Arguments = map[string]interface{}{key: "monstera deliciosa"}
+			result, err := handler(context.Background(), req)
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if text := resultText(t, result); text != "monstera deliciosa" {
+				t.Errorf("handler returned %q", text)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// passportSchemaVersion is bumped whenever the passport document changes shape.
+// Additive, optional fields do not require a bump; renames and removals do.
+const passportSchemaVersion = "1"
+
+// plantPassport is a portable, versioned JSON document describing one plant
+type plantPassport struct {
+	SchemaVersion string              `json:"schema_version"`
+	GeneratedAt   string              `json:"generated_at"`
+	Plant         passportIdentity    `json:"plant"`
+	Care          passportCare        `json:"care"`
+	Guidance      map[string]string   `json:"guidance,omitempty"`
+	Images        []string            `json:"images,omitempty"`
+	Attribution   passportAttribution `json:"attribution"`
+}
+
+// passportIdentity identifies the plant
+type passportIdentity struct {
+	PID        string `json:"pid"`
+	DisplayPID string `json:"display_pid"`
+	Alias      string `json:"alias,omitempty"`
+	Category   string `json:"category,omitempty"`
+}
+
+// passportRange is an ideal min/max range
+type passportRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// passportCare holds the care ranges in metric units; ranges without data are omitted
+type passportCare struct {
+	LightLux        *passportRange `json:"light_lux,omitempty"`
+	TemperatureC    *passportRange `json:"temperature_c,omitempty"`
+	HumidityPct     *passportRange `json:"humidity_pct,omitempty"`
+	SoilMoisturePct *passportRange `json:"soil_moisture_pct,omitempty"`
+	SoilECUsCm      *passportRange `json:"soil_ec_us_cm,omitempty"`
+}
+
+// passportAttribution credits the data source
+type passportAttribution struct {
+	Source string `json:"source"`
+	URL    string `json:"url"`
+	Notice string `json:"notice"`
+}
+
+// newPassportRange returns a range, or nil when the plant has no data (max of zero)
+func newPassportRange(min, max float64) *passportRange {
+	if max <= 0 {
+		return nil
+	}
+	return &passportRange{Min: min, Max: max}
+}
+
+// buildPlantPassport assembles a passport from plant details
+func buildPlantPassport(details *openplantbook.PlantDetails, generatedAt time.Time) plantPassport {
+	passport := plantPassport{
+		SchemaVersion: passportSchemaVersion,
+		GeneratedAt:   generatedAt.UTC().Format(time.RFC3339),
+		Plant: passportIdentity{
+			PID:        details.PID,
+			DisplayPID: details.DisplayPID,
+			Alias:      details.Alias,
+			Category:   details.Category,
+		},
+		Care: passportCare{
+			LightLux:        newPassportRange(float64(details.MinLightLux), float64(details.MaxLightLux)),
+			TemperatureC:    newPassportRange(details.MinTemp, details.MaxTemp),
+			HumidityPct:     newPassportRange(float64(details.MinEnvHumid), float64(details.MaxEnvHumid)),
+			SoilMoisturePct: newPassportRange(float64(details.MinSoilMoist), float64(details.MaxSoilMoist)),
+			SoilECUsCm:      newPassportRange(float64(details.MinSoilEC), float64(details.MaxSoilEC)),
+		},
+		Attribution: passportAttribution{
+			Source: dataSourceName,
+			URL:    dataSourceURL,
+			Notice: "Plant care data from the OpenPlantbook community",
+		},
+	}
+
+	guidance := map[string]string{}
+	if details.MaxLightLux > 0 {
+		guidance["light"] = stripInterpretation(interpretLightLevel(details.MinLightLux, details.MaxLightLux))
+	}
+	if details.MaxSoilMoist > 0 {
+		guidance["soil_moisture"] = stripInterpretation(interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist))
+	}
+	if len(guidance) > 0 {
+		passport.Guidance = guidance
+	}
+
+	if details.ImageURL != "" {
+		passport.Images = []string{details.ImageURL}
+	}
+
+	return passport
+}
+
+// handlePlantPassport handles the plant_passport tool
+func (s *Server) handlePlantPassport(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "plant_passport")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	language := request.GetString("language", "")
+
+	logger.Info("building plant passport", "pid", pid, "language", language)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, language)
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	data, err := json.MarshalIndent(buildPlantPassport(details, time.Now()), "", "  ")
+	if err != nil {
+		logger.Error("marshal passport failed", "error", err)
+		return mcp.NewToolResultError("failed to format plant passport"), nil
+	}
+
+	logger.Info("plant passport built", "pid", details.PID)
+
+	return mcp.NewToolResultText(string(data)), nil
+}
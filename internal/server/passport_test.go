@@ -0,0 +1,64 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBuildPlantPassport(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		PID: "ocimum basilicum", DisplayPID: "Ocimum basilicum", Alias: "basil", Category: "Lamiaceae",
+		ImageURL: "https://example.com/basil.jpg", MinLightLux: 2500, MaxLightLux: 30000,
+		MinTemp: 10, MaxTemp: 35, MinSoilMoist: 15, MaxSoilMoist: 60,
+	}
+	generated := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	data, err := json.Marshal(buildPlantPassport(details, generated))
+	if err != nil {
+		t.Fatalf("marshal passport: %v", err)
+	}
+
+	// Decode generically to check the documented field names
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("unmarshal passport: %v", err)
+	}
+
+	if doc["schema_version"] != passportSchemaVersion {
+		t.Errorf("schema_version = %v, want %s", doc["schema_version"], passportSchemaVersion)
+	}
+	if doc["generated_at"] != "2024-05-01T08:00:00Z" {
+		t.Errorf("generated_at = %v", doc["generated_at"])
+	}
+
+	plant := doc["plant"].(map[string]interface{})
+	if plant["pid"] != "ocimum basilicum" || plant["category"] != "Lamiaceae" {
+		t.Errorf("plant = %v", plant)
+	}
+
+	care := doc["care"].(map[string]interface{})
+	light := care["light_lux"].(map[string]interface{})
+	if light["min"] != 2500.0 || light["max"] != 30000.0 {
+		t.Errorf("light_lux = %v", light)
+	}
+	for _, missing := range []string{"humidity_pct", "soil_ec_us_cm"} {
+		if _, ok := care[missing]; ok {
+			t.Errorf("expected %s to be omitted without data", missing)
+		}
+	}
+
+	guidance := doc["guidance"].(map[string]interface{})
+	if guidance["light"] != "Bright indirect light - near windows" {
+		t.Errorf("guidance.light = %v", guidance["light"])
+	}
+
+	if images := doc["images"].([]interface{}); len(images) != 1 || images[0] != details.ImageURL {
+		t.Errorf("images = %v", images)
+	}
+	if attribution := doc["attribution"].(map[string]interface{}); attribution["source"] != "OpenPlantbook" {
+		t.Errorf("attribution = %v", attribution)
+	}
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// pinTokenPrefix marks a pid argument as a pin_plant token rather than a real pid
+const pinTokenPrefix = "pin-"
+
+// pinStore maps pin tokens to pids, per MCP session
+type pinStore struct {
+	mu     sync.Mutex
+	tokens map[string]string // "session|token" -> pid
+}
+
+// pinSessionID identifies the calling MCP session; stdio has a single session
+func pinSessionID(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return session.SessionID()
+	}
+	return ""
+}
+
+// pinToken derives a short token from a pid. The same pid always gets the same token,
+// so pinning twice is harmless.
+func pinToken(pid string) string {
+	h := fnv.New32a()
+	h.Write([]byte(strings.ToLower(pid)))
+	return fmt.Sprintf("%s%06x", pinTokenPrefix, h.Sum32()&0xffffff)
+}
+
+// isPinToken reports whether a pid argument is a pin token
+func isPinToken(pid string) bool {
+	return strings.HasPrefix(pid, pinTokenPrefix)
+}
+
+// pin records pid for the session and returns its token
+func (p *pinStore) pin(session, pid string) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.tokens == nil {
+		p.tokens = make(map[string]string)
+	}
+	token := pinToken(pid)
+	p.tokens[session+"|"+token] = pid
+	return token
+}
+
+// lookup returns the pid pinned under token for the session
+func (p *pinStore) lookup(session, token string) (string, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pid, ok := p.tokens[session+"|"+token]
+	return pid, ok
+}
+
+// unpin forgets a token
+func (p *pinStore) unpin(session, token string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.tokens, session+"|"+token)
+}
+
+// pinnedUntil returns when the cached details behind a pin expire, checking every
+// language in the chain; false means nothing is cached any more
+func (s *Server) pinnedUntil(ctx context.Context, pid string) (time.Time, bool) {
+	if s.cache == nil {
+		return time.Time{}, false
+	}
+	for _, lang := range s.languageChain(ctx, "") {
+		if at, ok := s.cache.fetchedAt(detailsCacheKey(pid, lang)); ok {
+			return at.Add(s.cache.ttl), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// resolvePin turns a pin token into its pid; any other value is returned unchanged.
+// Tokens expire with the cached details they point at.
+func (s *Server) resolvePin(ctx context.Context, pid string) (string, error) {
+	if !isPinToken(pid) {
+		return pid, nil
+	}
+
+	session := pinSessionID(ctx)
+	resolved, ok := s.pins.lookup(session, pid)
+	if !ok {
+		return "", fmt.Errorf("unknown pin token %q; call pin_plant with the full pid first", pid)
+	}
+	if _, live := s.pinnedUntil(ctx, resolved); !live {
+		s.pins.unpin(session, pid)
+		return "", fmt.Errorf("pin token %q for %s has expired with its cached data; call pin_plant again", pid, resolved)
+	}
+	return resolved, nil
+}
+
+// handlePinPlant handles the pin_plant tool
+func (s *Server) handlePinPlant(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "pin_plant")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	if s.cache == nil {
+		logger.Warn("pin requested with caching disabled")
+		return mcp.NewToolResultError("pin_plant needs the response cache; enable it with OPENPLANTBOOK_CACHE_ENABLED=true"), nil
+	}
+
+	logger.Info("pinning plant", "pid", pid)
+
+	// Get plant details; this also places them in the cache the pin lives on
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	// Pinning a token again re-pins the pid it points at
+	pid, _ = s.resolvePin(ctx, pid)
+	token := s.pins.pin(pinSessionID(ctx), pid)
+	expires, _ := s.pinnedUntil(ctx, pid)
+
+	logger.Info("plant pinned", "pid", pid, "token", token)
+
+	output := fmt.Sprintf("Pinned **%s** (%s) as `%s`.\n\n", details.Alias, details.DisplayPID, token)
+	output += "Pass the token as the `pid` argument of any tool in this conversation instead of the full pid. "
+	output += fmt.Sprintf("It expires with the cached data at %s; pin again after that.\n", expires.UTC().Format(time.RFC3339))
+	return mcp.NewToolResultText(output), nil
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestPinPlant(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera",
+			MinTemp: 15, MaxTemp: 30, MaxSoilMoist: 60},
+	}}
+	srv := newTestServer(t, client)
+	srv.cache = newResponseCache(time.Hour, 0)
+	now := time.Now()
+	srv.cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+
+	result, err := srv.handlePinPlant(ctx, request)
+	if err != nil || result.IsError {
+		t.Fatalf("pin_plant failed: %v %s", err, resultText(t, result))
+	}
+	token := pinToken("monstera deliciosa")
+	if !strings.Contains(resultText(t, result), "`"+token+"`") {
+		t.Fatalf("expected token %s in output:\n%s", token, resultText(t, result))
+	}
+
+	t.Run("token resolves from cache", func(t *testing.T) {
+		calls := len(client.detailCalls)
+		details, err := srv.getPlantDetails(ctx, token, "")
+		if err != nil {
+			t.Fatalf("getPlantDetails(%s): %v", token, err)
+		}
+		if details.Alias != "Monstera" {
+			t.Errorf("resolved to %q, want Monstera", details.Alias)
+		}
+		if len(client.detailCalls) != calls {
+			t.Errorf("expected a cache hit, got API calls %v", client.detailCalls[calls:])
+		}
+	})
+
+	t.Run("same pid same token", func(t *testing.T) {
+		if again := srv.pins.pin("", "monstera deliciosa"); again != token {
+			t.Errorf("re-pin token = %s, want %s", again, token)
+		}
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := srv.getPlantDetails(ctx, "pin-000000", "")
+		if err == nil || !strings.Contains(err.Error(), "unknown pin token") {
+			t.Errorf("expected unknown token error, got %v", err)
+		}
+	})
+
+	t.Run("token expires with cache entry", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+		_, err := srv.getPlantDetails(ctx, token, "")
+		if err == nil || !strings.Contains(err.Error(), "expired") {
+			t.Errorf("expected expiry error, got %v", err)
+		}
+		if _, ok := srv.pins.lookup("", token); ok {
+			t.Error("expired token should be forgotten")
+		}
+	})
+}
+
+func TestPinPlant_RequiresCache(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+
+	result, err := srv.handlePinPlant(context.Background(), request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.IsError || !strings.Contains(resultText(t, result), "cache") {
+		t.Errorf("expected cache-required error, got %s", resultText(t, result))
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// plantCareBatchEntry is one pid's outcome in get_plant_care_batch: its details, or why
+// they couldn't be fetched
+type plantCareBatchEntry struct {
+	Details *openplantbook.PlantDetails `json:"details,omitempty"`
+	Error   string                      `json:"error,omitempty"`
+}
+
+// plantCareBatch is the get_plant_care_batch response, keyed by the pid as requested
+type plantCareBatch struct {
+	Plants    map[string]plantCareBatchEntry `json:"plants"`
+	Succeeded int                            `json:"succeeded"`
+	Failed    int                            `json:"failed"`
+}
+
+// buildPlantCareBatch collects fetched plants into the response map; one plant failing
+// doesn't fail the others
+func buildPlantCareBatch(fetched []plantFetch) plantCareBatch {
+	batch := plantCareBatch{Plants: make(map[string]plantCareBatchEntry, len(fetched))}
+	for _, f := range fetched {
+		if f.err != nil {
+			batch.Plants[f.pid] = plantCareBatchEntry{Error: f.err.Error()}
+			batch.Failed++
+			continue
+		}
+		batch.Plants[f.pid] = plantCareBatchEntry{Details: f.details}
+		batch.Succeeded++
+	}
+	return batch
+}
+
+// handleGetPlantCareBatch handles the get_plant_care_batch tool
+func (s *Server) handleGetPlantCareBatch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "get_plant_care_batch")
+
+	// Extract parameters; duplicates would share a key in the response, so fetch them once
+	var pids []string
+	seen := map[string]bool{}
+	for _, pid := range request.GetStringSlice("pids", nil) {
+		if pid != "" && !seen[pid] {
+			seen[pid] = true
+			pids = append(pids, pid)
+		}
+	}
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	language := request.GetString("language", "")
+
+	if request.GetBool("no_cache", false) {
+		ctx = withNoCache(ctx)
+	}
+
+	logger.Info("getting plant care batch", "pids", len(pids), "language", language, "concurrency", s.fetchConcurrency())
+
+	batch := buildPlantCareBatch(s.fetchPlantsInLanguage(withTraceID(ctx, traceID), pids, language))
+
+	// Format response
+	data, err := json.MarshalIndent(batch, "", "  ")
+	if err != nil {
+		logger.Error("marshal batch failed", "error", err)
+		return mcp.NewToolResultError("failed to format details"), nil
+	}
+
+	logger.Info("plant care batch retrieved", "succeeded", batch.Succeeded, "failed", batch.Failed)
+
+	return mcp.NewToolResultText(string(data)), nil
+}
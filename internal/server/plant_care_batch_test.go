@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestHandleGetPlantCareBatch(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", Alias: "Monstera"},
+		"epipremnum aureum|":  {PID: "epipremnum aureum", Alias: "Pothos"},
+	}}
+	srv := newTestServer(t, client)
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"pids": []interface{}{"monstera deliciosa", "no such plant", "epipremnum aureum", "monstera deliciosa"},
+	}
+	result, err := srv.handleGetPlantCareBatch(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handleGetPlantCareBatch() error = %v", err)
+	}
+	text := resultText(t, result)
+	if result.IsError {
+		t.Fatalf("a partial failure should not fail the call: %s", text)
+	}
+
+	var batch plantCareBatch
+	if err := json.Unmarshal([]byte(text), &batch); err != nil {
+		t.Fatalf("decode batch: %v", err)
+	}
+	if batch.Succeeded != 2 || batch.Failed != 1 || len(batch.Plants) != 3 {
+		t.Errorf("batch = %+v, want 2 succeeded and 1 failed", batch)
+	}
+	if got := batch.Plants["epipremnum aureum"]; got.Details == nil || got.Details.Alias != "Pothos" || got.Error != "" {
+		t.Errorf("epipremnum aureum = %+v, want Pothos details", got)
+	}
+	if got := batch.Plants["no such plant"]; got.Details != nil || !strings.Contains(got.Error, "not found") {
+		t.Errorf("no such plant = %+v, want a not found error", got)
+	}
+	if len(client.detailCalls) != 3 {
+		t.Errorf("detail calls = %v, want the duplicate fetched once", client.detailCalls)
+	}
+}
+
+func TestHandleGetPlantCareBatch_NoPids(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pids": []interface{}{}}
+	result, err := srv.handleGetPlantCareBatch(context.Background(), request)
+	if err != nil {
+		t.Fatalf("handleGetPlantCareBatch() error = %v", err)
+	}
+	if text := resultText(t, result); !result.IsError || !strings.Contains(text, "pids parameter is required") {
+		t.Errorf("result = %q, want a pids error", text)
+	}
+}
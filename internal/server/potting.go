@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Pot diameter bounds (cm) for the size classes shared with collection_watering_plan
+const (
+	smallPotMaxCM  = 12
+	mediumPotMaxCM = 25
+)
+
+// potSizeForDiameter classifies a pot by its diameter
+func potSizeForDiameter(cm float64) string {
+	switch {
+	case cm < smallPotMaxCM:
+		return "small"
+	case cm <= mediumPotMaxCM:
+		return "medium"
+	}
+	return "large"
+}
+
+// pottingProfile is the subset of care data and pot size the potting rules look at
+type pottingProfile struct {
+	category    string // lowercase botanical family/category
+	moistureAvg int    // midpoint of the soil moisture range
+	potSize     string
+}
+
+// likesDry reports a plant that wants its soil to dry out between waterings
+func (p pottingProfile) likesDry() bool {
+	return p.moistureAvg < 30 || categoryHasAny(p.category, "cactaceae", "crassulaceae", "aizoaceae", "cact", "succulent")
+}
+
+// likesWet reports a plant that wants consistently moist soil
+func (p pottingProfile) likesWet() bool {
+	return p.moistureAvg >= 55
+}
+
+// epiphyte reports a plant whose roots need air more than soil
+func (p pottingProfile) epiphyte() bool {
+	return categoryHasAny(p.category, "orchidaceae", "bromeliaceae", "orchid", "bromeliad")
+}
+
+// pottingRule is a pot size / moisture combination worth flagging
+type pottingRule struct {
+	name     string
+	severity badgeStatus
+	advice   string
+	matches  func(p pottingProfile) bool
+}
+
+// pottingRules is the heuristic table behind potting_advice. The first match wins:
+//
+//	large pot + likes to dry out or epiphyte  -> critical: root rot
+//	medium pot + epiphyte                     -> attention: roots stay wet
+//	small pot + likes wet soil                -> attention: dries out too fast
+//	large pot + likes wet soil                -> healthy: holds moisture well
+//	medium pot + likes to dry out             -> attention: drainage matters
+//	anything else                             -> healthy
+var pottingRules = []pottingRule{
+	{
+		name:     "Root rot risk",
+		severity: badgeCritical,
+		advice:   "a large pot holds water far longer than this plant wants, so the roots sit wet; consider a smaller, faster-draining pot (terracotta with a gritty or chunky mix)",
+		matches: func(p pottingProfile) bool {
+			return p.potSize == "large" && (p.likesDry() || p.epiphyte())
+		},
+	},
+	{
+		name:     "Slow-drying pot",
+		severity: badgeAttention,
+		advice:   "epiphyte roots need air; use a slotted or clear orchid pot with bark rather than soil, sized to the root ball",
+		matches: func(p pottingProfile) bool {
+			return p.potSize == "medium" && p.epiphyte()
+		},
+	},
+	{
+		name:     "Dries out too fast",
+		severity: badgeAttention,
+		advice:   "a small pot can dry out within a day or two for a plant that likes steady moisture; pot up one size, water more often or use a self-watering pot",
+		matches: func(p pottingProfile) bool {
+			return p.potSize == "small" && p.likesWet()
+		},
+	},
+	{
+		name:     "Good match",
+		severity: badgeHealthy,
+		advice:   "a large pot's reserve of water suits a plant that likes steady moisture; just make sure it has a drainage hole",
+		matches: func(p pottingProfile) bool {
+			return p.potSize == "large" && p.likesWet()
+		},
+	},
+	{
+		name:     "Watch the drainage",
+		severity: badgeAttention,
+		advice:   "a medium pot is fine for a plant that likes to dry out as long as it drains fast; prefer terracotta and a gritty mix, and water only when the pot feels light",
+		matches: func(p pottingProfile) bool {
+			return p.potSize == "medium" && p.likesDry()
+		},
+	},
+	{
+		name:     "Good match",
+		severity: badgeHealthy,
+		advice:   "the pot size suits the plant's moisture needs; water when the soil nears the bottom of its range",
+		matches: func(p pottingProfile) bool {
+			return true
+		},
+	},
+}
+
+// pottingAssessment is the result of the potting heuristics
+type pottingAssessment struct {
+	rule    pottingRule
+	potSize string
+	dryDays float64 // estimated days for the soil to fall from the top to the bottom of the range
+}
+
+// assessPotting matches a plant and pot diameter against pottingRules
+func assessPotting(details *openplantbook.PlantDetails, diameterCM float64) pottingAssessment {
+	p := pottingProfile{
+		category:    strings.ToLower(details.Category),
+		moistureAvg: (details.MinSoilMoist + details.MaxSoilMoist) / 2,
+		potSize:     potSizeForDiameter(diameterCM),
+	}
+
+	a := pottingAssessment{potSize: p.potSize}
+	a.dryDays = float64(details.MaxSoilMoist-details.MinSoilMoist) / potDryingRates[p.potSize]
+	for _, rule := range pottingRules {
+		if rule.matches(p) {
+			a.rule = rule
+			break
+		}
+	}
+	return a
+}
+
+// formatPottingAdvice renders the assessment
+func formatPottingAdvice(details *openplantbook.PlantDetails, diameterCM float64, a pottingAssessment) string {
+	output := fmt.Sprintf("# Potting Advice for %s (%s)\n\n", details.Alias, details.DisplayPID)
+	output += fmt.Sprintf("**Pot**: %g cm (%s) · **Soil moisture range**: %d - %d%%%s\n\n",
+		diameterCM, a.potSize, details.MinSoilMoist, details.MaxSoilMoist, interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist))
+	output += fmt.Sprintf("**%s**: %s\n\n", a.rule.severity, a.rule.name)
+	output += fmt.Sprintf("In this pot, %s.\n\n", a.rule.advice)
+	output += fmt.Sprintf("_A %s pot loses roughly %g moisture points a day, so the soil would go from the top to the bottom of this plant's range in about %.0f days. Light, temperature and mix change this a lot._\n",
+		a.potSize, potDryingRates[a.potSize], a.dryDays)
+	return output
+}
+
+// handlePottingAdvice handles the potting_advice tool
+func (s *Server) handlePottingAdvice(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "potting_advice")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	diameter, err := request.RequireFloat("pot_diameter_cm")
+	if err != nil || diameter <= 0 {
+		logger.Warn("invalid pot_diameter_cm parameter", "error", err)
+		return mcp.NewToolResultError("pot_diameter_cm parameter is required and must be a positive number"), nil
+	}
+
+	logger.Info("assessing potting", "pid", pid, "pot_diameter_cm", diameter)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxSoilMoist <= 0 {
+		logger.Warn("plant has no soil moisture data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("no soil moisture range is available for this plant (%s)", pid)), nil
+	}
+
+	assessment := assessPotting(details, diameter)
+
+	logger.Info("potting assessed", "pid", details.PID, "pot_size", assessment.potSize, "result", assessment.rule.name)
+
+	return mcp.NewToolResultText(formatPottingAdvice(details, diameter, assessment)), nil
+}
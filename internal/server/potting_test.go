@@ -0,0 +1,56 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestAssessPotting(t *testing.T) {
+	echeveria := &openplantbook.PlantDetails{Category: "Crassulaceae", MinSoilMoist: 10, MaxSoilMoist: 35}
+	phalaenopsis := &openplantbook.PlantDetails{Category: "Orchidaceae", MinSoilMoist: 30, MaxSoilMoist: 60}
+	fern := &openplantbook.PlantDetails{Category: "Nephrolepidaceae", MinSoilMoist: 45, MaxSoilMoist: 75}
+	pothos := &openplantbook.PlantDetails{Category: "Araceae", MinSoilMoist: 30, MaxSoilMoist: 60}
+
+	tests := []struct {
+		name     string
+		details  *openplantbook.PlantDetails
+		diameter float64
+		potSize  string
+		rule     string
+		severity badgeStatus
+	}{
+		{"succulent in large pot", echeveria, 30, "large", "Root rot risk", badgeCritical},
+		{"orchid in large pot", phalaenopsis, 28, "large", "Root rot risk", badgeCritical},
+		{"orchid in medium pot", phalaenopsis, 15, "medium", "Slow-drying pot", badgeAttention},
+		{"fern in small pot", fern, 10, "small", "Dries out too fast", badgeAttention},
+		{"fern in large pot", fern, 30, "large", "Good match", badgeHealthy},
+		{"succulent in medium pot", echeveria, 20, "medium", "Watch the drainage", badgeAttention},
+		{"succulent in small pot", echeveria, 8, "small", "Good match", badgeHealthy},
+		{"pothos at the medium bound", pothos, 25, "medium", "Good match", badgeHealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assessPotting(tt.details, tt.diameter)
+			if a.potSize != tt.potSize {
+				t.Errorf("pot size = %s, want %s", a.potSize, tt.potSize)
+			}
+			if a.rule.name != tt.rule || a.rule.severity != tt.severity {
+				t.Errorf("rule = %s (%s), want %s (%s)", a.rule.name, a.rule.severity, tt.rule, tt.severity)
+			}
+		})
+	}
+}
+
+func TestFormatPottingAdvice(t *testing.T) {
+	details := &openplantbook.PlantDetails{Alias: "Echeveria", DisplayPID: "Echeveria elegans", Category: "Crassulaceae", MinSoilMoist: 10, MaxSoilMoist: 34}
+	output := formatPottingAdvice(details, 30, assessPotting(details, 30))
+
+	for _, want := range []string{"**Pot**: 30 cm (large)", "**🔴 critical**: Root rot risk", "smaller, faster-draining pot", "in about 8 days"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+}
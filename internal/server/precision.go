@@ -0,0 +1,59 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// maxPrecision caps configured decimal places; sensor data is never that precise anyway
+const maxPrecision = 6
+
+// numberPrecision controls decimal places in formatted numbers. The zero value keeps
+// each value's usual precision (one decimal for temperatures, none for integer metrics).
+type numberPrecision struct {
+	decimals int
+	set      bool
+}
+
+// fixedPrecision returns a precision that formats every number with decimals places
+func fixedPrecision(decimals int) numberPrecision {
+	return numberPrecision{decimals: decimals, set: true}
+}
+
+// format renders v with the configured decimals, or with usual when none are configured
+func (p numberPrecision) format(v float64, usual int) string {
+	decimals := usual
+	if p.set {
+		decimals = p.decimals
+	}
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
+// formatRange renders "min - max" with the same precision rules as format
+func (p numberPrecision) formatRange(min, max float64, usual int) string {
+	return p.format(min, usual) + " - " + p.format(max, usual)
+}
+
+// numberPrecision returns the configured output precision
+func (s *Server) numberPrecision() numberPrecision {
+	if s.config.Precision == nil {
+		return numberPrecision{}
+	}
+	return fixedPrecision(*s.config.Precision)
+}
+
+// parsePrecision reads the precision setting. Unset, empty or negative values return nil
+// to keep the defaults; larger values are capped at maxPrecision.
+func parsePrecision(raw interface{}) *int {
+	if raw == nil {
+		return nil
+	}
+	// Environment variables arrive as strings, JSON numbers as float64
+	n, err := strconv.Atoi(strings.TrimSpace(fmt.Sprint(raw)))
+	if err != nil || n < 0 {
+		return nil
+	}
+	n = min(n, maxPrecision)
+	return &n
+}
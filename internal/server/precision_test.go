@@ -0,0 +1,91 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestNumberPrecision(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     numberPrecision
+		value float64
+		usual int
+		want  string
+	}{
+		{"default integer", numberPrecision{}, 2500, 0, "2500"},
+		{"default temperature", numberPrecision{}, 21.25, 1, "21.2"},
+		{"fixed two", fixedPrecision(2), 21.256, 1, "21.26"},
+		{"fixed zero", fixedPrecision(0), 21.6, 1, "22"},
+		{"fixed on integer metric", fixedPrecision(1), 60, 0, "60.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.format(tt.value, tt.usual); got != tt.want {
+				t.Errorf("format(%g, %d) = %q, want %q", tt.value, tt.usual, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePrecision(t *testing.T) {
+	tests := []struct {
+		raw  interface{}
+		want int // -1 for nil
+	}{
+		{nil, -1},
+		{"", -1},
+		{"-1", -1},
+		{"abc", -1},
+		{"2", 2},
+		{float64(0), 0},
+		{"12", maxPrecision},
+	}
+
+	for _, tt := range tests {
+		got := parsePrecision(tt.raw)
+		switch {
+		case tt.want < 0 && got != nil:
+			t.Errorf("parsePrecision(%#v) = %d, want nil", tt.raw, *got)
+		case tt.want >= 0 && (got == nil || *got != tt.want):
+			t.Errorf("parsePrecision(%#v) = %v, want %d", tt.raw, got, tt.want)
+		}
+	}
+}
+
+func TestPrecisionInOutputs(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "Monstera", MinLightLux: 1500, MaxLightLux: 20000, MinTemp: 15, MaxTemp: 30,
+		MinEnvHumid: 40, MaxEnvHumid: 80, MinSoilMoist: 20, MaxSoilMoist: 60,
+	}
+
+	summary := renderCareSummary(details, summaryOptions{metric: true})
+	for _, want := range []string{"**Light**: 1500 - 20000 lux", "**Temperature**: 15.0 - 30.0°C", "**Humidity**: 40 - 80%"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("default summary missing %q:\n%s", want, summary)
+		}
+	}
+
+	summary = renderCareSummary(details, summaryOptions{metric: true, precision: fixedPrecision(2)})
+	for _, want := range []string{"**Light**: 1500.00 - 20000.00 lux", "**Temperature**: 15.00 - 30.00°C"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("precision 2 summary missing %q:\n%s", want, summary)
+		}
+	}
+
+	conditions := map[string]interface{}{"temperature": 22.345, "moisture": 10.0}
+	analysis := compareConditions(details, conditions, true, numberPrecision{}, false)
+	for _, want := range []string{"Current 10.0%, needs 20-60% (10.0% below minimum)", "22.3°C (within 15.0-30.0°C range)"} {
+		if !strings.Contains(analysis, want) {
+			t.Errorf("default analysis missing %q:\n%s", want, analysis)
+		}
+	}
+
+	analysis = compareConditions(details, conditions, true, fixedPrecision(0), false)
+	if !strings.Contains(analysis, "22°C (within 15-30°C range)") {
+		t.Errorf("precision 0 analysis not rounded:\n%s", analysis)
+	}
+}
@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// timedReading is a single timestamped sensor value
+type timedReading struct {
+	at    time.Time
+	value float64
+}
+
+// parseTimedReadings accepts an array of {"timestamp": ..., "value": n} objects.
+// Timestamps may be RFC 3339 strings or Unix seconds. Readings are returned oldest first.
+func parseTimedReadings(raw interface{}) ([]timedReading, error) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("must be an array of {timestamp, value} objects")
+	}
+
+	readings := make([]timedReading, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("reading %d must be an object", i)
+		}
+		value, ok := obj["value"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("reading %d: value must be a number", i)
+		}
+		at, err := parseTimestamp(obj["timestamp"])
+		if err != nil {
+			return nil, fmt.Errorf("reading %d: %w", i, err)
+		}
+		readings = append(readings, timedReading{at: at, value: value})
+	}
+
+	sort.SliceStable(readings, func(i, j int) bool {
+		return readings[i].at.Before(readings[j].at)
+	})
+	return readings, nil
+}
+
+// parseTimestamp accepts an RFC 3339 string or Unix seconds
+func parseTimestamp(raw interface{}) (time.Time, error) {
+	switch v := raw.(type) {
+	case string:
+		at, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("timestamp must be RFC 3339 (e.g. 2024-05-01T08:00:00Z): %w", err)
+		}
+		return at, nil
+	case float64:
+		sec, frac := math.Modf(v)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("timestamp is required (RFC 3339 string or Unix seconds)")
+}
+
+// linearTrend is a least-squares fit of value against time
+type linearTrend struct {
+	slopePerHour float64
+	latestAt     time.Time
+	latestFit    float64 // fitted value at latestAt
+}
+
+// fitLinearTrend fits a straight line through the readings.
+// It needs at least two readings at distinct times.
+func fitLinearTrend(readings []timedReading) (linearTrend, bool) {
+	if len(readings) < 2 {
+		return linearTrend{}, false
+	}
+
+	start := readings[0].at
+	n := float64(len(readings))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, r := range readings {
+		x := r.at.Sub(start).Hours()
+		sumX += x
+		sumY += r.value
+		sumXY += x * r.value
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return linearTrend{}, false
+	}
+
+	slope := (n*sumXY - sumX*sumY) / denominator
+	intercept := (sumY - slope*sumX) / n
+	latest := readings[len(readings)-1].at
+
+	return linearTrend{
+		slopePerHour: slope,
+		latestAt:     latest,
+		latestFit:    intercept + slope*latest.Sub(start).Hours(),
+	}, true
+}
+
+// projection describes when a trend leaves the ideal range
+type projection struct {
+	status   string // "outside", "stable", "crossing", or "safe"
+	boundary string // "minimum" or "maximum" for crossing/outside
+	hours    float64
+}
+
+// stableSlopePerDay is the fraction of the ideal range width per day under which a trend counts as flat
+const stableSlopePerDay = 0.01
+
+// projectCrossing projects when the trend crosses the nearest ideal boundary
+func projectCrossing(trend linearTrend, min, max float64) projection {
+	switch {
+	case trend.latestFit < min:
+		return projection{status: "outside", boundary: "minimum"}
+	case trend.latestFit > max:
+		return projection{status: "outside", boundary: "maximum"}
+	}
+
+	if math.Abs(trend.slopePerHour*24) <= (max-min)*stableSlopePerDay {
+		return projection{status: "stable"}
+	}
+
+	if trend.slopePerHour < 0 {
+		return projection{status: "crossing", boundary: "minimum", hours: (trend.latestFit - min) / -trend.slopePerHour}
+	}
+	return projection{status: "crossing", boundary: "maximum", hours: (max - trend.latestFit) / trend.slopePerHour}
+}
+
+// formatDuration renders hours as a friendly "N days" or "N hours" string
+func formatDuration(hours float64) string {
+	if hours >= 48 {
+		return fmt.Sprintf("%.1f days", hours/24)
+	}
+	return fmt.Sprintf("%.0f hours", math.Max(1, math.Round(hours)))
+}
+
+// handleProjectConditions handles the project_conditions tool
+func (s *Server) handleProjectConditions(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "project_conditions")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	readings, ok := request.GetArguments()["readings"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid readings parameter")
+		return mcp.NewToolResultError("readings parameter is required and must be an object keyed by metric"), nil
+	}
+
+	logger.Info("projecting conditions", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	output := fmt.Sprintf("# Condition Projection for %s\n\n", details.Alias)
+	output += "_Projections are naive linear estimates from the supplied readings. Watering, weather, and seasons will change the real trend._\n\n"
+
+	projected := 0
+	for _, metric := range careMetrics {
+		raw, exists := readings[metric.key]
+		if !exists {
+			continue
+		}
+
+		series, err := parseTimedReadings(raw)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("readings.%s %v", metric.key, err)), nil
+		}
+
+		min, max, hasData := metric.ideal(details)
+		if !hasData {
+			output += fmt.Sprintf("**%s**: no ideal range available for this plant\n\n", metric.label)
+			continue
+		}
+
+		trend, ok := fitLinearTrend(series)
+		if !ok {
+			output += fmt.Sprintf("**%s**: need at least two readings at different times\n\n", metric.label)
+			continue
+		}
+		projected++
+
+		rate := fmt.Sprintf("%+.1f%s/day", trend.slopePerHour*24, metric.unit)
+		p := projectCrossing(trend, min, max)
+		switch p.status {
+		case "outside":
+			output += fmt.Sprintf("❌ **%s**: already beyond the %s (trend value %.1f%s, ideal %g-%g%s, %s)\n\n", metric.label, p.boundary, trend.latestFit, metric.unit, min, max, metric.unit, rate)
+		case "stable":
+			output += fmt.Sprintf("✅ **%s**: stable within range (trend value %.1f%s, ideal %g-%g%s)\n\n", metric.label, trend.latestFit, metric.unit, min, max, metric.unit)
+		default:
+			bound := min
+			if p.boundary == "maximum" {
+				bound = max
+			}
+			eta := trend.latestAt.Add(time.Duration(p.hours * float64(time.Hour)))
+			output += fmt.Sprintf("⚠️ **%s**: at %s, projected to reach the %s (%g%s) in about %s (around %s)\n\n", metric.label, rate, p.boundary, bound, metric.unit, formatDuration(p.hours), eta.Format("Mon Jan 2 15:04 MST"))
+		}
+	}
+
+	if projected == 0 {
+		output += "No metrics could be projected. Provide at least two timestamped readings for moisture, temperature, light_lux, or humidity.\n"
+	}
+
+	logger.Info("condition projection completed", "pid", details.PID, "metrics", projected)
+
+	return mcp.NewToolResultText(output), nil
+}
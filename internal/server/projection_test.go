@@ -0,0 +1,83 @@
+package server
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFitLinearTrend(t *testing.T) {
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("perfect line", func(t *testing.T) {
+		readings := []timedReading{
+			{start, 60},
+			{start.Add(24 * time.Hour), 50},
+			{start.Add(48 * time.Hour), 40},
+		}
+		trend, ok := fitLinearTrend(readings)
+		if !ok {
+			t.Fatal("expected a fit")
+		}
+		if math.Abs(trend.slopePerHour*24-(-10)) > 1e-9 {
+			t.Errorf("slope = %v/day, want -10/day", trend.slopePerHour*24)
+		}
+		if math.Abs(trend.latestFit-40) > 1e-9 {
+			t.Errorf("latest fit = %v, want 40", trend.latestFit)
+		}
+	})
+
+	t.Run("too few readings", func(t *testing.T) {
+		if _, ok := fitLinearTrend([]timedReading{{start, 10}}); ok {
+			t.Error("expected no fit for one reading")
+		}
+	})
+
+	t.Run("same timestamp", func(t *testing.T) {
+		if _, ok := fitLinearTrend([]timedReading{{start, 10}, {start, 20}}); ok {
+			t.Error("expected no fit for identical timestamps")
+		}
+	})
+}
+
+func TestProjectCrossing(t *testing.T) {
+	tests := []struct {
+		name     string
+		trend    linearTrend
+		expected projection
+	}{
+		{"drying toward min", linearTrend{slopePerHour: -10.0 / 24, latestFit: 40}, projection{"crossing", "minimum", 48}},
+		{"warming toward max", linearTrend{slopePerHour: 1, latestFit: 55}, projection{"crossing", "maximum", 5}},
+		{"already too dry", linearTrend{slopePerHour: -1, latestFit: 10}, projection{status: "outside", boundary: "minimum"}},
+		{"already too wet", linearTrend{slopePerHour: 1, latestFit: 70}, projection{status: "outside", boundary: "maximum"}},
+		{"flat", linearTrend{slopePerHour: 0.001, latestFit: 40}, projection{status: "stable"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectCrossing(tt.trend, 20, 60)
+			if got.status != tt.expected.status || got.boundary != tt.expected.boundary || math.Abs(got.hours-tt.expected.hours) > 1e-9 {
+				t.Errorf("projectCrossing() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseTimedReadings(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{"timestamp": "2024-05-02T00:00:00Z", "value": 30.0},
+		map[string]interface{}{"timestamp": 1714521600.0, "value": 40.0}, // 2024-05-01T00:00:00Z
+	}
+
+	readings, err := parseTimedReadings(raw)
+	if err != nil {
+		t.Fatalf("parseTimedReadings() error = %v", err)
+	}
+	if readings[0].value != 40 || readings[1].value != 30 {
+		t.Errorf("expected readings sorted oldest first, got %+v", readings)
+	}
+
+	if _, err := parseTimedReadings([]interface{}{map[string]interface{}{"value": 1.0}}); err == nil {
+		t.Error("expected error for missing timestamp")
+	}
+}
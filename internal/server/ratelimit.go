@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+	"golang.org/x/time/rate"
+)
+
+// Behaviors when the outbound rate limit is exhausted
+const (
+	rateLimitModeWait   = "wait"
+	rateLimitModeReject = "reject"
+)
+
+// rateLimitModes lists the accepted rate_limit_mode values, default first
+var rateLimitModes = []string{rateLimitModeWait, rateLimitModeReject}
+
+// maxRateLimitBurst caps how many calls may go out back to back after a quiet spell, so
+// a per-minute budget is spread over the minute instead of spent in one burst
+const maxRateLimitBurst = 10
+
+// ErrRateLimited is returned by API calls in reject mode when the configured
+// rate_limit_per_minute budget is used up
+var ErrRateLimited = errors.New("rate limited, try again shortly")
+
+// newRateLimiter builds the token bucket shared by every tool, or nil when perMinute is
+// zero (unlimited)
+func newRateLimiter(perMinute int) *rate.Limiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), min(perMinute, maxRateLimitBurst))
+}
+
+// validateRateLimitMode checks rate_limit_mode; empty means wait
+func validateRateLimitMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+	for _, m := range rateLimitModes {
+		if mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("rate_limit_mode must be one of: %s", strings.Join(rateLimitModes, ", "))
+}
+
+// rateLimitedClient takes a token from the shared limiter before every SDK call
+type rateLimitedClient struct {
+	next    plantClient
+	limiter *rate.Limiter
+	reject  bool
+}
+
+// acquire takes a token, waiting for one (up to ctx's deadline) or failing at once in
+// reject mode
+func (c *rateLimitedClient) acquire(ctx context.Context) error {
+	if c.reject {
+		if !c.limiter.Allow() {
+			return ErrRateLimited
+		}
+		return nil
+	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("%w: %w", ErrRateLimited, err)
+	}
+	return nil
+}
+
+// SearchPlants implements plantClient
+func (c *rateLimitedClient) SearchPlants(ctx context.Context, query string, opts *openplantbook.SearchOptions) ([]openplantbook.PlantSearchResult, error) {
+	if err := c.acquire(ctx); err != nil {
+		return nil, err
+	}
+	return c.next.SearchPlants(ctx, query, opts)
+}
+
+// GetPlantDetails implements plantClient
+func (c *rateLimitedClient) GetPlantDetails(ctx context.Context, pid string, opts *openplantbook.DetailOptions) (*openplantbook.PlantDetails, error) {
+	if err := c.acquire(ctx); err != nil {
+		return nil, err
+	}
+	return c.next.GetPlantDetails(ctx, pid, opts)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	if newRateLimiter(0) != nil {
+		t.Error("0 per minute should mean no limiter")
+	}
+	if l := newRateLimiter(600); l.Burst() != maxRateLimitBurst {
+		t.Errorf("burst = %d, want it capped at %d", l.Burst(), maxRateLimitBurst)
+	}
+	if l := newRateLimiter(3); l.Burst() != 3 {
+		t.Errorf("burst = %d, want the whole budget of 3", l.Burst())
+	}
+}
+
+func TestValidateRateLimitMode(t *testing.T) {
+	for mode, wantErr := range map[string]bool{"": false, rateLimitModeWait: false, rateLimitModeReject: false, "queue": true} {
+		if err := validateRateLimitMode(mode); (err != nil) != wantErr {
+			t.Errorf("validateRateLimitMode(%q) error = %v, wantErr %v", mode, err, wantErr)
+		}
+	}
+}
+
+func TestRateLimit_SharedAcrossTools(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{"monstera deliciosa|": {PID: "monstera deliciosa"}}}
+	srv := newTestServer(t, client)
+	srv.config.RateLimitMode = rateLimitModeReject
+	srv.limiter = newRateLimiter(1)
+	ctx := context.Background()
+
+	// The search spends the only token, so the details call is rejected
+	if _, err := srv.searchPlants(ctx, "monstera", &openplantbook.SearchOptions{Limit: 5}); err != nil {
+		t.Fatalf("first call should pass: %v", err)
+	}
+	if _, err := srv.fetchDetails(ctx, "monstera deliciosa", "en"); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("second call error = %v, want ErrRateLimited", err)
+	}
+	if len(client.detailCalls) != 0 {
+		t.Errorf("a rejected call should not reach the API, got %v", client.detailCalls)
+	}
+}
+
+func TestRateLimit_WaitHonorsDeadline(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.RateLimitMode = rateLimitModeWait
+	srv.limiter = newRateLimiter(1)
+
+	if _, err := srv.searchPlants(context.Background(), "monstera", &openplantbook.SearchOptions{Limit: 5}); err != nil {
+		t.Fatalf("first call should pass: %v", err)
+	}
+
+	// The next token is a minute away, past the deadline, so waiting fails at once
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := srv.searchPlants(ctx, "ficus", &openplantbook.SearchOptions{Limit: 5})
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("error = %v, want ErrRateLimited", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waiting took %v; it should give up when the deadline can't be met", elapsed)
+	}
+}
+
+func TestRateLimit_RejectedCallsNotCountedUpstream(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{"monstera deliciosa|": {PID: "monstera deliciosa"}}}
+	srv := newTestServer(t, client)
+	srv.config.RateLimitMode = rateLimitModeReject
+	srv.limiter = newRateLimiter(1)
+	ctx := context.Background()
+
+	// The search spends the only token; the next two calls are rejected before the API
+	if _, err := srv.searchPlants(ctx, "monstera", &openplantbook.SearchOptions{Limit: 5}); err != nil {
+		t.Fatalf("first call should pass: %v", err)
+	}
+	if _, err := srv.fetchDetails(ctx, "monstera deliciosa", "en"); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("details error = %v, want ErrRateLimited", err)
+	}
+	if _, err := srv.searchPlants(ctx, "ficus", &openplantbook.SearchOptions{Limit: 5}); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("search error = %v, want ErrRateLimited", err)
+	}
+
+	result, err := srv.handleAPIUsage(ctx, mcp.CallToolRequest{})
+	if err != nil {
+		t.Fatalf("handleAPIUsage() error = %v", err)
+	}
+	var usage apiUsageSnapshot
+	if err := json.Unmarshal([]byte(resultText(t, result)), &usage); err != nil {
+		t.Fatalf("decode api_usage: %v", err)
+	}
+	if usage.UpstreamCalls != (apiCallCounts{Total: 1, Search: 1}) {
+		t.Errorf("upstream calls = %+v, want only the search that was sent", usage.UpstreamCalls)
+	}
+	if usage.FailedCalls != 0 || usage.RateLimited != 2 {
+		t.Errorf("failed = %d, rate limited = %d; want 0 and 2", usage.FailedCalls, usage.RateLimited)
+	}
+}
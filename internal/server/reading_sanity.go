@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// sanityReadingKeys are the readings reading_sanity understands
+var sanityReadingKeys = []string{"moisture", "temperature", "light_lux", "humidity", "soil_ec"}
+
+// sanitySeverity says how sure a finding is
+type sanitySeverity int
+
+const (
+	sanitySuspicious sanitySeverity = iota // unusual enough to double-check
+	sanityImpossible                       // can't be a correct reading
+)
+
+// String returns the label shown next to a finding
+func (s sanitySeverity) String() string {
+	if s == sanityImpossible {
+		return "❌ impossible"
+	}
+	return "⚠️ suspicious"
+}
+
+// sanityRule is one heuristic cross-check. It only runs when every required reading is
+// present, and indoor-only rules are skipped for outdoor sensors.
+type sanityRule struct {
+	name       string
+	requires   []string
+	indoorOnly bool
+	severity   sanitySeverity
+	check      func(r map[string]float64) bool
+	finding    string // explanation, formatted with the required readings in order
+}
+
+// sanityRules are heuristics: each describes a combination that is physically implausible
+// or almost always means a mislabeled or misplaced sensor, not proof of one
+var sanityRules = []sanityRule{
+	{
+		name:     "humidity out of bounds",
+		requires: []string{"humidity"},
+		severity: sanityImpossible,
+		check:    func(r map[string]float64) bool { return r["humidity"] < 0 || r["humidity"] > 100 },
+		finding:  "Relative humidity of %g%% is outside 0-100%%; the value may belong to another sensor.",
+	},
+	{
+		name:     "moisture out of bounds",
+		requires: []string{"moisture"},
+		severity: sanityImpossible,
+		check:    func(r map[string]float64) bool { return r["moisture"] < 0 || r["moisture"] > 100 },
+		finding:  "Soil moisture of %g%% is outside 0-100%%; the value may belong to another sensor.",
+	},
+	{
+		name:     "negative light",
+		requires: []string{"light_lux"},
+		severity: sanityImpossible,
+		check:    func(r map[string]float64) bool { return r["light_lux"] < 0 },
+		finding:  "Light of %g lux is negative.",
+	},
+	{
+		name:     "brighter than sunlight",
+		requires: []string{"light_lux"},
+		severity: sanityImpossible,
+		check:    func(r map[string]float64) bool { return r["light_lux"] > 150000 },
+		finding:  "Light of %g lux is brighter than direct midday sun (about 120,000 lux); check the unit or the sensor.",
+	},
+	{
+		name:     "temperature looks like Fahrenheit",
+		requires: []string{"temperature"},
+		severity: sanitySuspicious,
+		check:    func(r map[string]float64) bool { return r["temperature"] >= 45 && r["temperature"] <= 110 },
+		finding:  "A temperature of %g°C would kill most plants; it looks like a Fahrenheit reading.",
+	},
+	{
+		name:       "freezing indoors",
+		requires:   []string{"temperature"},
+		indoorOnly: true,
+		severity:   sanitySuspicious,
+		check:      func(r map[string]float64) bool { return r["temperature"] <= 0 },
+		finding:    "A temperature of %g°C is below freezing, which is rare indoors; the sensor may be outside or mislabeled.",
+	},
+	{
+		name:       "cold under full sun",
+		requires:   []string{"light_lux", "temperature"},
+		indoorOnly: true,
+		severity:   sanitySuspicious,
+		check:      func(r map[string]float64) bool { return r["light_lux"] >= 30000 && r["temperature"] < 12 },
+		finding:    "%g lux is direct sun, which warms an indoor spot, yet the temperature is %g°C; the light and temperature readings may come from different places.",
+	},
+	{
+		name:       "saturated air in a cold, sunny spot",
+		requires:   []string{"humidity", "temperature", "light_lux"},
+		indoorOnly: true,
+		severity:   sanitySuspicious,
+		check: func(r map[string]float64) bool {
+			return r["humidity"] >= 85 && r["temperature"] < 12 && r["light_lux"] >= 30000
+		},
+		finding: "%g%% humidity at %g°C under %g lux of sun is an unlikely mix indoors; humidity and temperature may be swapped with another sensor's.",
+	},
+	{
+		name:     "dry soil conducting",
+		requires: []string{"moisture", "soil_ec"},
+		severity: sanitySuspicious,
+		check:    func(r map[string]float64) bool { return r["moisture"] <= 5 && r["soil_ec"] >= 500 },
+		finding:  "Soil moisture of %g%% means almost no water, but %g µS/cm needs moist soil to conduct; the moisture and EC readings may be from different pots.",
+	},
+	{
+		name:     "wet soil not conducting",
+		requires: []string{"moisture", "soil_ec"},
+		severity: sanitySuspicious,
+		check:    func(r map[string]float64) bool { return r["moisture"] >= 40 && r["soil_ec"] <= 10 },
+		finding:  "Soil moisture of %g%% with an EC of %g µS/cm: wet soil almost always conducts, so the EC probe may be out of the soil.",
+	},
+}
+
+// sanityFinding is a rule that fired
+type sanityFinding struct {
+	rule    sanityRule
+	message string
+}
+
+// checkReadingSanity runs every applicable rule, impossible findings first
+func checkReadingSanity(readings map[string]float64, indoor bool) (findings []sanityFinding, checked int) {
+rules:
+	for _, rule := range sanityRules {
+		if rule.indoorOnly && !indoor {
+			continue
+		}
+		values := make([]interface{}, len(rule.requires))
+		for i, key := range rule.requires {
+			value, ok := readings[key]
+			if !ok {
+				continue rules
+			}
+			values[i] = value
+		}
+		checked++
+		if rule.check(readings) {
+			findings = append(findings, sanityFinding{rule: rule, message: fmt.Sprintf(rule.finding, values...)})
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].rule.severity > findings[j].rule.severity
+	})
+	return findings, checked
+}
+
+// parseSanityReadings reads the numeric readings, rejecting unknown keys so typos don't
+// silently skip a check
+func parseSanityReadings(raw interface{}) (map[string]float64, error) {
+	obj, ok := raw.(map[string]interface{})
+	if !ok || len(obj) == 0 {
+		return nil, fmt.Errorf("readings parameter is required and must be an object with at least one of: %s", strings.Join(sanityReadingKeys, ", "))
+	}
+
+	readings := make(map[string]float64, len(obj))
+	for key, value := range obj {
+		if !containsString(sanityReadingKeys, key) {
+			return nil, fmt.Errorf("unknown reading %q: expected %s", key, strings.Join(sanityReadingKeys, ", "))
+		}
+		number, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("readings.%s must be a number", key)
+		}
+		readings[key] = number
+	}
+	return readings, nil
+}
+
+// formatReadingSanity renders the findings
+func formatReadingSanity(findings []sanityFinding, checked int, readings int) string {
+	output := "# Reading Sanity Check\n\n"
+
+	if len(findings) == 0 {
+		output += fmt.Sprintf("✅ No inconsistencies found: %d reading(s) passed %d cross-check(s).\n\n", readings, checked)
+	} else {
+		output += fmt.Sprintf("Found %d possible sensor problem(s) in %d cross-check(s):\n\n", len(findings), checked)
+		for _, f := range findings {
+			output += fmt.Sprintf("- **%s - %s**: %s\n", f.rule.severity, f.rule.name, f.message)
+		}
+		output += "\n"
+	}
+
+	output += "_These are heuristics, not proof: unusual setups such as greenhouses, grow tents or sensors next to a window can trip them. Check the sensor's placement and labels before acting._\n"
+	return output
+}
+
+// handleReadingSanity handles the reading_sanity tool
+func (s *Server) handleReadingSanity(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "reading_sanity")
+
+	// Extract parameters
+	readings, err := parseSanityReadings(request.GetArguments()["readings"])
+	if err != nil {
+		logger.Warn("invalid readings parameter", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	indoor := request.GetBool("indoor", true)
+
+	logger.Info("checking reading sanity", "readings", len(readings), "indoor", indoor)
+
+	findings, checked := checkReadingSanity(readings, indoor)
+
+	logger.Info("reading sanity checked", "checks", checked, "findings", len(findings))
+
+	return mcp.NewToolResultText(formatReadingSanity(findings, checked, len(readings))), nil
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestCheckReadingSanity(t *testing.T) {
+	tests := []struct {
+		name     string
+		readings map[string]float64
+		indoor   bool
+		want     []string // rule names, impossible findings first
+	}{
+		{"plausible living room", map[string]float64{"moisture": 45, "temperature": 21, "light_lux": 3000, "humidity": 50, "soil_ec": 800}, true, nil},
+		{"humidity over 100", map[string]float64{"humidity": 140}, true, []string{"humidity out of bounds"}},
+		{"moisture below 0", map[string]float64{"moisture": -3}, true, []string{"moisture out of bounds"}},
+		{"negative light", map[string]float64{"light_lux": -1}, true, []string{"negative light"}},
+		{"light beyond the sun", map[string]float64{"light_lux": 500000}, true, []string{"brighter than sunlight"}},
+		{"fahrenheit", map[string]float64{"temperature": 72}, true, []string{"temperature looks like Fahrenheit"}},
+		{"freezing indoors", map[string]float64{"temperature": -2}, true, []string{"freezing indoors"}},
+		{"freezing outdoors is fine", map[string]float64{"temperature": -2}, false, nil},
+		{"mislabeled sensors", map[string]float64{"humidity": 90, "temperature": 10, "light_lux": 40000}, true, []string{"cold under full sun", "saturated air in a cold, sunny spot"}},
+		{"cold sunny day outdoors", map[string]float64{"humidity": 90, "temperature": 10, "light_lux": 40000}, false, nil},
+		{"dry soil conducting", map[string]float64{"moisture": 2, "soil_ec": 1200}, true, []string{"dry soil conducting"}},
+		{"wet soil not conducting", map[string]float64{"moisture": 60, "soil_ec": 3}, true, []string{"wet soil not conducting"}},
+		{"impossible sorts first", map[string]float64{"temperature": 80, "humidity": 120}, true, []string{"humidity out of bounds", "temperature looks like Fahrenheit"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, _ := checkReadingSanity(tt.readings, tt.indoor)
+			var got []string
+			for _, f := range findings {
+				got = append(got, f.rule.name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findings = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckReadingSanity_SkipsRulesWithoutReadings(t *testing.T) {
+	_, checked := checkReadingSanity(map[string]float64{"humidity": 50}, true)
+	if checked != 1 {
+		t.Errorf("checked = %d, want only the humidity bounds rule", checked)
+	}
+}
+
+func TestHandleReadingSanity(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"readings": map[string]interface{}{"humidity": 90.0, "temperature": 10.0, "light_lux": 40000.0},
+	}
+	result, err := srv.handleReadingSanity(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("reading_sanity failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Found 2 possible sensor problem(s)", "cold under full sun", "heuristics, not proof"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	request.Params.Arguments = map[string]interface{}{"readings": map[string]interface{}{"temprature": 20.0}}
+	if result, _ := srv.handleReadingSanity(context.Background(), request); !result.IsError {
+		t.Error("expected an unknown reading to be rejected")
+	}
+}
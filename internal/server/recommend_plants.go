@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// defaultRecommendLimit is how many plants recommend_plants returns by default
+	defaultRecommendLimit = 5
+
+	// maxRecommendLimit caps the recommend_plants limit parameter
+	maxRecommendLimit = 20
+
+	// maxRecommendSearchCandidates caps the search results scored for a query; the batch
+	// size limit applies too, since every candidate costs a details call
+	maxRecommendSearchCandidates = 25
+
+	// recommendMissingDataFactor scales a plant's score for each given condition it has
+	// no data for, so plants known to fit rank above plants that merely might
+	recommendMissingDataFactor = 0.9
+)
+
+// recommendMetrics are the room conditions recommend_plants scores, in display order
+var recommendMetrics = []string{"light_lux", "temperature", "humidity"}
+
+// PlantFit is how well a set of room conditions suits one plant
+type PlantFit struct {
+	Details *openplantbook.PlantDetails
+	Score   float64  // 0-1
+	Missing []string // labels of the given conditions the plant has no data for
+
+	metrics []metricOverlap
+	mean    float64 // unweighted mean of the per-metric fits, to rank plants that score 0
+}
+
+// ScorePlantFit scores how well conditions suit a plant. Conditions are keyed by
+// light_lux, temperature (°C) and humidity (%); weights are relative per metric, as in the
+// health_weights config, and nil weighs them equally. Each metric's fit runs from 1
+// inside the plant's range down to 0 a full range-width outside it; the fits are combined
+// with a weighted geometric mean like comfort_overlap, then scaled by
+// recommendMissingDataFactor for each condition the plant has no data for. It returns
+// false when the plant has data for none of the conditions.
+func ScorePlantFit(details *openplantbook.PlantDetails, conditions map[string]float64, weights map[string]float64) (PlantFit, bool) {
+	raw := make(map[string]interface{}, len(conditions))
+	for key, value := range conditions {
+		raw[key] = value
+	}
+	overlaps, score := computeComfortOverlap(details, raw, healthWeights(weights))
+	if len(overlaps) == 0 {
+		return PlantFit{Details: details}, false
+	}
+
+	fit := PlantFit{Details: details, metrics: overlaps}
+	scored := map[string]bool{}
+	for _, o := range overlaps {
+		scored[o.metric.key] = true
+		fit.mean += o.fraction / float64(len(overlaps))
+	}
+	for _, m := range careMetrics {
+		if _, given := conditions[m.key]; given && !scored[m.key] {
+			fit.Missing = append(fit.Missing, strings.ToLower(m.label))
+		}
+	}
+	fit.Score = score * math.Pow(recommendMissingDataFactor, float64(len(fit.Missing)))
+	return fit, true
+}
+
+// Rationale explains the score in one line: where each condition sits against the
+// plant's range, and which conditions it has no data for
+func (f PlantFit) Rationale() string {
+	var parts []string
+	for _, o := range f.metrics {
+		value := o.current.min
+		switch {
+		case value < o.idealMin:
+			parts = append(parts, fmt.Sprintf("%s %g%s is below its %g-%g%s", o.metric.label, value, o.metric.unit, o.idealMin, o.idealMax, o.metric.unit))
+		case value > o.idealMax:
+			parts = append(parts, fmt.Sprintf("%s %g%s is above its %g-%g%s", o.metric.label, value, o.metric.unit, o.idealMin, o.idealMax, o.metric.unit))
+		default:
+			parts = append(parts, fmt.Sprintf("%s %g%s is within %g-%g%s", o.metric.label, value, o.metric.unit, o.idealMin, o.idealMax, o.metric.unit))
+		}
+	}
+	if len(f.Missing) > 0 {
+		parts = append(parts, fmt.Sprintf("no %s data", strings.Join(f.Missing, " or ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// rankPlantFits orders fits best first. Ties, including plants that all score 0, go to
+// the better average fit, then alphabetically.
+func rankPlantFits(fits []PlantFit) {
+	sort.SliceStable(fits, func(i, j int) bool {
+		if fits[i].Score != fits[j].Score {
+			return fits[i].Score > fits[j].Score
+		}
+		if fits[i].mean != fits[j].mean {
+			return fits[i].mean > fits[j].mean
+		}
+		return fits[i].Details.Alias < fits[j].Details.Alias
+	})
+}
+
+// formatRoomConditions renders the given conditions, e.g. "Light 3000 lux, Humidity 45%"
+func formatRoomConditions(conditions map[string]float64) string {
+	var parts []string
+	for _, m := range careMetrics {
+		if value, ok := conditions[m.key]; ok {
+			parts = append(parts, fmt.Sprintf("%s %g%s", m.label, value, m.unit))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// handleRecommendPlants handles the recommend_plants tool
+func (s *Server) handleRecommendPlants(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "recommend_plants")
+
+	// Extract parameters
+	conditions := map[string]float64{}
+	args := request.GetArguments()
+	for _, key := range recommendMetrics {
+		if _, present := args[key]; !present {
+			continue
+		}
+		value, err := request.RequireFloat(key)
+		if err != nil {
+			logger.Warn("invalid condition parameter", "param", key, "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("%s must be a number", key)), nil
+		}
+		conditions[key] = value
+	}
+	if len(conditions) == 0 {
+		logger.Warn("no conditions provided")
+		return mcp.NewToolResultError("provide at least one of light_lux, temperature or humidity"), nil
+	}
+
+	pids := request.GetStringSlice("pids", nil)
+	query := strings.TrimSpace(request.GetString("query", ""))
+	switch {
+	case len(pids) == 0 && query == "":
+		logger.Warn("no candidate pool")
+		return mcp.NewToolResultError("provide candidate plants as pids or a search query: OpenPlantbook can't search by conditions"), nil
+	case len(pids) > 0 && query != "":
+		logger.Warn("both pids and query provided")
+		return mcp.NewToolResultError("provide either pids or query, not both"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	limit := request.GetInt("limit", defaultRecommendLimit)
+	if limit < 1 || limit > maxRecommendLimit {
+		logger.Warn("invalid limit parameter", "limit", limit)
+		return mcp.NewToolResultError(fmt.Sprintf("limit must be between 1 and %d", maxRecommendLimit)), nil
+	}
+
+	weights, err := s.healthWeights(nil)
+	if err != nil {
+		logger.Error("invalid configured weights", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Build the candidate pool
+	pool := fmt.Sprintf("%d provided plant(s)", len(pids))
+	if query != "" {
+		results, err := s.searchPlants(ctx, query, &openplantbook.SearchOptions{Limit: min(maxRecommendSearchCandidates, s.maxBatchSize())})
+		if err != nil {
+			logger.Error("search failed", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+		}
+		if len(results) == 0 {
+			return mcp.NewToolResultError(fmt.Sprintf("no plants found for %q to recommend from", query)), nil
+		}
+		for _, r := range results {
+			pids = append(pids, r.PID)
+		}
+		pool = fmt.Sprintf("%d plant(s) from the search %q", len(pids), query)
+	}
+
+	logger.Info("recommending plants", "candidates", len(pids), "conditions", len(conditions))
+
+	var fits []PlantFit
+	var unscored []string
+	for _, fetched := range s.fetchPlantsConcurrently(ctx, pids) {
+		if fetched.err != nil {
+			unscored = append(unscored, fmt.Sprintf("%s: %v", fetched.pid, fetched.err))
+			continue
+		}
+		fit, ok := ScorePlantFit(fetched.details, conditions, weights)
+		if !ok {
+			unscored = append(unscored, fmt.Sprintf("%s (%s): no data for the given conditions", fetched.details.Alias, fetched.details.PID))
+			continue
+		}
+		fits = append(fits, fit)
+	}
+	rankPlantFits(fits)
+
+	output := "# Plant Recommendations\n\n"
+	output += fmt.Sprintf("**Your conditions**: %s\n\n", formatRoomConditions(conditions))
+	output += fmt.Sprintf("**Candidates**: %s\n\n", pool)
+	if len(fits) == 0 {
+		output += "None of the candidates could be scored.\n\n"
+	}
+	for i, fit := range fits[:min(limit, len(fits))] {
+		output += fmt.Sprintf("%d. **%s** (%s) - %.0f%% fit\n", i+1, fit.Details.Alias, fit.Details.PID, fit.Score*100)
+		output += fmt.Sprintf("   - %s\n", fit.Rationale())
+	}
+	if len(fits) > limit {
+		output += fmt.Sprintf("\n_%d more candidate(s) scored lower._\n", len(fits)-limit)
+	}
+	if len(unscored) > 0 {
+		output += "\n## Not Scored\n\n"
+		for _, line := range unscored {
+			output += fmt.Sprintf("- %s\n", line)
+		}
+	}
+	output += "\n_OpenPlantbook can't search by conditions, so only these candidates were considered. Scores combine each condition's fit like comfort_overlap; each condition a plant has no data for costs 10%._\n"
+
+	logger.Info("plants recommended", "scored", len(fits), "unscored", len(unscored))
+
+	return mcp.NewToolResultText(output), nil
+}
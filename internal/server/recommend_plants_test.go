@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestScorePlantFit(t *testing.T) {
+	pothos := &openplantbook.PlantDetails{Alias: "Pothos", MinLightLux: 1000, MaxLightLux: 11000, MinTemp: 15, MaxTemp: 30, MinEnvHumid: 40, MaxEnvHumid: 80}
+	lightOnly := &openplantbook.PlantDetails{Alias: "Mystery", MinLightLux: 1000, MaxLightLux: 11000}
+
+	tests := []struct {
+		name       string
+		details    *openplantbook.PlantDetails
+		conditions map[string]float64
+		weights    map[string]float64
+		score      float64
+		ok         bool
+		rationale  string
+	}{
+		{"all within range", pothos, map[string]float64{"light_lux": 3000, "temperature": 21, "humidity": 50}, nil, 1, true, "Temperature 21°C is within 15-30°C; Light 3000 lux is within 1000-11000 lux; Humidity 50% is within 40-80%"},
+		// Humidity 30% is a quarter of the 40-point range below it: fit 0.75, sqrt(0.75) overall
+		{"one metric off", pothos, map[string]float64{"light_lux": 3000, "humidity": 30}, nil, math.Sqrt(0.75), true, "Humidity 30% is below its 40-80%"},
+		{"weighted", pothos, map[string]float64{"light_lux": 3000, "humidity": 30}, map[string]float64{"humidity": 0}, 1, true, ""},
+		{"far outside", pothos, map[string]float64{"temperature": 50}, nil, 0, true, "Temperature 50°C is above its 15-30°C"},
+		{"missing data costs 10%", lightOnly, map[string]float64{"light_lux": 3000, "humidity": 50}, nil, recommendMissingDataFactor, true, "no humidity data"},
+		{"no data at all", lightOnly, map[string]float64{"temperature": 21}, nil, 0, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fit, ok := ScorePlantFit(tt.details, tt.conditions, tt.weights)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			if math.Abs(fit.Score-tt.score) > 1e-9 {
+				t.Errorf("score = %v, want %v", fit.Score, tt.score)
+			}
+			if !strings.Contains(fit.Rationale(), tt.rationale) {
+				t.Errorf("rationale = %q, want it to contain %q", fit.Rationale(), tt.rationale)
+			}
+		})
+	}
+}
+
+func TestRankPlantFits(t *testing.T) {
+	plant := func(alias string) *openplantbook.PlantDetails { return &openplantbook.PlantDetails{Alias: alias} }
+	fits := []PlantFit{
+		{Details: plant("Zero, closer"), Score: 0, mean: 0.5},
+		{Details: plant("Best"), Score: 0.9},
+		{Details: plant("Zero, far"), Score: 0, mean: 0.1},
+		{Details: plant("Alpha"), Score: 0.5},
+		{Details: plant("Beta"), Score: 0.5},
+	}
+	rankPlantFits(fits)
+
+	var got []string
+	for _, f := range fits {
+		got = append(got, f.Details.Alias)
+	}
+	if want := "Best,Alpha,Beta,Zero, closer,Zero, far"; strings.Join(got, ",") != want {
+		t.Errorf("order = %v, want %s", got, want)
+	}
+}
+
+func TestHandleRecommendPlants(t *testing.T) {
+	client := &fakeClient{
+		details: map[string]*openplantbook.PlantDetails{
+			"ficus lyrata|":   {PID: "ficus lyrata", Alias: "Fiddle Leaf Fig", MinLightLux: 5000, MaxLightLux: 30000, MinTemp: 16, MaxTemp: 30, MinEnvHumid: 40, MaxEnvHumid: 70},
+			"ficus elastica|": {PID: "ficus elastica", Alias: "Rubber Plant", MinLightLux: 1000, MaxLightLux: 15000, MinTemp: 15, MaxTemp: 30, MinEnvHumid: 30, MaxEnvHumid: 70},
+			"ficus nodata|":   {PID: "ficus nodata", Alias: "Mystery Fig"},
+		},
+		search: map[string][]openplantbook.PlantSearchResult{
+			"ficus": {{PID: "ficus lyrata"}, {PID: "ficus elastica"}, {PID: "ficus nodata"}},
+		},
+	}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    []string
+	}{
+		{"from search", map[string]interface{}{"query": "ficus", "light_lux": 2000.0, "temperature": 21.0, "humidity": 45.0}, "", []string{
+			"**Candidates**: 3 plant(s) from the search \"ficus\"",
+			"1. **Rubber Plant** (ficus elastica) - 100% fit",
+			"2. **Fiddle Leaf Fig** (ficus lyrata)",
+			"Light 2000 lux is below its 5000-30000 lux",
+			"- Mystery Fig (ficus nodata): no data for the given conditions",
+		}},
+		{"from pids with limit", map[string]interface{}{"pids": []interface{}{"ficus lyrata", "ficus elastica", "missing"}, "light_lux": 10000.0, "limit": 1.0}, "", []string{
+			"**Candidates**: 3 provided plant(s)",
+			"1. **Fiddle Leaf Fig**",
+			"_1 more candidate(s) scored lower._",
+			"- missing:",
+		}},
+		{"no conditions", map[string]interface{}{"query": "ficus"}, "provide at least one of light_lux, temperature or humidity", nil},
+		{"no candidates", map[string]interface{}{"light_lux": 2000.0}, "provide candidate plants as pids or a search query", nil},
+		{"pids and query", map[string]interface{}{"light_lux": 2000.0, "query": "ficus", "pids": []interface{}{"ficus lyrata"}}, "provide either pids or query, not both", nil},
+		{"limit too large", map[string]interface{}{"light_lux": 2000.0, "query": "ficus", "limit": 50.0}, "limit must be between 1 and 20", nil},
+		{"empty search", map[string]interface{}{"light_lux": 2000.0, "query": "cactus"}, `no plants found for "cactus"`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleRecommendPlants(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleRecommendPlants() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError {
+				t.Fatalf("unexpected error: %s", text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(text, want) {
+					t.Errorf("result missing %q:\n%s", want, text)
+				}
+			}
+		})
+	}
+}
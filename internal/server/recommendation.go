@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// recommendationActions maps metric key to the instruction for a low and a high reading
+var recommendationActions = map[string][2]string{
+	"moisture":    {"Water the plant now", "Hold off watering and let the soil dry out"},
+	"temperature": {"Move the plant somewhere warmer, away from cold drafts", "Move the plant somewhere cooler, away from heaters and hot windows"},
+	"light_lux":   {"Move the plant closer to a window or add a grow light", "Move the plant out of direct sun or filter the light with a sheer curtain"},
+	"humidity":    {"Raise the humidity with a humidifier or pebble tray", "Improve air circulation to bring the humidity down"},
+}
+
+// topRecommendation picks the out-of-range metric whose fix would help most, ranked the
+// same way as status_badge: worst status first, then largest deviation.
+// ok is false when every comparable metric is in range; rated is every comparable metric.
+func topRecommendation(details *openplantbook.PlantDetails, conditions map[string]interface{}, criticalDeviation float64) (top metricBadge, rated []metricBadge, ok bool) {
+	rated = rateMetrics(details, conditions, criticalDeviation)
+	top, found := computeBadge(details, conditions, criticalDeviation)
+	return top, rated, found && top.status != badgeHealthy
+}
+
+// recommendationInstruction is the one-sentence action for an out-of-range metric
+func recommendationInstruction(b metricBadge) string {
+	actions := recommendationActions[b.metric.key]
+	action, direction := actions[0], "below"
+	if b.value > b.max {
+		action, direction = actions[1], "above"
+	}
+	return fmt.Sprintf("%s: %s is %g%s, %s the ideal %g-%g%s.",
+		action, b.metric.label, b.value, b.metric.unit, direction, b.min, b.max, b.metric.unit)
+}
+
+// formatTopRecommendation renders the chosen recommendation, or encouragement when nothing is off
+func formatTopRecommendation(details *openplantbook.PlantDetails, top metricBadge, rated []metricBadge, found bool) string {
+	output := fmt.Sprintf("# Top Recommendation for %s (%s)\n\n", details.Alias, details.DisplayPID)
+
+	if !found {
+		output += fmt.Sprintf("✅ Nothing to change - all %d readings are within the ideal ranges. Keep doing what you're doing!\n", len(rated))
+		return output
+	}
+
+	output += fmt.Sprintf("**%s**\n\n", recommendationInstruction(top))
+	output += fmt.Sprintf("Severity: %s (%.0f%% of the range width outside the ideal range)\n", top.status, top.deviation)
+
+	others := 0
+	for _, b := range rated {
+		if b.status != badgeHealthy && b.metric.key != top.metric.key {
+			others++
+		}
+	}
+	if others > 0 {
+		output += fmt.Sprintf("\n%d other reading(s) are also off, but less severely - fix this one first and re-check.\n", others)
+	}
+
+	return output
+}
+
+// handleTopRecommendation handles the top_recommendation tool
+func (s *Server) handleTopRecommendation(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "top_recommendation")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	conditions, ok := request.GetArguments()["current_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid current_conditions parameter")
+		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
+	}
+
+	logger.Info("choosing top recommendation", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	top, rated, found := topRecommendation(details, conditions, s.badgeCriticalDeviation())
+	if len(rated) == 0 {
+		return mcp.NewToolResultError("no comparable conditions provided: supply moisture, temperature, light_lux, or humidity for metrics this plant has data for"), nil
+	}
+
+	logger.Info("top recommendation chosen", "pid", details.PID, "metric", top.metric.key, "found", found)
+
+	return mcp.NewToolResultText(formatTopRecommendation(details, top, rated, found)), nil
+}
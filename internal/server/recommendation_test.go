@@ -0,0 +1,69 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestTopRecommendation(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		MinSoilMoist: 20, MaxSoilMoist: 60, // width 40
+		MinTemp: 15, MaxTemp: 25, // width 10
+		MinLightLux: 1000, MaxLightLux: 5000,
+	}
+
+	tests := []struct {
+		name        string
+		conditions  map[string]interface{}
+		found       bool
+		metric      string
+		instruction string
+	}{
+		{"all in range", map[string]interface{}{"moisture": 40.0, "temperature": 20.0}, false, "", ""},
+		{"single issue", map[string]interface{}{"moisture": 65.0, "temperature": 20.0}, true, "moisture",
+			"Hold off watering and let the soil dry out: Soil Moisture is 65%, above the ideal 20-60%."},
+		// 5% of the moisture range vs 20% of the temperature range
+		{"larger relative deviation wins", map[string]interface{}{"moisture": 18.0, "temperature": 13.0}, true, "temperature",
+			"Move the plant somewhere warmer, away from cold drafts: Temperature is 13°C, below the ideal 15-25°C."},
+		// light is 25% outside (critical), moisture 23.75% (attention)
+		{"critical beats attention", map[string]interface{}{"moisture": 10.5, "light_lux": 0.0}, true, "light_lux", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			top, _, found := topRecommendation(details, tt.conditions, defaultBadgeCriticalDeviation)
+			if found != tt.found {
+				t.Fatalf("found = %v, want %v", found, tt.found)
+			}
+			if !found {
+				return
+			}
+			if top.metric.key != tt.metric {
+				t.Errorf("metric = %s, want %s", top.metric.key, tt.metric)
+			}
+			if tt.instruction != "" && recommendationInstruction(top) != tt.instruction {
+				t.Errorf("instruction = %q, want %q", recommendationInstruction(top), tt.instruction)
+			}
+		})
+	}
+
+	t.Run("nothing to change message", func(t *testing.T) {
+		conditions := map[string]interface{}{"moisture": 40.0}
+		top, rated, found := topRecommendation(details, conditions, defaultBadgeCriticalDeviation)
+		output := formatTopRecommendation(details, top, rated, found)
+		if !strings.Contains(output, "Nothing to change") {
+			t.Errorf("expected encouraging message, got:\n%s", output)
+		}
+	})
+
+	t.Run("mentions other issues", func(t *testing.T) {
+		conditions := map[string]interface{}{"moisture": 18.0, "temperature": 13.0}
+		top, rated, found := topRecommendation(details, conditions, defaultBadgeCriticalDeviation)
+		output := formatTopRecommendation(details, top, rated, found)
+		if !strings.Contains(output, "1 other reading(s)") {
+			t.Errorf("expected note about the remaining issue, got:\n%s", output)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+)
+
+// redactedPlaceholder replaces secrets in log output
+const redactedPlaceholder = "***"
+
+// newSecretRedactor returns a replacer that masks every non-empty secret, or nil when
+// there is nothing to mask. Longer secrets are replaced first so one that contains
+// another is masked whole.
+func newSecretRedactor(secrets ...string) *strings.Replacer {
+	var kept []string
+	for _, secret := range secrets {
+		if secret != "" {
+			kept = append(kept, secret)
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	// strings.Replacer tries old strings in argument order at each position
+	sort.SliceStable(kept, func(i, j int) bool { return len(kept[i]) > len(kept[j]) })
+	pairs := make([]string, 0, 2*len(kept))
+	for _, secret := range kept {
+		pairs = append(pairs, secret, redactedPlaceholder)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// redactingHandler scrubs secrets from log records before the wrapped handler writes
+// them, so logs pasted into issues never carry credentials. It covers the message and
+// every attribute, including those added with With and inside groups.
+type redactingHandler struct {
+	next     slog.Handler
+	redactor *strings.Replacer
+}
+
+// newRedactingHandler wraps next so the given secrets never reach it; with no non-empty
+// secret it returns next unchanged
+func newRedactingHandler(next slog.Handler, secrets ...string) slog.Handler {
+	redactor := newSecretRedactor(secrets...)
+	if redactor == nil {
+		return next
+	}
+	return &redactingHandler{next: next, redactor: redactor}
+}
+
+// Enabled implements slog.Handler
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.Replace(record.Message), record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(attr))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs implements slog.Handler
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, attr := range attrs {
+		redacted[i] = h.redactAttr(attr)
+	}
+	return &redactingHandler{next: h.next.WithAttrs(redacted), redactor: h.redactor}
+}
+
+// WithGroup implements slog.Handler
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name), redactor: h.redactor}
+}
+
+// redactAttr masks secrets in an attribute's value. Values that aren't strings are
+// checked through their printed form and only replaced by it when they leak a secret,
+// so ordinary numbers and structures keep their JSON shape.
+func (h *redactingHandler) redactAttr(attr slog.Attr) slog.Attr {
+	value := attr.Value.Resolve()
+	switch value.Kind() {
+	case slog.KindString:
+		attr.Value = slog.StringValue(h.redactor.Replace(value.String()))
+	case slog.KindGroup:
+		group := value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, a := range group {
+			redacted[i] = h.redactAttr(a)
+		}
+		attr.Value = slog.GroupValue(redacted...)
+	case slog.KindAny:
+		printed := fmt.Sprint(value.Any())
+		if masked := h.redactor.Replace(printed); masked != printed {
+			attr.Value = slog.StringValue(masked)
+		} else {
+			attr.Value = value
+		}
+	default:
+		attr.Value = value
+	}
+	return attr
+}
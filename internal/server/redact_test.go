@@ -0,0 +1,76 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactingHandler(t *testing.T) {
+	const apiKey, secret = "sk-live-abc123", "oauth-secret-xyz"
+
+	tests := []struct {
+		name string
+		log  func(*slog.Logger)
+	}{
+		{"message", func(l *slog.Logger) { l.Info("request with key " + apiKey) }},
+		{"string attr", func(l *slog.Logger) { l.Info("auth", "header", "Bearer "+secret) }},
+		{"error attr", func(l *slog.Logger) { l.Error("call failed", "error", errors.New("invalid key "+apiKey)) }},
+		{"group", func(l *slog.Logger) { l.Info("config", slog.Group("auth", "client_secret", secret)) }},
+		{"with attrs", func(l *slog.Logger) { l.With("api_key", apiKey).Info("ready") }},
+		{"with group", func(l *slog.Logger) { l.WithGroup("auth").Info("ready", "key", apiKey) }},
+		{"any value", func(l *slog.Logger) { l.Info("args", "list", []string{"a", secret}) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tt.log(slog.New(newRedactingHandler(slog.NewJSONHandler(&buf, nil), apiKey, "", secret)))
+
+			out := buf.String()
+			if strings.Contains(out, apiKey) || strings.Contains(out, secret) {
+				t.Errorf("log leaked a secret: %s", out)
+			}
+			if !strings.Contains(out, redactedPlaceholder) {
+				t.Errorf("log missing %q placeholder: %s", redactedPlaceholder, out)
+			}
+		})
+	}
+}
+
+func TestRedactingHandler_KeepsOtherValues(t *testing.T) {
+	var buf bytes.Buffer
+	slog.New(newRedactingHandler(slog.NewJSONHandler(&buf, nil), "secret")).Info("ok", "count", 3, "pids", []string{"monstera deliciosa"})
+	if out := buf.String(); !strings.Contains(out, `"count":3`) || !strings.Contains(out, `"pids":["monstera deliciosa"]`) {
+		t.Errorf("values without secrets should keep their JSON form: %s", out)
+	}
+
+	// No secrets configured leaves the handler unwrapped
+	next := slog.NewJSONHandler(&buf, nil)
+	if h := newRedactingHandler(next, "", ""); h != next {
+		t.Errorf("expected the handler to be returned unchanged, got %T", h)
+	}
+}
+
+func TestNew_RedactsCredentialsInLogs(t *testing.T) {
+	const apiKey = "sk-live-abc123"
+	logFile := filepath.Join(t.TempDir(), "server.log")
+	srv, err := New(&Config{APIKey: apiKey, LogFile: logFile}, "test")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	srv.logger.Error("upstream rejected key", "error", errors.New("401: bad key "+apiKey))
+
+	data, err := os.ReadFile(logFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out := string(data); strings.Contains(out, apiKey) || !strings.Contains(out, "401: bad key "+redactedPlaceholder) {
+		t.Errorf("expected the API key masked in the log file, got %s", out)
+	}
+}
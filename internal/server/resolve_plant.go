@@ -0,0 +1,204 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// resolveSearchLimit is how many search results are ranked when resolving a name
+const resolveSearchLimit = 20
+
+// resolveMaxAlternatives caps the runner-up candidates listed after the best match
+const resolveMaxAlternatives = 3
+
+// Match kinds, strongest first, with the score range each one produces
+const (
+	matchExact    = "exact"    // 1.0
+	matchPrefix   = "prefix"   // 0.8-0.9, higher when the query covers more of the name
+	matchWord     = "word"     // 0.7: the query is one of the name's words
+	matchContains = "contains" // 0.6
+	matchFuzzy    = "fuzzy"    // below 0.6, by edit distance
+)
+
+// normalizeName folds case and whitespace for comparison
+func normalizeName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+// matchScore scores how well a query names a candidate, from 0 to 1, and says how
+func matchScore(query, candidate string) (float64, string) {
+	q, c := normalizeName(query), normalizeName(candidate)
+	switch {
+	case q == "" || c == "":
+		return 0, matchFuzzy
+	case q == c:
+		return 1, matchExact
+	case strings.HasPrefix(c, q):
+		return 0.8 + 0.1*float64(len(q))/float64(len(c)), matchPrefix
+	case containsString(strings.Fields(c), q):
+		return 0.7, matchWord
+	case strings.Contains(c, q):
+		return 0.6, matchContains
+	}
+	longest := math.Max(float64(len([]rune(q))), float64(len([]rune(c))))
+	similarity := 1 - float64(editDistance(q, c))/longest
+	return math.Max(similarity, 0) * 0.6, matchFuzzy
+}
+
+// editDistance is the Levenshtein distance between two strings, in runes
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr := make([]int, len(rb)+1)
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(rb)]
+}
+
+// plantMatch is a search result scored against the query
+type plantMatch struct {
+	PID         string  `json:"pid"`
+	DisplayName string  `json:"display_name"`
+	CommonName  string  `json:"common_name,omitempty"`
+	Score       float64 `json:"score"`
+	Match       string  `json:"match"`
+	MatchedOn   string  `json:"matched_on"` // "common name" or "scientific name"
+}
+
+// scorePlantMatch scores a result on both its common and scientific names, keeping the better
+func scorePlantMatch(query string, r openplantbook.PlantSearchResult) plantMatch {
+	m := plantMatch{PID: r.PID, DisplayName: r.DisplayPID, CommonName: r.Alias}
+	if m.DisplayName == "" {
+		m.DisplayName = r.PID
+	}
+
+	m.Score, m.Match = matchScore(query, m.DisplayName)
+	m.MatchedOn = "scientific name"
+	if score, kind := matchScore(query, r.Alias); score > m.Score {
+		m.Score, m.Match, m.MatchedOn = score, kind, "common name"
+	}
+	m.Score = math.Round(m.Score*100) / 100
+	return m
+}
+
+// rankPlantMatches scores every result, best first; ties keep the API's order
+func rankPlantMatches(query string, results []openplantbook.PlantSearchResult) []plantMatch {
+	matches := make([]plantMatch, len(results))
+	for i, r := range results {
+		matches[i] = scorePlantMatch(query, r)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// matchReason explains in a sentence why a candidate was chosen
+func matchReason(m plantMatch, broadened bool) string {
+	var reason string
+	switch m.Match {
+	case matchExact:
+		reason = fmt.Sprintf("Exact match on the %s", m.MatchedOn)
+	case matchPrefix:
+		reason = fmt.Sprintf("The query is the start of the %s", m.MatchedOn)
+	case matchWord:
+		reason = fmt.Sprintf("The query is one word of the %s", m.MatchedOn)
+	case matchContains:
+		reason = fmt.Sprintf("The query appears inside the %s", m.MatchedOn)
+	default:
+		reason = fmt.Sprintf("Closest spelling to the %s; check this is the plant you meant", m.MatchedOn)
+	}
+	if broadened {
+		reason += ", found by searching the genus because the full name had no results"
+	}
+	return reason + "."
+}
+
+// plantResolution is the resolve_plant response
+type plantResolution struct {
+	Query string `json:"query"`
+	plantMatch
+	Reason       string       `json:"reason"`
+	Broadened    bool         `json:"broadened,omitempty"`
+	Alternatives []plantMatch `json:"alternatives,omitempty"`
+}
+
+// handleResolvePlant handles the resolve_plant tool
+func (s *Server) handleResolvePlant(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "resolve_plant")
+
+	// Extract parameters
+	query, err := request.RequireString("query")
+	if err != nil || strings.TrimSpace(query) == "" {
+		logger.Warn("invalid query parameter", "error", err)
+		return mcp.NewToolResultError("query parameter is required and must be a plant name"), nil
+	}
+
+	logger.Info("resolving plant name", "query", query)
+
+	opts := &openplantbook.SearchOptions{Limit: resolveSearchLimit}
+	results, err := s.searchPlants(ctx, query, opts)
+	if err != nil {
+		logger.Error("search failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+	}
+
+	// Fall back to the genus, as search_plants does with broaden_on_empty
+	broadened := false
+	if len(results) == 0 {
+		if genus, ok := genusQuery(query); ok {
+			logger.Info("no results, broadening to genus", "query", query, "genus", genus)
+			results, err = s.searchPlants(ctx, genus, opts)
+			if err != nil {
+				logger.Error("genus search failed", "error", err)
+				return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+			}
+			broadened = len(results) > 0
+		}
+	}
+	if len(results) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("no plants match %q; try the scientific name or a shorter common name", query)), nil
+	}
+
+	matches := rankPlantMatches(query, results)
+	best := matches[0]
+	resolution := plantResolution{
+		Query:      query,
+		plantMatch: best,
+		Reason:     matchReason(best, broadened),
+		Broadened:  broadened,
+	}
+	if len(matches) > 1 {
+		resolution.Alternatives = matches[1:min(len(matches), resolveMaxAlternatives+1)]
+	}
+
+	logger.Info("plant resolved", "query", query, "pid", best.PID, "score", best.Score, "match", best.Match)
+
+	data, err := json.MarshalIndent(resolution, "", "  ")
+	if err != nil {
+		logger.Error("marshal resolution failed", "error", err)
+		return mcp.NewToolResultError("failed to format resolution"), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
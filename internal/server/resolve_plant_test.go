@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestMatchScore(t *testing.T) {
+	tests := []struct {
+		query, candidate string
+		kind             string
+		minScore         float64
+		maxScore         float64
+	}{
+		{"Monstera Deliciosa", "monstera  deliciosa", matchExact, 1, 1},
+		{"monstera", "monstera deliciosa", matchPrefix, 0.8, 0.9},
+		{"deliciosa", "monstera deliciosa", matchWord, 0.7, 0.7},
+		{"stera", "monstera deliciosa", matchContains, 0.6, 0.6},
+		{"monstra deliciosa", "monstera deliciosa", matchFuzzy, 0.5, 0.6},
+		{"basil", "monstera deliciosa", matchFuzzy, 0, 0.2},
+	}
+
+	for _, tt := range tests {
+		score, kind := matchScore(tt.query, tt.candidate)
+		if kind != tt.kind || score < tt.minScore || score > tt.maxScore {
+			t.Errorf("matchScore(%q, %q) = %v, %s; want %s in [%v, %v]", tt.query, tt.candidate, score, kind, tt.kind, tt.minScore, tt.maxScore)
+		}
+	}
+
+	// A longer share of the name ranks a prefix higher
+	short, _ := matchScore("mon", "monstera deliciosa")
+	long, _ := matchScore("monstera del", "monstera deliciosa")
+	if short >= long {
+		t.Errorf("expected the longer prefix to score higher: %v >= %v", short, long)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"kitten", "sitting", 3},
+		{"ficus", "ficus", 0},
+		{"café", "cafe", 1},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestHandleResolvePlant(t *testing.T) {
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{
+		"swiss cheese plant": {
+			{PID: "monstera adansonii", DisplayPID: "Monstera adansonii", Alias: "Swiss cheese vine"},
+			{PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Swiss Cheese Plant"},
+		},
+		"philodendron bogusii": nil,
+		"philodendron": {
+			{PID: "philodendron hederaceum", DisplayPID: "Philodendron hederaceum", Alias: "Heartleaf philodendron"},
+		},
+	}}
+	srv := newTestServer(t, client)
+
+	resolve := func(query string) plantResolution {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{"query": query}
+		result, err := srv.handleResolvePlant(context.Background(), request)
+		if err != nil || result.IsError {
+			t.Fatalf("resolve_plant(%q) failed: %v %s", query, err, resultText(t, result))
+		}
+		var resolution plantResolution
+		if err := json.Unmarshal([]byte(resultText(t, result)), &resolution); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		return resolution
+	}
+
+	r := resolve("swiss cheese plant")
+	if r.PID != "monstera deliciosa" || r.Match != matchExact || r.MatchedOn != "common name" || r.Score != 1 {
+		t.Errorf("expected an exact common-name match on monstera deliciosa, got %+v", r)
+	}
+	if len(r.Alternatives) != 1 || r.Alternatives[0].PID != "monstera adansonii" {
+		t.Errorf("expected the other result as an alternative, got %+v", r.Alternatives)
+	}
+
+	r = resolve("philodendron bogusii")
+	if r.PID != "philodendron hederaceum" || !r.Broadened || !strings.Contains(r.Reason, "searching the genus") {
+		t.Errorf("expected a broadened genus match, got %+v", r)
+	}
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"query": "xyzzy"}
+	if result, _ := srv.handleResolvePlant(context.Background(), request); !result.IsError {
+		t.Error("expected an unknown name to fail")
+	}
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// plantResourceTemplate is the URI template clients read plant profiles through
+const plantResourceTemplate = "plant://{pid}"
+
+// plantResourceMIMEType is the MIME type of plant profile resources
+const plantResourceMIMEType = "application/json"
+
+// plantSummaryResourceTemplate is the URI template clients read care summaries through
+const plantSummaryResourceTemplate = "plant://{pid}/summary"
+
+// plantSummaryResourceMIMEType is the MIME type of care summary resources
+const plantSummaryResourceMIMEType = "text/markdown"
+
+// registerResources registers the MCP resources. A plant's full care profile can be read
+// as plant://<pid>, and its care summary as plant://<pid>/summary, and attached as
+// context without a tool call; both go through getPlantDetails, so reads share the
+// cache and retries with the tools.
+func (s *Server) registerResources(mcpServer *server.MCPServer) {
+	mcpServer.AddResourceTemplate(
+		mcp.NewResourceTemplate(
+			plantResourceTemplate,
+			"Plant care profile",
+			mcp.WithTemplateDescription("Full OpenPlantbook care profile of a plant as JSON. Use the exact 'pid' value from search_plants (URL-encoded, e.g. plant://monstera%20deliciosa) or a pin_plant token"),
+			mcp.WithTemplateMIMEType(plantResourceMIMEType),
+		),
+		s.handleReadPlantResource,
+	)
+	mcpServer.AddResourceTemplate(
+		mcp.NewResourceTemplate(
+			plantSummaryResourceTemplate,
+			"Plant care summary",
+			mcp.WithTemplateDescription("Human-readable care summary of a plant with interpreted ranges, as get_care_summary renders it by default. Use the exact 'pid' value from search_plants (URL-encoded, e.g. plant://monstera%20deliciosa/summary) or a pin_plant token"),
+			mcp.WithTemplateMIMEType(plantSummaryResourceMIMEType),
+		),
+		s.handleReadPlantSummaryResource,
+	)
+	s.logger.Info("registered resources", "templates", []string{plantResourceTemplate, plantSummaryResourceTemplate})
+}
+
+// plantResourcePID returns the pid matched from a plant:// URI, decoding any escaped
+// characters such as the space in "monstera%20deliciosa"
+func plantResourcePID(request mcp.ReadResourceRequest) (string, error) {
+	var pid string
+	switch v := request.Params.Arguments["pid"].(type) {
+	case string:
+		pid = v
+	case []string:
+		if len(v) > 0 {
+			pid = v[0]
+		}
+	}
+	if pid == "" {
+		return "", fmt.Errorf("no plant ID in resource URI %q: use plant://<pid>", request.Params.URI)
+	}
+	decoded, err := url.PathUnescape(pid)
+	if err != nil {
+		return "", fmt.Errorf("invalid plant ID %q in resource URI: %w", pid, err)
+	}
+	return decoded, nil
+}
+
+// handleReadPlantResource handles reads of plant://{pid}
+func (s *Server) handleReadPlantResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "resource", plantResourceTemplate)
+
+	pid, err := plantResourcePID(request)
+	if err != nil {
+		logger.Warn("invalid plant resource URI", "uri", request.Params.URI, "error", err)
+		return nil, err
+	}
+
+	logger.Info("reading plant resource", "pid", pid)
+
+	details, err := s.getPlantDetails(withTraceID(ctx, traceID), pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return nil, fmt.Errorf("failed to get plant details: %w", err)
+	}
+
+	data, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		logger.Error("marshal failed", "error", err)
+		return nil, fmt.Errorf("failed to format plant details: %w", err)
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: plantResourceMIMEType,
+			Text:     string(data),
+		},
+	}, nil
+}
+
+// handleReadPlantSummaryResource handles reads of plant://{pid}/summary
+func (s *Server) handleReadPlantSummaryResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "resource", plantSummaryResourceTemplate)
+
+	pid, err := plantResourcePID(request)
+	if err != nil {
+		logger.Warn("invalid plant resource URI", "uri", request.Params.URI, "error", err)
+		return nil, err
+	}
+
+	logger.Info("reading plant summary resource", "pid", pid)
+
+	details, err := s.getPlantDetails(withTraceID(ctx, traceID), pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return nil, fmt.Errorf("failed to get plant details: %w", err)
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return nil, errors.New(noCareDataMessage(pid))
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      request.Params.URI,
+			MIMEType: plantSummaryResourceMIMEType,
+			Text:     renderCareSummary(details, summaryOptions{metric: true, precision: s.numberPrecision()}),
+		},
+	}, nil
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+// handleResourceMessage sends one JSON-RPC message to an MCP server with the resources
+// registered and returns the raw response
+func handleResourceMessage(t *testing.T, srv *Server, message string) []byte {
+	t.Helper()
+	mcpServer := srv.newMCPServer()
+	srv.registerResources(mcpServer)
+	raw, err := json.Marshal(mcpServer.HandleMessage(context.Background(), json.RawMessage(message)))
+	if err != nil {
+		t.Fatalf("marshal response: %v", err)
+	}
+	return raw
+}
+
+func TestRegisterResources_Template(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+
+	raw := handleResourceMessage(t, srv, `{"jsonrpc":"2.0","id":1,"method":"resources/templates/list"}`)
+	var decoded struct {
+		Result mcp.ListResourceTemplatesResult `json:"result"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+
+	want := map[string]string{
+		plantResourceTemplate:        plantResourceMIMEType,
+		plantSummaryResourceTemplate: plantSummaryResourceMIMEType,
+	}
+	templates := decoded.Result.ResourceTemplates
+	if len(templates) != len(want) {
+		t.Fatalf("templates = %s, want %d", raw, len(want))
+	}
+	for _, template := range templates {
+		if mimeType, ok := want[template.URITemplate.Raw()]; !ok || template.MIMEType != mimeType {
+			t.Errorf("unexpected template %s (%s)", template.URITemplate.Raw(), template.MIMEType)
+		}
+	}
+}
+
+func TestHandleReadPlantResource(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", Alias: "Monstera", MinTemp: 15, MaxTemp: 30},
+		"no data|":            {PID: "no data", Alias: "Mystery"},
+	}}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name     string
+		uri      string
+		wantErr  string
+		wantMIME string
+		want     string
+	}{
+		{"escaped pid", "plant://monstera%20deliciosa", "", plantResourceMIMEType, `"alias": "Monstera"`},
+		{"unknown plant", "plant://no%20such%20plant", "failed to get plant details", "", ""},
+		{"summary", "plant://monstera%20deliciosa/summary", "", plantSummaryResourceMIMEType, "15.0 - 30.0°C"},
+		{"summary of unknown plant", "plant://no%20such%20plant/summary", "failed to get plant details", "", ""},
+		{"summary without care data", "plant://no%20data/summary", "care data is unavailable", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := handleResourceMessage(t, srv, `{"jsonrpc":"2.0","id":1,"method":"resources/read","params":{"uri":"`+tt.uri+`"}}`)
+			var decoded struct {
+				Result struct {
+					Contents []mcp.TextResourceContents `json:"contents"`
+				} `json:"result"`
+				Error *struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(raw, &decoded); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+
+			if tt.wantErr != "" {
+				if decoded.Error == nil || !strings.Contains(decoded.Error.Message, tt.wantErr) {
+					t.Errorf("response = %s, want error containing %q", raw, tt.wantErr)
+				}
+				return
+			}
+			if decoded.Error != nil || len(decoded.Result.Contents) != 1 {
+				t.Fatalf("response = %s, want one content", raw)
+			}
+			content := decoded.Result.Contents[0]
+			if content.URI != tt.uri || content.MIMEType != tt.wantMIME || !strings.Contains(content.Text, tt.want) {
+				t.Errorf("content = %+v, want %s containing %q", content, tt.wantMIME, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"time"
+
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// defaultRetryMaxAttempts is the total number of tries for a transient failure,
+	// including the first
+	defaultRetryMaxAttempts = 3
+
+	// defaultRetryBaseDelay is the wait before the first retry; each later retry doubles it
+	defaultRetryBaseDelay = 250 * time.Millisecond
+
+	// maxRetryDelay caps a single wait so a large attempt count can't stall a tool call
+	maxRetryDelay = 5 * time.Second
+)
+
+// retryMaxAttempts returns the configured attempt limit or the default; 1 disables retries
+func (s *Server) retryMaxAttempts() int {
+	if s.config.RetryMaxAttempts > 0 {
+		return s.config.RetryMaxAttempts
+	}
+	return defaultRetryMaxAttempts
+}
+
+// retryBaseDelay returns the configured first backoff or the default
+func (s *Server) retryBaseDelay() time.Duration {
+	if s.config.RetryBaseDelay > 0 {
+		return s.config.RetryBaseDelay
+	}
+	return defaultRetryBaseDelay
+}
+
+// isTransient reports whether an API error is worth retrying: a 5xx from OpenPlantbook or
+// a network failure. Not found, auth and rate limit errors would fail the same way again.
+func isTransient(err error) bool {
+	var apiErr *openplantbook.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.IsServerError()
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// retryDelay is the exponential backoff before retry n (1-based), with jitter spreading
+// the wait over its upper half so concurrent calls don't retry in lockstep
+func retryDelay(base time.Duration, n int) time.Duration {
+	delay := min(base<<(n-1), maxRetryDelay)
+	if delay <= 0 {
+		// The shift overflowed
+		delay = maxRetryDelay
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// withRetry runs call, retrying transient failures with exponential backoff up to
+// retryMaxAttempts tries. It gives up at once when ctx is cancelled, returning the last
+// call's error.
+func (s *Server) withRetry(ctx context.Context, op string, call func() error) error {
+	attempts := s.retryMaxAttempts()
+	for attempt := 1; ; attempt++ {
+		err := call()
+		if err == nil || attempt >= attempts || !isTransient(err) || ctx.Err() != nil {
+			return err
+		}
+
+		delay := retryDelay(s.retryBaseDelay(), attempt)
+		s.logger.Warn("transient API error, retrying", "op", op, "attempt", attempt, "max_attempts", attempts, "delay", delay, "error", err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"bad gateway", fmt.Errorf("get details: %w", &openplantbook.APIError{StatusCode: 502}), true},
+		{"client error", &openplantbook.APIError{StatusCode: 400}, false},
+		{"not found", fmt.Errorf("get details: %w", openplantbook.ErrNotFound), false},
+		{"unauthorized", openplantbook.ErrUnauthorized, false},
+		{"rate limited", openplantbook.ErrRateLimitExceeded, false},
+		{"network timeout", &net.DNSError{Err: "i/o timeout", IsTimeout: true}, true},
+		{"connection refused", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"other", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.err); got != tt.want {
+				t.Errorf("isTransient(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	base := 100 * time.Millisecond
+	for n, want := range map[int]time.Duration{1: 100 * time.Millisecond, 3: 400 * time.Millisecond, 20: maxRetryDelay} {
+		for i := 0; i < 20; i++ {
+			if got := retryDelay(base, n); got < want/2 || got > want {
+				t.Fatalf("retryDelay(%v, %d) = %v, want within [%v, %v]", base, n, got, want/2, want)
+			}
+		}
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.RetryBaseDelay = time.Millisecond
+	transient := &openplantbook.APIError{StatusCode: 503}
+
+	t.Run("recovers after transient failures", func(t *testing.T) {
+		calls := 0
+		err := srv.withRetry(context.Background(), "test", func() error {
+			calls++
+			if calls < 3 {
+				return transient
+			}
+			return nil
+		})
+		if err != nil || calls != 3 {
+			t.Errorf("withRetry() = %v after %d calls, want success on the third", err, calls)
+		}
+	})
+
+	t.Run("stops at max attempts", func(t *testing.T) {
+		srv.config.RetryMaxAttempts = 2
+		defer func() { srv.config.RetryMaxAttempts = 0 }()
+		calls := 0
+		err := srv.withRetry(context.Background(), "test", func() error {
+			calls++
+			return transient
+		})
+		if !errors.Is(err, transient) || calls != 2 {
+			t.Errorf("withRetry() = %v after %d calls, want the last error after 2", err, calls)
+		}
+	})
+
+	t.Run("honors cancellation", func(t *testing.T) {
+		srv.config.RetryBaseDelay = time.Hour
+		defer func() { srv.config.RetryBaseDelay = time.Millisecond }()
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		done := make(chan error, 1)
+		go func() {
+			done <- srv.withRetry(ctx, "test", func() error {
+				calls++
+				return transient
+			})
+		}()
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		select {
+		case err := <-done:
+			if !errors.Is(err, transient) || calls != 1 {
+				t.Errorf("withRetry() = %v after %d calls, want the first error", err, calls)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("withRetry() kept waiting after the context was cancelled")
+		}
+	})
+}
+
+func TestFetchDetails_Retry(t *testing.T) {
+	client := &fakeClient{
+		details:    map[string]*openplantbook.PlantDetails{"monstera deliciosa|": {PID: "monstera deliciosa"}},
+		detailErrs: map[string]error{"flaky": fmt.Errorf("get details: %w", &openplantbook.APIError{StatusCode: 502})},
+	}
+	srv := newTestServer(t, client)
+	srv.config.RetryBaseDelay = time.Millisecond
+
+	if _, err := srv.fetchDetails(context.Background(), "flaky", "en"); err == nil {
+		t.Fatal("expected the 502 to surface after the retries")
+	}
+	if got := len(client.detailCalls); got != defaultRetryMaxAttempts {
+		t.Errorf("502 was tried %d times, want %d", got, defaultRetryMaxAttempts)
+	}
+
+	// A 404 is final: no retry
+	client.detailCalls = nil
+	if _, err := srv.fetchDetails(context.Background(), "ficus lyrata", "en"); !errors.Is(err, openplantbook.ErrNotFound) {
+		t.Fatalf("fetchDetails() error = %v, want not found", err)
+	}
+	if got := len(client.detailCalls); got != 1 {
+		t.Errorf("404 was tried %d times, want 1", got)
+	}
+
+	// Every attempt counts as an upstream call
+	if got := srv.usage.detailCalls.Load(); got != defaultRetryMaxAttempts+1 {
+		t.Errorf("recorded detail calls = %d, want %d", got, defaultRetryMaxAttempts+1)
+	}
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// metricScore is the share of readings for one metric that fell within the ideal range
+type metricScore struct {
+	metric   careMetric
+	samples  int
+	inRange  int
+	idealMin float64
+	idealMax float64
+}
+
+// percent returns the in-range share as a percentage
+func (m metricScore) percent() float64 {
+	if m.samples == 0 {
+		return 0
+	}
+	return float64(m.inRange) / float64(m.samples) * 100
+}
+
+// scoreGrades maps a minimum percentage to a letter grade, best first
+var scoreGrades = []struct {
+	min   float64
+	grade string
+}{
+	{90, "A"},
+	{80, "B"},
+	{70, "C"},
+	{60, "D"},
+	{0, "F"},
+}
+
+// letterGrade converts an in-range percentage to a letter grade
+func letterGrade(percent float64) string {
+	for _, g := range scoreGrades {
+		if percent >= g.min {
+			return g.grade
+		}
+	}
+	return "F"
+}
+
+// scoreCard is the aggregated report for one plant
+type scoreCard struct {
+	scores  []metricScore
+	overall float64
+	worst   *metricScore
+	weights healthWeights
+	from    time.Time
+	to      time.Time
+}
+
+// computeScoreCard checks every reading against the plant's ideal range and aggregates per metric.
+// The overall score is the weighted mean of the metric percentages; the worst metric is the
+// lowest percentage, ties going to the metric listed first in careMetrics.
+func computeScoreCard(details *openplantbook.PlantDetails, series map[string][]timedReading, weights healthWeights) scoreCard {
+	var card scoreCard
+
+	for _, m := range careMetrics {
+		readings, exists := series[m.key]
+		if !exists || len(readings) == 0 {
+			continue
+		}
+		min, max, ok := m.ideal(details)
+		if !ok {
+			continue
+		}
+
+		score := metricScore{metric: m, samples: len(readings), idealMin: min, idealMax: max}
+		for _, r := range readings {
+			if r.value >= min && r.value <= max {
+				score.inRange++
+			}
+			if card.from.IsZero() || r.at.Before(card.from) {
+				card.from = r.at
+			}
+			if r.at.After(card.to) {
+				card.to = r.at
+			}
+		}
+		card.scores = append(card.scores, score)
+	}
+
+	if len(card.scores) == 0 {
+		return card
+	}
+
+	keys := make([]string, len(card.scores))
+	percents := make([]float64, len(card.scores))
+	for i := range card.scores {
+		keys[i] = card.scores[i].metric.key
+		percents[i] = card.scores[i].percent()
+		if card.worst == nil || card.scores[i].percent() < card.worst.percent() {
+			card.worst = &card.scores[i]
+		}
+	}
+	card.overall = weightedMean(percents, weights.normalized(keys))
+	card.weights = weights
+
+	return card
+}
+
+// formatScoreCard renders the score card as markdown
+func formatScoreCard(details *openplantbook.PlantDetails, card scoreCard) string {
+	output := fmt.Sprintf("# Care Score Card for %s\n\n", details.Alias)
+	output += fmt.Sprintf("Period: %s to %s\n\n", card.from.Format(time.RFC3339), card.to.Format(time.RFC3339))
+	output += fmt.Sprintf("**Overall: %.0f%% (%s)**\n\n", card.overall, letterGrade(card.overall))
+	if !card.weights.equal() {
+		metrics := make([]careMetric, len(card.scores))
+		for i, s := range card.scores {
+			metrics[i] = s.metric
+		}
+		output += fmt.Sprintf("Weighted: %s\n\n", card.weights.describe(metrics))
+	}
+	output += "| Metric | Ideal | In range | Grade |\n"
+	output += "|--------|-------|----------|-------|\n"
+	for _, s := range card.scores {
+		output += fmt.Sprintf("| %s | %g-%g%s | %d/%d (%.0f%%) | %s |\n",
+			s.metric.label, s.idealMin, s.idealMax, s.metric.unit, s.inRange, s.samples, s.percent(), letterGrade(s.percent()))
+	}
+	if card.worst != nil && card.worst.inRange < card.worst.samples {
+		output += fmt.Sprintf("\n**Needs most attention**: %s (in range %.0f%% of the time)\n", card.worst.metric.label, card.worst.percent())
+	}
+	return output
+}
+
+// handleScoreCard handles the score_card tool
+func (s *Server) handleScoreCard(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "score_card")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	readings, ok := request.GetArguments()["readings"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid readings parameter")
+		return mcp.NewToolResultError("readings parameter is required and must be an object keyed by metric"), nil
+	}
+
+	series := make(map[string][]timedReading, len(readings))
+	for _, metric := range careMetrics {
+		raw, exists := readings[metric.key]
+		if !exists {
+			continue
+		}
+		parsed, err := parseTimedReadings(raw)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("readings.%s %v", metric.key, err)), nil
+		}
+		series[metric.key] = parsed
+	}
+
+	weights, err := s.healthWeights(request.GetArguments()["weights"])
+	if err != nil {
+		logger.Warn("invalid weights parameter", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("computing score card", "pid", pid, "metrics", len(series))
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	card := computeScoreCard(details, series, weights)
+	if len(card.scores) == 0 {
+		return mcp.NewToolResultError("no scorable readings provided: supply moisture, temperature, light_lux, or humidity readings for metrics this plant has data for"), nil
+	}
+
+	logger.Info("score card computed", "pid", details.PID, "overall", card.overall)
+
+	return mcp.NewToolResultText(formatScoreCard(details, card)), nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestLetterGrade(t *testing.T) {
+	tests := []struct {
+		percent  float64
+		expected string
+	}{
+		{100, "A"},
+		{90, "A"},
+		{89.9, "B"},
+		{70, "C"},
+		{60, "D"},
+		{59, "F"},
+		{0, "F"},
+	}
+
+	for _, tt := range tests {
+		if got := letterGrade(tt.percent); got != tt.expected {
+			t.Errorf("letterGrade(%v) = %q, want %q", tt.percent, got, tt.expected)
+		}
+	}
+}
+
+func TestComputeScoreCard(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias:        "Basil",
+		MinSoilMoist: 20, MaxSoilMoist: 60,
+		MinTemp: 15, MaxTemp: 30,
+	}
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return start.Add(time.Duration(h) * time.Hour) }
+
+	series := map[string][]timedReading{
+		// 2 of 4 in range
+		"moisture": {{at(0), 50}, {at(1), 30}, {at(2), 15}, {at(3), 10}},
+		// 4 of 4 in range
+		"temperature": {{at(0), 20}, {at(1), 21}, {at(2), 22}, {at(4), 23}},
+		// plant has no humidity data, so this is ignored
+		"humidity": {{at(0), 50}},
+	}
+
+	card := computeScoreCard(details, series, nil)
+	if len(card.scores) != 2 {
+		t.Fatalf("expected 2 scored metrics, got %d", len(card.scores))
+	}
+	if card.overall != 75 {
+		t.Errorf("overall = %v, want 75", card.overall)
+	}
+	if card.worst == nil || card.worst.metric.key != "moisture" {
+		t.Errorf("worst metric = %v, want moisture", card.worst)
+	}
+	if !card.from.Equal(at(0)) || !card.to.Equal(at(4)) {
+		t.Errorf("period = %v to %v", card.from, card.to)
+	}
+
+	output := formatScoreCard(details, card)
+	for _, want := range []string{"**Overall: 75% (C)**", "| Soil Moisture | 20-60% | 2/4 (50%) | F |", "**Needs most attention**: Soil Moisture"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"strings"
+)
+
+// minGenusLength skips broadening on fragments too short to name a genus
+const minGenusLength = 3
+
+// genusQuery returns the probable genus of a multi-word query: its first word.
+// False when the query is a single word (already as broad as it gets) or too short.
+func genusQuery(query string) (string, bool) {
+	words := strings.Fields(query)
+	if len(words) < 2 || len(words[0]) < minGenusLength {
+		return "", false
+	}
+	return words[0], true
+}
+
+// newBroadenedSearch labels a page of genus results so they aren't mistaken for exact matches
+func newBroadenedSearch(query, genus string, page searchPage) searchPage {
+	page.Broadened = true
+	page.OriginalQuery = query
+	page.GenusQuery = genus
+	page.Note = "No exact matches; these are broader genus matches. Check the species before using a pid."
+	return page
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestGenusQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		genus string
+		ok    bool
+	}{
+		{"monstera delicioso", "monstera", true},
+		{"  Ficus   lyratta ", "Ficus", true},
+		{"monstera", "", false},
+		{"ab cd", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		genus, ok := genusQuery(tt.query)
+		if genus != tt.genus || ok != tt.ok {
+			t.Errorf("genusQuery(%q) = %q, %v; want %q, %v", tt.query, genus, ok, tt.genus, tt.ok)
+		}
+	}
+}
+
+func TestSearchPlants_BroadenOnEmpty(t *testing.T) {
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{
+		"monstera": {{PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa"}},
+	}}
+	srv := newTestServer(t, client)
+
+	search := func(args map[string]interface{}) string {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := srv.handleSearchPlants(context.Background(), request)
+		if err != nil || result.IsError {
+			t.Fatalf("search failed: %v", err)
+		}
+		return resultText(t, result)
+	}
+
+	t.Run("strict by default", func(t *testing.T) {
+		var got searchPage
+		text := search(map[string]interface{}{"query": "monstera delicioso"})
+		if err := json.Unmarshal([]byte(text), &got); err != nil || len(got.Results) != 0 || got.Broadened {
+			t.Errorf("expected no results, got %s", text)
+		}
+	})
+
+	t.Run("broadened to genus", func(t *testing.T) {
+		var got searchPage
+		text := search(map[string]interface{}{"query": "monstera delicioso", "broaden_on_empty": true})
+		if err := json.Unmarshal([]byte(text), &got); err != nil {
+			t.Fatalf("unmarshal %s: %v", text, err)
+		}
+		if !got.Broadened || got.GenusQuery != "monstera" || len(got.Results) != 1 {
+			t.Errorf("unexpected broadened response: %+v", got)
+		}
+	})
+
+	t.Run("exact matches are not broadened", func(t *testing.T) {
+		calls := len(client.searchCalls)
+		text := search(map[string]interface{}{"query": "monstera", "broaden_on_empty": true})
+		var got searchPage
+		if err := json.Unmarshal([]byte(text), &got); err != nil || len(got.Results) != 1 || got.Broadened {
+			t.Errorf("expected an unbroadened page, got %s", text)
+		}
+		if len(client.searchCalls) != calls+1 {
+			t.Errorf("expected a single search call, got %v", client.searchCalls[calls:])
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// maxSearchSnapshots bounds how many distinct searches keep a prior result set
+const maxSearchSnapshots = 256
+
+// defaultSearchDiffLimit is the result limit search_diff uses unless told otherwise
+const defaultSearchDiffLimit = 100
+
+// searchSnapshot is the pid set a search returned at one point in time
+type searchSnapshot struct {
+	pids      []string // sorted
+	fetchedAt time.Time
+}
+
+// searchSnapshots keeps the last upstream result of each search. Unlike the response
+// cache, entries don't expire, so a later search_diff can compare against them; the
+// oldest entry is dropped once maxSearchSnapshots is reached.
+type searchSnapshots struct {
+	mu      sync.Mutex
+	entries map[string]searchSnapshot
+}
+
+// searchSnapshotKey identifies a search by normalized query and limit
+func searchSnapshotKey(query string, limit int) string {
+	return fmt.Sprintf("%s:%d", strings.ToLower(strings.TrimSpace(query)), limit)
+}
+
+// record stores the result of a fresh search and returns the snapshot it replaced
+func (s *searchSnapshots) record(key string, results []openplantbook.PlantSearchResult, now time.Time) (searchSnapshot, bool) {
+	pids := make([]string, len(results))
+	for i, r := range results {
+		pids[i] = r.PID
+	}
+	sort.Strings(pids)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]searchSnapshot)
+	}
+	previous, ok := s.entries[key]
+	if !ok && len(s.entries) >= maxSearchSnapshots {
+		s.evictOldest()
+	}
+	s.entries[key] = searchSnapshot{pids: pids, fetchedAt: now}
+	return previous, ok
+}
+
+// evictOldest drops the least recently fetched snapshot; the caller holds mu
+func (s *searchSnapshots) evictOldest() {
+	var oldestKey string
+	var oldest time.Time
+	for key, snap := range s.entries {
+		if oldestKey == "" || snap.fetchedAt.Before(oldest) {
+			oldestKey, oldest = key, snap.fetchedAt
+		}
+	}
+	delete(s.entries, oldestKey)
+}
+
+// searchDiff is the search_diff result
+type searchDiff struct {
+	Query             string            `json:"query"`
+	PreviousFetchedAt string            `json:"previous_fetched_at,omitempty"`
+	Baseline          bool              `json:"baseline,omitempty"` // no prior result set; this run recorded one
+	Added             []string          `json:"added"`
+	Removed           []string          `json:"removed"`
+	Summary           searchDiffSummary `json:"summary"`
+}
+
+// searchDiffSummary counts the changes
+type searchDiffSummary struct {
+	Added     int `json:"added"`
+	Removed   int `json:"removed"`
+	Unchanged int `json:"unchanged"`
+	Total     int `json:"total"`
+}
+
+// diffSearchSnapshots compares two sorted pid sets
+func diffSearchSnapshots(previous, current []string) (added, removed []string, unchanged int) {
+	added, removed = []string{}, []string{}
+	i, j := 0, 0
+	for i < len(previous) || j < len(current) {
+		switch {
+		case j == len(current) || (i < len(previous) && previous[i] < current[j]):
+			removed = append(removed, previous[i])
+			i++
+		case i == len(previous) || current[j] < previous[i]:
+			added = append(added, current[j])
+			j++
+		default:
+			unchanged++
+			i++
+			j++
+		}
+	}
+	return added, removed, unchanged
+}
+
+// handleSearchDiff handles the search_diff tool
+func (s *Server) handleSearchDiff(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "search_diff")
+
+	// Extract parameters
+	query, err := request.RequireString("query")
+	if err != nil {
+		logger.Warn("invalid query parameter", "error", err)
+		return mcp.NewToolResultError("query parameter is required and must be a string"), nil
+	}
+	opts := &openplantbook.SearchOptions{Limit: request.GetInt("limit", defaultSearchDiffLimit)}
+
+	logger.Info("diffing search results", "query", query, "limit", opts.Limit)
+
+	// Always go upstream; searchPlants records the fresh result and hands back the prior one
+	results, previous, hadPrevious, err := s.searchPlantsWithSnapshot(withNoCache(ctx), query, opts)
+	if err != nil {
+		logger.Error("search failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+	}
+
+	current := make([]string, len(results))
+	for i, r := range results {
+		current[i] = r.PID
+	}
+	sort.Strings(current)
+
+	diff := searchDiff{Query: query, Baseline: !hadPrevious}
+	var unchanged int
+	if hadPrevious {
+		diff.PreviousFetchedAt = previous.fetchedAt.UTC().Format(time.RFC3339)
+		diff.Added, diff.Removed, unchanged = diffSearchSnapshots(previous.pids, current)
+	} else {
+		diff.Added, diff.Removed, unchanged = []string{}, []string{}, len(current)
+	}
+	diff.Summary = searchDiffSummary{Added: len(diff.Added), Removed: len(diff.Removed), Unchanged: unchanged, Total: len(current)}
+
+	logger.Info("search diff computed", "query", query, "added", diff.Summary.Added, "removed", diff.Summary.Removed, "baseline", diff.Baseline)
+
+	data, err := json.MarshalIndent(diff, "", "  ")
+	if err != nil {
+		logger.Error("marshal diff failed", "error", err)
+		return mcp.NewToolResultError("failed to format search diff"), nil
+	}
+	return mcp.NewToolResultText(string(data)), nil
+}
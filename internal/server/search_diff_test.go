@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestDiffSearchSnapshots(t *testing.T) {
+	added, removed, unchanged := diffSearchSnapshots([]string{"a", "b", "c"}, []string{"b", "c", "d", "e"})
+	if !reflect.DeepEqual(added, []string{"d", "e"}) || !reflect.DeepEqual(removed, []string{"a"}) || unchanged != 2 {
+		t.Errorf("got added=%v removed=%v unchanged=%d", added, removed, unchanged)
+	}
+}
+
+func TestSearchDiff(t *testing.T) {
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{
+		"monstera": {{PID: "monstera deliciosa"}, {PID: "monstera adansonii"}},
+	}}
+	srv := newTestServer(t, client)
+
+	diff := func() searchDiff {
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{"query": "monstera"}
+		result, err := srv.handleSearchDiff(context.Background(), request)
+		if err != nil || result.IsError {
+			t.Fatalf("search_diff failed: %v", err)
+		}
+		var got searchDiff
+		if err := json.Unmarshal([]byte(resultText(t, result)), &got); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		return got
+	}
+
+	first := diff()
+	if !first.Baseline || first.Summary.Total != 2 || len(first.Added) != 0 {
+		t.Errorf("expected a baseline of 2 results, got %+v", first)
+	}
+
+	client.mu.Lock()
+	client.search["monstera"] = []openplantbook.PlantSearchResult{{PID: "monstera deliciosa"}, {PID: "monstera obliqua"}}
+	client.mu.Unlock()
+
+	second := diff()
+	want := searchDiffSummary{Added: 1, Removed: 1, Unchanged: 1, Total: 2}
+	if second.Baseline || second.Summary != want {
+		t.Errorf("summary = %+v, want %+v", second.Summary, want)
+	}
+	if !reflect.DeepEqual(second.Added, []string{"monstera obliqua"}) || !reflect.DeepEqual(second.Removed, []string{"monstera adansonii"}) {
+		t.Errorf("added=%v removed=%v", second.Added, second.Removed)
+	}
+	if second.PreviousFetchedAt == "" {
+		t.Error("expected previous_fetched_at on a real diff")
+	}
+	if len(client.searchCalls) != 2 {
+		t.Errorf("expected both runs to reach the API, got %d calls", len(client.searchCalls))
+	}
+}
+
+func TestSearchSnapshots_EvictsOldest(t *testing.T) {
+	var snaps searchSnapshots
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= maxSearchSnapshots; i++ {
+		snaps.record(searchSnapshotKey("q", i), nil, start.Add(time.Duration(i)*time.Second))
+	}
+	if len(snaps.entries) != maxSearchSnapshots {
+		t.Errorf("expected %d entries, got %d", maxSearchSnapshots, len(snaps.entries))
+	}
+	if _, ok := snaps.entries[searchSnapshotKey("q", 0)]; ok {
+		t.Error("expected the oldest snapshot to be evicted")
+	}
+}
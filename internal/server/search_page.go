@@ -0,0 +1,47 @@
+package server
+
+import (
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// defaultSearchLimit is the search_plants page size when the call doesn't set limit
+	defaultSearchLimit = 10
+
+	// maxSearchLimit caps the search_plants page size; larger limits are clamped to it
+	maxSearchLimit = 100
+)
+
+// searchPage is the search_plants response: one page of results and whether more follow.
+// OpenPlantbook has no offset or total count, so pages are cut from a search for
+// offset+limit+1 results and the extra result only signals has_more.
+type searchPage struct {
+	Results []openplantbook.PlantSearchResult `json:"results"`
+	Offset  int                               `json:"offset"`
+	Limit   int                               `json:"limit"`
+	HasMore bool                              `json:"has_more"`
+
+	// Set when broaden_on_empty found nothing for the exact query and fell back to its genus
+	Broadened     bool   `json:"broadened,omitempty"`
+	OriginalQuery string `json:"original_query,omitempty"`
+	GenusQuery    string `json:"genus_query,omitempty"`
+	Note          string `json:"note,omitempty"`
+}
+
+// searchFetchLimit is how many results to ask OpenPlantbook for to serve a page
+func searchFetchLimit(offset, limit int) int {
+	return offset + limit + 1
+}
+
+// paginateSearch cuts the page at offset from results fetched with searchFetchLimit.
+// Results is never nil, so an empty page marshals as [].
+func paginateSearch(results []openplantbook.PlantSearchResult, offset, limit int) searchPage {
+	page := searchPage{Results: []openplantbook.PlantSearchResult{}, Offset: offset, Limit: limit}
+	if offset >= len(results) {
+		return page
+	}
+	end := min(offset+limit, len(results))
+	page.Results = append(page.Results, results[offset:end]...)
+	page.HasMore = len(results) > end
+	return page
+}
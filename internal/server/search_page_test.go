@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+// searchResults returns n distinct search results
+func searchResults(n int) []openplantbook.PlantSearchResult {
+	results := make([]openplantbook.PlantSearchResult, n)
+	for i := range results {
+		results[i] = openplantbook.PlantSearchResult{PID: fmt.Sprintf("plant %d", i)}
+	}
+	return results
+}
+
+func TestPaginateSearch(t *testing.T) {
+	results := searchResults(5)
+
+	tests := []struct {
+		name          string
+		offset, limit int
+		want          []string
+		hasMore       bool
+	}{
+		{"first page", 0, 2, []string{"plant 0", "plant 1"}, true},
+		{"middle page", 2, 2, []string{"plant 2", "plant 3"}, true},
+		{"last page", 4, 2, []string{"plant 4"}, false},
+		{"exact fit", 3, 2, []string{"plant 3", "plant 4"}, false},
+		{"past the end", 10, 2, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := paginateSearch(results, tt.offset, tt.limit)
+			var got []string
+			for _, r := range page.Results {
+				got = append(got, r.PID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") || page.HasMore != tt.hasMore {
+				t.Errorf("page = %v (has_more %v), want %v (has_more %v)", got, page.HasMore, tt.want, tt.hasMore)
+			}
+			if page.Results == nil {
+				t.Error("results should be an empty slice, not nil")
+			}
+		})
+	}
+}
+
+func TestHandleSearchPlants_Pagination(t *testing.T) {
+	client := &fakeClient{search: map[string][]openplantbook.PlantSearchResult{"ficus": searchResults(25)}}
+	srv := newTestServer(t, client)
+
+	search := func(args map[string]interface{}) (*mcp.CallToolResult, searchPage) {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = args
+		result, err := srv.handleSearchPlants(context.Background(), request)
+		if err != nil {
+			t.Fatalf("handleSearchPlants() error = %v", err)
+		}
+		var page searchPage
+		if !result.IsError {
+			if err := json.Unmarshal([]byte(resultText(t, result)), &page); err != nil {
+				t.Fatalf("decode page: %v", err)
+			}
+		}
+		return result, page
+	}
+
+	t.Run("pages without duplicates", func(t *testing.T) {
+		seen := map[string]bool{}
+		offset, pages := 0, 0
+		for {
+			_, page := search(map[string]interface{}{"query": "ficus", "limit": 10.0, "offset": float64(offset)})
+			if page.Offset != offset || page.Limit != 10 {
+				t.Fatalf("page offset/limit = %d/%d, want %d/10", page.Offset, page.Limit, offset)
+			}
+			for _, r := range page.Results {
+				if seen[r.PID] {
+					t.Errorf("duplicate result %q on page at offset %d", r.PID, offset)
+				}
+				seen[r.PID] = true
+			}
+			pages++
+			if !page.HasMore {
+				break
+			}
+			offset += page.Limit
+		}
+		if pages != 3 || len(seen) != 25 {
+			t.Errorf("got %d pages covering %d plants, want 3 pages covering 25", pages, len(seen))
+		}
+	})
+
+	t.Run("limit is capped", func(t *testing.T) {
+		_, page := search(map[string]interface{}{"query": "ficus", "limit": 500.0})
+		if page.Limit != maxSearchLimit || len(page.Results) != 25 || page.HasMore {
+			t.Errorf("page = limit %d, %d results, has_more %v", page.Limit, len(page.Results), page.HasMore)
+		}
+	})
+
+	for _, tt := range []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+	}{
+		{"negative offset", map[string]interface{}{"query": "ficus", "offset": -1.0}, "offset must not be negative"},
+		{"zero limit", map[string]interface{}{"query": "ficus", "limit": 0.0}, "limit must be at least 1"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := search(tt.args)
+			if text := resultText(t, result); !result.IsError || !strings.Contains(text, tt.wantErr) {
+				t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import "fmt"
+
+// Defaults for the sensor-not-in-soil heuristic
+const (
+	defaultSensorInAirMoistureMax = 2.0  // % soil moisture at or below which a reading is suspicious
+	defaultSensorInAirDropMin     = 25.0 // percentage points lost between consecutive samples
+	defaultSensorInAirECMax       = 10.0 // µS/cm; probes read near zero EC in air
+)
+
+// sensorInAirThresholds configures detectSensorInAir
+type sensorInAirThresholds struct {
+	moistureMax float64
+	dropMin     float64
+	ecMax       float64
+}
+
+// sensorInAirCheck is the outcome of the sensor placement heuristic
+type sensorInAirCheck struct {
+	moisture   float64  // latest moisture reading
+	suspicious bool     // moisture is at or below the threshold
+	signals    []string // corroborating evidence; any signal makes the verdict "likely"
+}
+
+// likely reports whether the reading is better explained by a dislodged sensor than by drought
+func (c sensorInAirCheck) likely() bool {
+	return c.suspicious && len(c.signals) > 0
+}
+
+// detectSensorInAir looks at raw (unaggregated) conditions for signs that the moisture
+// probe is reading air. Soil rarely reaches ~0% on its own, and when it does it dries
+// gradually; a sudden plunge to zero, or a near-zero EC alongside it, points at the sensor.
+// The zero check (not suspicious) is returned when there is no moisture reading.
+func detectSensorInAir(conditions map[string]interface{}, t sensorInAirThresholds) sensorInAirCheck {
+	var samples []float64
+	switch v := conditions["moisture"].(type) {
+	case float64:
+		samples = []float64{v}
+	case []interface{}:
+		for _, item := range v {
+			if f, ok := item.(float64); ok {
+				samples = append(samples, f)
+			}
+		}
+	}
+	if len(samples) == 0 {
+		return sensorInAirCheck{}
+	}
+
+	check := sensorInAirCheck{moisture: samples[len(samples)-1]}
+	check.suspicious = check.moisture <= t.moistureMax
+	if !check.suspicious {
+		return check
+	}
+
+	for i := 1; i < len(samples); i++ {
+		if drop := samples[i-1] - samples[i]; drop >= t.dropMin {
+			check.signals = append(check.signals, fmt.Sprintf("moisture fell %.0f points between consecutive samples (%.1f%% → %.1f%%); soil dries gradually", drop, samples[i-1], samples[i]))
+			break
+		}
+	}
+
+	if ec, ok := conditions["soil_ec"].(float64); ok && ec <= t.ecMax {
+		check.signals = append(check.signals, fmt.Sprintf("soil EC is %.0f µS/cm, which is what probes read in open air", ec))
+	}
+
+	return check
+}
+
+// formatSensorInAirWarning explains a suspicious moisture reading
+func formatSensorInAirWarning(check sensorInAirCheck) string {
+	if check.likely() {
+		output := "## ⚠️ Check Sensor Placement\n\n"
+		output += fmt.Sprintf("Soil moisture reads %.1f%%, but this looks like a sensor that is no longer in the soil rather than a drought:\n\n", check.moisture)
+		for _, signal := range check.signals {
+			output += fmt.Sprintf("- %s\n", signal)
+		}
+		output += "\nPush the probe back into the soil up to its marked line, wait a few minutes, then take a new reading before watering.\n"
+		return output
+	}
+	return fmt.Sprintf("_Soil moisture of %.1f%% is unusually low. If the soil feels damp, the sensor may have slipped out of the pot._\n", check.moisture)
+}
+
+// withoutProbeReadings returns a copy of conditions with the moisture and EC readings
+// removed, as a probe out of the soil misreads both
+func withoutProbeReadings(conditions map[string]interface{}) map[string]interface{} {
+	filtered := make(map[string]interface{}, len(conditions))
+	for key, value := range conditions {
+		if key != "moisture" && key != "soil_ec" {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// sensorInAirThresholds returns the configured heuristic thresholds, using defaults for unset values
+func (s *Server) sensorInAirThresholds() sensorInAirThresholds {
+	t := sensorInAirThresholds{
+		moistureMax: defaultSensorInAirMoistureMax,
+		dropMin:     defaultSensorInAirDropMin,
+		ecMax:       defaultSensorInAirECMax,
+	}
+	if s.config.SensorInAirMoistureMax > 0 {
+		t.moistureMax = s.config.SensorInAirMoistureMax
+	}
+	if s.config.SensorInAirDropMin > 0 {
+		t.dropMin = s.config.SensorInAirDropMin
+	}
+	if s.config.SensorInAirECMax > 0 {
+		t.ecMax = s.config.SensorInAirECMax
+	}
+	return t
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestDetectSensorInAir(t *testing.T) {
+	thresholds := sensorInAirThresholds{
+		moistureMax: defaultSensorInAirMoistureMax,
+		dropMin:     defaultSensorInAirDropMin,
+		ecMax:       defaultSensorInAirECMax,
+	}
+
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		suspicious bool
+		likely     bool
+	}{
+		{"no moisture reading", map[string]interface{}{"temperature": 20.0}, false, false},
+		{"normal reading", map[string]interface{}{"moisture": 35.0}, false, false},
+		{"near zero alone", map[string]interface{}{"moisture": 1.0}, true, false},
+		{"sudden drop", map[string]interface{}{"moisture": []interface{}{42.0, 40.0, 0.5}}, true, true},
+		{"gradual drying", map[string]interface{}{"moisture": []interface{}{20.0, 12.0, 6.0, 1.5}}, true, false},
+		{"zero ec", map[string]interface{}{"moisture": 0.0, "soil_ec": 0.0}, true, true},
+		{"ec present in soil", map[string]interface{}{"moisture": 0.0, "soil_ec": 450.0}, true, false},
+		{"drop but latest recovered", map[string]interface{}{"moisture": []interface{}{40.0, 0.0, 38.0}}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := detectSensorInAir(tt.conditions, thresholds)
+			if check.suspicious != tt.suspicious {
+				t.Errorf("suspicious = %v, want %v", check.suspicious, tt.suspicious)
+			}
+			if check.likely() != tt.likely {
+				t.Errorf("likely = %v, want %v (signals %v)", check.likely(), tt.likely, check.signals)
+			}
+		})
+	}
+
+	t.Run("thresholds are configurable", func(t *testing.T) {
+		strict := sensorInAirThresholds{moistureMax: 0.5, dropMin: 50, ecMax: 1}
+		check := detectSensorInAir(map[string]interface{}{"moisture": []interface{}{40.0, 1.0}}, strict)
+		if check.suspicious {
+			t.Error("1% should not be suspicious with a 0.5% threshold")
+		}
+		loose := sensorInAirThresholds{moistureMax: 5, dropMin: 10, ecMax: 1}
+		check = detectSensorInAir(map[string]interface{}{"moisture": []interface{}{15.0, 4.0}}, loose)
+		if !check.likely() {
+			t.Error("an 11-point drop to 4% should be likely with loose thresholds")
+		}
+	})
+}
+
+func TestHandleCompareConditions_SensorInAir(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", Alias: "Monstera", MinSoilMoist: 15, MaxSoilMoist: 60, MinTemp: 12, MaxTemp: 32},
+	}}
+	srv := newTestServer(t, client)
+
+	compare := func(conditions map[string]interface{}) string {
+		t.Helper()
+		result, err := srv.handleCompareConditions(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "compare_conditions", Arguments: map[string]interface{}{
+				"pid": "monstera deliciosa", "current_conditions": conditions,
+			}},
+		})
+		if err != nil || result.IsError {
+			t.Fatalf("handleCompareConditions() = %v, %v", result, err)
+		}
+		return resultText(t, result)
+	}
+
+	text := compare(map[string]interface{}{"moisture": 0.0, "soil_ec": 0.0, "temperature": 20.0})
+	if !strings.Contains(text, "Check Sensor Placement") {
+		t.Errorf("expected sensor placement warning, got:\n%s", text)
+	}
+	if strings.Contains(text, "Soil Moisture Too Low") {
+		t.Errorf("drought alert should be replaced by the sensor warning, got:\n%s", text)
+	}
+	if strings.Contains(text, "Soil EC") {
+		t.Errorf("the probe's EC reading in air should not be compared, got:\n%s", text)
+	}
+
+	text = compare(map[string]interface{}{"moisture": 1.0})
+	if !strings.Contains(text, "Soil Moisture Too Low") || !strings.Contains(text, "slipped out of the pot") {
+		t.Errorf("uncorroborated low reading should keep the alert and add a hint, got:\n%s", text)
+	}
+}
@@ -0,0 +1,175 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// sensorNames maps a baseline metric key to the sensor that measures it
+var sensorNames = map[string]string{
+	"light_lux":   "light (lux) meter",
+	"temperature": "thermometer",
+	"humidity":    "hygrometer",
+	"moisture":    "soil moisture probe",
+	"soil_ec":     "EC (conductivity) probe",
+}
+
+// sensorPriority ranks how worthwhile a sensor is for a plant
+type sensorPriority int
+
+const (
+	sensorOptional sensorPriority = iota
+	sensorUseful
+	sensorEssential
+)
+
+// String returns the priority label
+func (p sensorPriority) String() string {
+	switch p {
+	case sensorEssential:
+		return "Essential"
+	case sensorUseful:
+		return "Useful"
+	}
+	return "Optional"
+}
+
+// Thresholds for sensor priority. widthRatio is the plant's range width divided by the
+// baseline width (smaller is tighter); shift is the baseline delta (larger is more unusual).
+const (
+	sensorEssentialWidthRatio = 0.75
+	sensorUsefulWidthRatio    = 1.25
+	sensorEssentialShift      = 2 * baselineSimilarFraction
+)
+
+// sensorAdvice is the recommendation for one metric's sensor
+type sensorAdvice struct {
+	metric     baselineMetric
+	min, max   float64
+	widthRatio float64
+	delta      baselineDelta
+	priority   sensorPriority
+	score      float64 // higher sorts first
+}
+
+// rankSensors scores each metric the plant has data for by range tightness and by how far
+// its needs depart from a typical houseplant, most important first. Ties keep display order.
+func rankSensors(details *openplantbook.PlantDetails, baseline map[string]valueRange) []sensorAdvice {
+	deltas := map[string]baselineDelta{}
+	for _, d := range computeBaselineDeltas(details, baseline) {
+		deltas[d.metric.key] = d
+	}
+
+	var advice []sensorAdvice
+	for _, m := range baselineMetrics {
+		delta, ok := deltas[m.key]
+		if !ok {
+			continue
+		}
+		min, max, _ := m.ideal(details)
+		base := baseline[m.key]
+
+		a := sensorAdvice{metric: m, min: min, max: max, delta: delta}
+		a.widthRatio = (max - min) / (base.max - base.min)
+		shift := math.Abs(delta.shift)
+
+		switch {
+		case a.widthRatio < sensorEssentialWidthRatio || shift > sensorEssentialShift:
+			a.priority = sensorEssential
+		case a.widthRatio < sensorUsefulWidthRatio || shift > baselineSimilarFraction:
+			a.priority = sensorUseful
+		default:
+			a.priority = sensorOptional
+		}
+		// Tightness and unusualness both raise the score; a zero-width range is maximally tight
+		a.score = shift + 1/math.Max(a.widthRatio, 0.01)
+		advice = append(advice, a)
+	}
+
+	sort.SliceStable(advice, func(i, j int) bool {
+		if advice[i].priority != advice[j].priority {
+			return advice[i].priority > advice[j].priority
+		}
+		return advice[i].score > advice[j].score
+	})
+	return advice
+}
+
+// sensorReason explains why a sensor got its priority
+func sensorReason(a sensorAdvice) string {
+	reason := fmt.Sprintf("range %g - %g is %.0f%% as wide as a typical houseplant's", a.min, a.max, a.widthRatio*100)
+	if math.Abs(a.delta.shift) > baselineSimilarFraction {
+		reason += "; it " + a.delta.describe()
+	}
+	switch a.priority {
+	case sensorEssential:
+		return fmt.Sprintf("matters a lot for this plant (%s). Worth a dedicated %s.", reason, sensorNames[a.metric.key])
+	case sensorUseful:
+		return fmt.Sprintf("worth watching (%s). A basic %s is enough.", reason, sensorNames[a.metric.key])
+	}
+	return fmt.Sprintf("forgiving (%s). Occasional spot checks or a cheap %s suffice.", reason, sensorNames[a.metric.key])
+}
+
+// formatSensorRecommendation renders the ranked sensors
+func formatSensorRecommendation(details *openplantbook.PlantDetails, advice []sensorAdvice) string {
+	output := fmt.Sprintf("# Sensor Recommendation for %s (%s)\n\n", details.Alias, details.DisplayPID)
+
+	recommended := map[string]bool{}
+	for i, a := range advice {
+		output += fmt.Sprintf("%d. **%s** - %s: %s\n", i+1, a.priority, a.metric.label, sensorReason(a))
+		recommended[a.metric.key] = a.priority != sensorOptional
+	}
+
+	if recommended["temperature"] && recommended["humidity"] {
+		output += "\nTip: a combined thermo-hygrometer covers both temperature and humidity.\n"
+	}
+
+	var missing []string
+	for _, m := range baselineMetrics {
+		if _, _, ok := m.ideal(details); !ok {
+			missing = append(missing, m.label)
+		}
+	}
+	if len(missing) > 0 {
+		output += fmt.Sprintf("\n_No data for: %s, so no recommendation is made for those sensors._\n", joinOrNone(missing))
+	}
+
+	return output
+}
+
+// handleSensorRecommendation handles the sensor_recommendation tool
+func (s *Server) handleSensorRecommendation(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "sensor_recommendation")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	logger.Info("recommending sensors", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	advice := rankSensors(details, s.baselineProfile())
+
+	logger.Info("sensors ranked", "pid", details.PID, "metrics", len(advice))
+
+	return mcp.NewToolResultText(formatSensorRecommendation(details, advice)), nil
+}
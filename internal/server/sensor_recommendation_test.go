@@ -0,0 +1,59 @@
+package server
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestRankSensors(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias:       "Calathea",
+		MinEnvHumid: 60, MaxEnvHumid: 80, // typical width but far above average: essential
+		MinSoilMoist: 20, MaxSoilMoist: 60, // same as baseline: useful
+		MinLightLux: 2000, MaxLightLux: 20000, // wide and near average: optional
+		MinTemp: 15, MaxTemp: 30, // wide and near average: optional
+	}
+
+	advice := rankSensors(details, defaultBaselineProfile)
+
+	var order []string
+	var priorities []sensorPriority
+	for _, a := range advice {
+		order = append(order, a.metric.key)
+		priorities = append(priorities, a.priority)
+	}
+
+	expectedOrder := []string{"humidity", "moisture", "light_lux", "temperature"}
+	if !reflect.DeepEqual(order, expectedOrder) {
+		t.Errorf("order = %v, want %v", order, expectedOrder)
+	}
+	expectedPriorities := []sensorPriority{sensorEssential, sensorUseful, sensorOptional, sensorOptional}
+	if !reflect.DeepEqual(priorities, expectedPriorities) {
+		t.Errorf("priorities = %v, want %v", priorities, expectedPriorities)
+	}
+
+	t.Run("narrow range is essential", func(t *testing.T) {
+		narrow := &openplantbook.PlantDetails{MinTemp: 20, MaxTemp: 24}
+		advice := rankSensors(narrow, defaultBaselineProfile)
+		if len(advice) != 1 || advice[0].priority != sensorEssential {
+			t.Errorf("expected an essential thermometer, got %+v", advice)
+		}
+	})
+
+	t.Run("output", func(t *testing.T) {
+		output := formatSensorRecommendation(details, advice)
+		for _, want := range []string{
+			"1. **Essential** - Humidity: matters a lot for this plant",
+			"needs more humidity than average",
+			"cheap thermometer suffice",
+			"_No data for: Fertilizer (EC)",
+		} {
+			if !strings.Contains(output, want) {
+				t.Errorf("expected %q in output:\n%s", want, output)
+			}
+		}
+	})
+}
@@ -3,22 +3,77 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 	"github.com/rmrfslashbin/openplantbook-go"
 	"github.com/rs/xid"
+	"golang.org/x/time/rate"
 )
 
+// defaultServerName is the server name advertised to MCP clients unless overridden
+const defaultServerName = "openplantbook-mcp"
+
+// plantClient is the subset of the OpenPlantbook SDK used by the server
+type plantClient interface {
+	SearchPlants(ctx context.Context, query string, opts *openplantbook.SearchOptions) ([]openplantbook.PlantSearchResult, error)
+	GetPlantDetails(ctx context.Context, pid string, opts *openplantbook.DetailOptions) (*openplantbook.PlantDetails, error)
+}
+
 // Server implements the MCP server for OpenPlantbook
 type Server struct {
-	client  *openplantbook.Client
 	logger  *slog.Logger
 	config  *Config
 	version string
+
+	// client is built on first use by apiClient; tests may set it directly
+	client     plantClient
+	newClient  func() (plantClient, error)
+	clientOnce sync.Once
+	clientErr  error
+
+	// toolCount is the number of tools registered with the MCP server
+	toolCount int
+
+	// cache holds API responses; nil when caching is disabled
+	cache *responseCache
+
+	// suggestCache holds autocomplete suggestions with a short TTL; nil when caching is disabled
+	suggestCache *responseCache
+
+	// missingCache remembers pids the API reported as not found; nil when caching is disabled
+	missingCache *responseCache
+
+	// validators holds ETag and Last-Modified validators with the response bodies they
+	// revalidate; nil when caching is disabled
+	validators *responseCache
+
+	// fileCache persists plant details across restarts; nil unless cache_backend is "file"
+	fileCache *fileCache
+
+	// limiter budgets outbound API calls across every tool; nil when unlimited
+	limiter *rate.Limiter
+
+	// usage counts upstream API calls since startup
+	usage apiUsage
+
+	// pins maps pin_plant tokens to pids
+	pins pinStore
+
+	// searchHistory keeps the last upstream result of each search for search_diff
+	searchHistory searchSnapshots
+
+	// imported holds care profiles loaded with import_bundle
+	imported importedProfiles
 }
 
 // New creates a new MCP server instance
@@ -36,16 +91,22 @@ func New(config *Config, version string) (*Server, error) {
 		}
 	}
 
-	// Set up structured logging
-	logger := slog.New(slog.NewJSONHandler(logWriter, &slog.HandlerOptions{
+	// Set up structured logging, with credentials masked in every record
+	handler := slog.NewJSONHandler(logWriter, &slog.HandlerOptions{
 		Level: config.LogLevel,
-	})).With(
+	})
+	logger := slog.New(newRedactingHandler(handler, config.APIKey, config.ClientSecret, config.ServerAuthToken)).With(
 		"trace_id", traceID,
 		"service", "openplantbook-mcp",
 		"version", version,
 		"pid", os.Getpid(),
 	)
 
+	// Catch malformed credentials before handing them to the SDK
+	if err := validateCredentials(config); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrAuthConfig, err)
+	}
+
 	// Determine authentication method
 	var opts []openplantbook.Option
 	if config.APIKey != "" {
@@ -56,42 +117,140 @@ func New(config *Config, version string) (*Server, error) {
 		opts = append(opts, openplantbook.WithOAuth2(config.ClientID, config.ClientSecret))
 	}
 
-	// Disable rate limiting for MCP server usage
-	// The MCP server handles its own rate limiting via request throttling
+	// Disable the SDK's rate limiting; the server applies rate_limit_per_minute itself,
+	// shared across every tool
 	opts = append(opts, openplantbook.DisableRateLimit())
 	logger.Info("rate limiting disabled for MCP server")
+	if err := validateRateLimitMode(config.RateLimitMode); err != nil {
+		return nil, err
+	}
 
-	// Create OpenPlantbook SDK client
-	client, err := openplantbook.New(opts...)
-	if err != nil {
-		return nil, fmt.Errorf("create openplantbook client: %w", err)
+	// Caching is handled by the server so it honors CacheTTL and per-request
+	// bypass; the SDK's own cache would otherwise serve stale data underneath
+	opts = append(opts, openplantbook.WithCache(openplantbook.NewNoOpCache()))
+
+	// The server's own HTTP client sees response headers, so cache entries follow the
+	// API's Cache-Control max-age and expired entries are revalidated with ETags
+	transport := &cacheHeaderTransport{next: http.DefaultTransport}
+	if config.CacheEnabled {
+		transport.validators = newResponseCache(validatorCacheTTL, max(config.CacheMaxEntries, 0))
+	}
+	opts = append(opts, openplantbook.WithHTTPClient(newAPIHTTPClient(config, transport)))
+
+	// Resolve an empty default language once, so lookups never send an empty language
+	config.DefaultLang = strings.TrimSpace(config.DefaultLang)
+	if config.DefaultLang == "" {
+		logger.Warn("no default language configured, falling back", "language", fallbackLanguage)
+		config.DefaultLang = fallbackLanguage
+	}
+
+	srv := &Server{
+		logger:     logger,
+		config:     config,
+		version:    version,
+		validators: transport.validators,
+	}
+
+	// The SDK client is created on first use so offline modes such as
+	// -validate-config don't need one; serving calls Ping to fail fast
+	srv.newClient = func() (plantClient, error) {
+		client, err := openplantbook.New(opts...)
+		if err != nil {
+			return nil, classifyClientError(err)
+		}
+		logger.Info("openplantbook client created successfully")
+		return client, nil
+	}
+	srv.usage.started = time.Now()
+
+	if srv.limiter = newRateLimiter(config.RateLimitPerMinute); srv.limiter != nil {
+		logger.Info("outbound rate limit enabled", "per_minute", config.RateLimitPerMinute, "mode", config.RateLimitMode)
+	}
+
+	if config.CacheEnabled {
+		maxEntries := srv.cacheMaxEntries()
+		srv.cache = newResponseCache(time.Duration(config.CacheTTL)*time.Hour, maxEntries)
+		logger.Info("response cache enabled", "ttl_hours", config.CacheTTL, "max_entries", maxEntries)
+
+		srv.suggestCache = newResponseCache(autocompleteCacheTTL, maxEntries)
+		srv.missingCache = newResponseCache(missingPlantCacheTTL, maxEntries)
+
+		switch config.CacheBackend {
+		case "", cacheBackendMemory:
+		case cacheBackendFile:
+			fc, err := newFileCache(config.CacheDir, time.Duration(config.CacheTTL)*time.Hour)
+			if err != nil {
+				return nil, fmt.Errorf("file cache: %w", err)
+			}
+			srv.fileCache = fc
+			logger.Info("file cache enabled", "dir", config.CacheDir)
+		default:
+			return nil, fmt.Errorf("cache_backend must be one of: %s", strings.Join(cacheBackends, ", "))
+		}
 	}
 
-	logger.Info("openplantbook client created successfully")
+	return srv, nil
+}
+
+// apiClient returns the SDK client, creating it on first use.
+// Concurrent callers share a single construction attempt and its error.
+func (s *Server) apiClient() (plantClient, error) {
+	s.clientOnce.Do(func() {
+		if s.client == nil {
+			s.client, s.clientErr = s.newClient()
+		}
+		if s.clientErr == nil && s.limiter != nil {
+			s.client = &rateLimitedClient{next: s.client, limiter: s.limiter, reject: s.config.RateLimitMode == rateLimitModeReject}
+		}
+	})
+	return s.client, s.clientErr
+}
+
+// Ping creates the API client if needed, so credential and initialization problems
+// surface at startup rather than on the first tool call. It does not contact the API.
+func (s *Server) Ping() error {
+	_, err := s.apiClient()
+	return err
+}
 
-	return &Server{
-		client:  client,
-		logger:  logger,
-		config:  config,
-		version: version,
-	}, nil
+// classifyClientError wraps an SDK construction error so callers can tell
+// credential problems apart from transport/initialization failures
+func classifyClientError(err error) error {
+	var configErr *openplantbook.ConfigError
+	if errors.Is(err, openplantbook.ErrNoAuthProvided) ||
+		errors.Is(err, openplantbook.ErrMultipleAuthMethods) ||
+		errors.As(err, &configErr) {
+		return fmt.Errorf("%w: %w", ErrAuthConfig, err)
+	}
+	return fmt.Errorf("%w: %w", ErrClientInit, err)
 }
 
-// Run starts the MCP server using stdio transport
+// Run starts the MCP server on the configured transport: stdio by default, or HTTP/SSE,
+// which shuts down gracefully when ctx is cancelled
 func (s *Server) Run(ctx context.Context) error {
-	s.logger.Info("starting openplantbook-mcp server")
+	s.logger.Info("starting openplantbook-mcp server", "transport", s.transport())
+
+	if err := s.validateTransport(); err != nil {
+		return err
+	}
+
+	// Fail fast on client problems instead of on the first tool call
+	if err := s.Ping(); err != nil {
+		return fmt.Errorf("create api client: %w", err)
+	}
 
 	// Create MCP server
-	mcpServer := server.NewMCPServer(
-		"openplantbook-mcp",
-		s.version,
-		server.WithToolCapabilities(true),
-	)
+	mcpServer := s.newMCPServer()
 
 	// Register all tools
 	if err := s.registerTools(mcpServer); err != nil {
 		return fmt.Errorf("register tools: %w", err)
 	}
+	s.registerResources(mcpServer)
+
+	if s.transport() == transportHTTP {
+		return s.serveHTTP(ctx, mcpServer)
+	}
 
 	// Start stdio transport
 	s.logger.Info("starting stdio server")
@@ -102,134 +261,1454 @@ func (s *Server) Run(ctx context.Context) error {
 	return nil
 }
 
-// registerTools registers all MCP tools
+// serverName returns the name advertised to MCP clients, falling back to the default
+func (s *Server) serverName() string {
+	if s.config.ServerName == "" {
+		return defaultServerName
+	}
+	return s.config.ServerName
+}
+
+// newMCPServer creates the mcp-go server with the configured name and instructions
+func (s *Server) newMCPServer() *server.MCPServer {
+	opts := []server.ServerOption{server.WithToolCapabilities(true), server.WithResourceCapabilities(false, false)}
+	if s.config.ServerInstructions != "" {
+		opts = append(opts, server.WithInstructions(s.config.ServerInstructions))
+	}
+
+	return server.NewMCPServer(s.serverName(), s.version, opts...)
+}
+
+// toolAccess is the API access level a tool needs
+type toolAccess int
+
+const (
+	// readAccess tools work with both API key and OAuth2 authentication
+	readAccess toolAccess = iota
+	// writeAccess tools modify OpenPlantbook data and need OAuth2
+	writeAccess
+)
+
+// addTool registers a tool unless its access level exceeds the configured auth tier.
+// API-key auth is read-only, so write tools are only exposed with OAuth2.
+// Argument keys are normalized against the tool's schema before the handler runs,
+// and deprecated parameters are flagged in the schema and in results.
+func (s *Server) addTool(mcpServer *server.MCPServer, access toolAccess, tool mcp.Tool, handler server.ToolHandlerFunc) {
+	if access == writeAccess && getAuthMethod(s.config) != "oauth2" {
+		s.logger.Debug("skipping write tool for read-only auth tier", "tool", tool.Name)
+		return
+	}
+
+	deprecations := deprecatedParameters[tool.Name]
+	markDeprecatedProperties(&tool, deprecations)
+	handler = s.withDeprecations(tool.Name, deprecations, handler)
+
+	if beginnerModeTools[tool.Name] {
+		addBeginnerModeProperty(&tool)
+	}
+
+	handler = s.withTiming(tool.Name, handler)
+
+	mcpServer.AddTool(tool, s.withTrace(withNormalizedArguments(tool.InputSchema.Properties, handler)))
+}
+
+// registerTools registers all MCP tools available to the configured auth tier
 func (s *Server) registerTools(mcpServer *server.MCPServer) error {
+	tier := getAuthMethod(s.config)
+	s.logger.Info("detected auth tier", "auth_method", tier, "write_tools", tier == "oauth2")
+
 	// Tool 1: search_plants
 	searchPlantsSchema := mcp.ToolInputSchema{
 		Type: "object",
 		Properties: map[string]interface{}{
-			"query": map[string]interface{}{
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant name to search for (common or scientific name)",
+			},
+			"limit": map[string]interface{}{
+				"type":        "number",
+				"description": fmt.Sprintf("Results per page (optional, default: %d, max: %d)", defaultSearchLimit, maxSearchLimit),
+				"minimum":     1,
+				"maximum":     maxSearchLimit,
+			},
+			"offset": map[string]interface{}{
+				"type":        "number",
+				"description": "Number of results to skip, for paging through large result sets (optional, default: 0). Use offset + limit of the previous page while has_more is true",
+				"minimum":     0,
+			},
+			"no_cache": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Skip the cache and fetch fresh results (the cache is still updated)",
+			},
+			"broaden_on_empty": map[string]interface{}{
+				"type":        "boolean",
+				"description": "When nothing matches, retry with just the first word (the genus) and return those results labeled as broader genus matches (default: false)",
+			},
+		},
+		Required: []string{"query"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "search_plants",
+		Description: "Search for plants by common name or scientific name in the OpenPlantbook database. Returns a page of results with offset, limit and has_more; page through large genera with offset",
+		InputSchema: searchPlantsSchema,
+	}, s.handleSearchPlants)
+
+	// Tool 2: get_plant_care
+	getPlantCareSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Preferred language code (e.g., 'en', 'de', 'es'), optional",
+			},
+			"no_cache": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Skip the cache and fetch fresh data (the cache is still updated)",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "get_plant_care",
+		Description: "Get detailed care requirements for a specific plant including moisture, temperature, light, and humidity ranges",
+		InputSchema: getPlantCareSchema,
+	}, s.handleGetPlantCare)
+
+	// Tool 3: get_care_summary
+	getCareSummarySchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+			"metric": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Use metric units (default: true)",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Preferred language code for plant data (e.g., 'en', 'de', 'es'), optional; defaults to the configured language",
+			},
+			"interpretation_lang": map[string]interface{}{
+				"type":        "string",
+				"description": "Language for the interpretive text (default: 'en', currently the only translation). When it differs from the plant data language, data and interpretation are shown in separate sections",
+			},
+			"compare_to_baseline": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Add a section describing how this plant differs from a typical houseplant (default: false)",
+			},
+			"include_table": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Append a markdown table of every metric's min/max and units after the prose (default: false)",
+			},
+			"dual_units": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Show temperature in both °C and °F and light in foot-candles alongside lux, for mixed audiences (default: false)",
+			},
+			"profile": map[string]interface{}{
+				"type":        "string",
+				"enum":        summaryProfiles,
+				"description": "Output profile: 'full' shows every range (default); 'beginner' shows only plain-language tips (when to water, where to place it, room temperature) with no lux or EC numbers. The beginner profile ignores include_table, dual_units, compare_to_baseline and max_summary_chars",
+			},
+			"max_summary_chars": map[string]interface{}{
+				"type":        "integer",
+				"description": "Cap the summary at this many characters by dropping whole sections, least important first (image link, then tables and notes, then temperature/humidity/fertilizer); light and watering are kept longest. 0 means unlimited (default: 0)",
+				"minimum":     0,
+			},
+			"include_footer": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Append a footer noting the data source, when the data was fetched and the language used (default: false)",
+			},
+			"format": map[string]interface{}{
+				"type":        "string",
+				"enum":        summaryFormats,
+				"description": "Output format: 'markdown' prose (default) or 'json', an object with min, max, unit and interpretation for each care band. JSON honors metric and language and ignores the other formatting options",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "get_care_summary",
+		Description: "Get a human-readable summary of plant care requirements with interpreted ranges",
+		InputSchema: getCareSummarySchema,
+	}, s.handleGetCareSummary)
+
+	// Tool 4: compare_conditions
+	compareConditionsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Current sensor readings",
+				"properties": map[string]interface{}{
+					"moisture": map[string]interface{}{
+						"type":        []string{"number", "array"},
+						"items":       map[string]interface{}{"type": "number"},
+						"description": "Current soil moisture percentage (0-100) (a single reading or an array of samples, oldest first)",
+					},
+					"temperature": map[string]interface{}{
+						"type":        []string{"number", "array"},
+						"items":       map[string]interface{}{"type": "number"},
+						"description": "Current temperature in °C, or °F when metric is false (a single reading or an array of samples, oldest first)",
+					},
+					"light_lux": map[string]interface{}{
+						"type":        []string{"number", "array"},
+						"items":       map[string]interface{}{"type": "number"},
+						"description": "Current light level in lux (a single reading or an array of samples, oldest first)",
+					},
+					"humidity": map[string]interface{}{
+						"type":        []string{"number", "array"},
+						"items":       map[string]interface{}{"type": "number"},
+						"description": "Current humidity percentage (0-100) (a single reading or an array of samples, oldest first)",
+					},
+					"soil_ec": map[string]interface{}{
+						"type":        []string{"number", "array"},
+						"items":       map[string]interface{}{"type": "number"},
+						"description": "Current soil EC (fertilizer) in µS/cm (a single reading or an array of samples, oldest first)",
+					},
+				},
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Preferred language code for plant data (e.g., 'en', 'de', 'es'), optional; defaults to the configured language",
+			},
+			"metric": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Temperature in °C (default: true); false for °F input and output",
+			},
+			"aggregation": map[string]interface{}{
+				"type":        "string",
+				"enum":        aggregationMethods,
+				"description": "How to reduce arrays of samples: mean, median (robust to spikes) or latest (default: configured aggregation, normally mean)",
+			},
+			"settling_minutes": map[string]interface{}{
+				"type":        "number",
+				"description": "Optional grace period after watering or moving the plant; out-of-range moisture and humidity readings inside it are reported as informational instead of alerts. Requires reading_age_minutes",
+			},
+			"reading_age_minutes": map[string]interface{}{
+				"type":        "number",
+				"description": "Minutes between the watering or move and the reading, used with settling_minutes",
+			},
+		},
+		Required: []string{"pid", "current_conditions"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "compare_conditions",
+		Description: "Compare actual sensor readings against ideal plant care ranges and identify issues",
+		InputSchema: compareConditionsSchema,
+	}, s.handleCompareConditions)
+
+	// Tool 5: server_info
+	serverInfoSchema := mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{},
+		Required:   []string{},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "server_info",
+		Description: "Get server version, build information, and runtime status",
+		InputSchema: serverInfoSchema,
+	}, s.handleServerInfo)
+
+	// Tool 6: care_diff_report
+	careDiffReportSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid_a": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) of the first plant",
+			},
+			"pid_b": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) of the second plant",
+			},
+			"metric": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Use metric units (default: true)",
+			},
+		},
+		Required: []string{"pid_a", "pid_b"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "care_diff_report",
+		Description: "Show a unified-diff-style comparison of two plants' care summaries, highlighting where their requirements differ",
+		InputSchema: careDiffReportSchema,
+	}, s.handleCareDiffReport)
+
+	// Tool 7: substrate_recommendation
+	substrateRecommendationSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "substrate_recommendation",
+		Description: "Suggest a soil/substrate mix for a plant based on its moisture and fertilizer (EC) profile and plant family",
+		InputSchema: substrateRecommendationSchema,
+	}, s.handleSubstrateRecommendation)
+
+	// Tool 8: comfort_overlap
+	healthWeightsSchema := map[string]interface{}{
+		"type":        "object",
+		"description": "Relative weight per metric (moisture, temperature, light_lux, humidity) for the overall score, e.g. {\"moisture\": 3}. Unlisted metrics use the configured weight (default 1); weights are normalized over the metrics scored",
+	}
+
+	comfortOverlapSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Current environment. Each metric is a number, a {\"min\", \"max\"} object, or a [min, max] array",
+				"properties": map[string]interface{}{
+					"moisture": map[string]interface{}{
+						"description": "Soil moisture percentage (0-100)",
+					},
+					"temperature": map[string]interface{}{
+						"description": "Temperature in Celsius",
+					},
+					"light_lux": map[string]interface{}{
+						"description": "Light level in lux",
+					},
+					"humidity": map[string]interface{}{
+						"description": "Humidity percentage (0-100)",
+					},
+				},
+			},
+			"weights": healthWeightsSchema,
+		},
+		Required: []string{"pid", "current_conditions"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "comfort_overlap",
+		Description: "Compute how suitable an environment is for a plant as a single percentage, combining per-metric overlap with the plant's comfort envelope",
+		InputSchema: comfortOverlapSchema,
+	}, s.handleComfortOverlap)
+
+	// Tool 9: project_conditions
+	timedReadingsSchema := map[string]interface{}{
+		"type": "array",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"timestamp": map[string]interface{}{
+					"description": "RFC 3339 timestamp or Unix seconds",
+				},
+				"value": map[string]interface{}{
+					"type": "number",
+				},
+			},
+		},
+	}
+
+	projectConditionsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID (pid) from search results, or a pin_plant token",
+			},
+			"readings": map[string]interface{}{
+				"type":        "object",
+				"description": "Timestamped readings per metric (at least two each)",
+				"properties": map[string]interface{}{
+					"moisture":    timedReadingsSchema,
+					"temperature": timedReadingsSchema,
+					"light_lux":   timedReadingsSchema,
+					"humidity":    timedReadingsSchema,
+				},
+			},
+		},
+		Required: []string{"pid", "readings"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "project_conditions",
+		Description: "Fit a naive linear trend to recent readings and project when each metric will leave the plant's ideal range",
+		InputSchema: projectConditionsSchema,
+	}, s.handleProjectConditions)
+
+	// Tool 10: convert_conditions
+	convertConditionsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Readings to convert. Metric uses temperature (°C) and light_lux; imperial uses temperature (°F) and light_fc (foot-candles). Moisture, humidity and soil_ec pass through",
+			},
+			"from": map[string]interface{}{
+				"type":        "string",
+				"enum":        []string{"metric", "imperial"},
+				"description": "Unit system of the input readings",
+			},
+			"to": map[string]interface{}{
+				"type":        "string",
+				"enum":        []string{"metric", "imperial"},
+				"description": "Unit system to convert to (use 'metric' before calling compare_conditions)",
+			},
+		},
+		Required: []string{"current_conditions", "from", "to"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "convert_conditions",
+		Description: "Convert a whole current_conditions object between metric and imperial units, ready to pass to compare_conditions",
+		InputSchema: convertConditionsSchema,
+	}, s.handleConvertConditions)
+
+	// Tool 11: explain_metric
+	explainMetricSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"metric": map[string]interface{}{
+				"type":        "string",
+				"description": "Metric to explain: moisture, temperature, light_lux, humidity or soil_ec (aliases like 'lux' or 'ec' are accepted)",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Language for the explanation (default: 'en'; falls back to English when untranslated)",
+			},
+		},
+		Required: []string{"metric"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "explain_metric",
+		Description: "Explain a care metric in plain language: what it is, typical units, how to measure it, and why it matters",
+		InputSchema: explainMetricSchema,
+	}, s.handleExplainMetric)
+
+	// Tool 12: simulate_change
+	simulateChangeSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to evaluate (exact 'pid' values from search_plants)",
+			},
+			"from_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Conditions before the change: moisture (%), temperature (°C), light_lux, humidity (%)",
+			},
+			"to_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Conditions after the change, using the same keys as from_conditions",
+			},
+		},
+		Required: []string{"pids", "from_conditions", "to_conditions"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "simulate_change",
+		Description: "Simulate moving plants to a different environment: for each plant, report whether each metric moves toward or away from its ideal range, and summarize which plants benefit or suffer",
+		InputSchema: simulateChangeSchema,
+	}, s.handleSimulateChange)
+
+	// Tool 13: score_card
+	scoreCardSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"readings": map[string]interface{}{
+				"type":        "object",
+				"description": "Logged readings per metric (moisture, temperature, light_lux, humidity), each an array of {timestamp, value} objects. Timestamps are RFC 3339 strings or Unix seconds",
+			},
+			"weights": healthWeightsSchema,
+		},
+		Required: []string{"pid", "readings"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "score_card",
+		Description: "Grade logged readings over a period: percentage of readings in the ideal range per metric, an overall score, and the worst-performing metric",
+		InputSchema: scoreCardSchema,
+	}, s.handleScoreCard)
+
+	// Tool 14: status_badge
+	statusBadgeSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Current sensor readings: moisture (%), temperature (°C), light_lux, humidity (%)",
+			},
+		},
+		Required: []string{"pid", "current_conditions"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "status_badge",
+		Description: "Return a single dashboard status token (🟢 healthy / 🟡 attention / 🔴 critical) with a one-line reason, based on the worst metric",
+		InputSchema: statusBadgeSchema,
+	}, s.handleStatusBadge)
+
+	// Tool 15: group_by_trait
+	groupByTraitSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to group (exact 'pid' values from search_plants)",
+			},
+			"trait": map[string]interface{}{
+				"type":        "string",
+				"enum":        traitNames(),
+				"description": "Care trait to group by",
+			},
+		},
+		Required: []string{"pids", "trait"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "group_by_trait",
+		Description: "Bucket a collection of plants by whether they share a care trait (e.g. high humidity, low light tolerant), with the matching criteria explained",
+		InputSchema: groupByTraitSchema,
+	}, s.handleGroupByTrait)
+
+	// Tool 16: ec_risk
+	ecRiskSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"soil_ec": map[string]interface{}{
+				"type":        "number",
+				"description": "Measured soil electrical conductivity in µS/cm",
+			},
+		},
+		Required: []string{"pid", "soil_ec"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "ec_risk",
+		Description: "Check a measured soil EC against the plant's fertilizer range and warn about salt buildup or fertilizer burn, with flushing advice",
+		InputSchema: ecRiskSchema,
+	}, s.handleECRisk)
+
+	// Tool 17: plant_passport
+	plantPassportSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Language for names and category (optional, default: configured language)",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "plant_passport",
+		Description: "Bundle a plant's identity, care ranges, interpreted guidance, image and attribution into one versioned, shareable JSON document",
+		InputSchema: plantPassportSchema,
+	}, s.handlePlantPassport)
+
+	// Tool 18: autocomplete
+	autocompleteSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Partial plant name typed so far (at least 2 characters)",
+			},
+		},
+		Required: []string{"query"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "autocomplete",
+		Description: "Return up to 8 plant display names matching a partial query, for type-ahead suggestion lists. Use search_plants for full results",
+		InputSchema: autocompleteSchema,
+	}, s.handleAutocomplete)
+
+	// Tool 19: shelf_placement
+	shelfPlacementSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to place (exact 'pid' values from search_plants)",
+			},
+			"shelves": map[string]interface{}{
+				"type":        "array",
+				"description": "Light at each shelf level, top first: lux numbers or {name, lux} objects",
+			},
+		},
+		Required: []string{"pids", "shelves"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "shelf_placement",
+		Description: "Assign each plant to the shelf level whose light best matches its lux range, given the lux at each level, and report plants that can't be placed",
+		InputSchema: shelfPlacementSchema,
+	}, s.handleShelfPlacement)
+
+	// Tool 20: estimate_lux
+	estimateLuxSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Qualitative light description, e.g. 'bright indirect', 'north-facing window', 'dark corner'",
+			},
+		},
+		Required: []string{"description"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "estimate_lux",
+		Description: "Estimate an approximate lux range from a qualitative placement description, for users without a light meter. The result can be passed to compare_conditions",
+		InputSchema: estimateLuxSchema,
+	}, s.handleEstimateLux)
+
+	// Tool 21: api_usage
+	apiUsageSchema := mcp.ToolInputSchema{
+		Type:       "object",
+		Properties: map[string]interface{}{},
+		Required:   []string{},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "api_usage",
+		Description: "Show how many OpenPlantbook API calls the server has made since startup, by endpoint, plus calls saved by the cache. Useful for staying under API quotas",
+		InputSchema: apiUsageSchema,
+	}, s.handleAPIUsage)
+
+	// Tool 22: care_steps
+	careStepsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Language for plant names (optional, default: configured language). Step text is currently English only",
+			},
+			"metric": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Use metric units (default: true)",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "care_steps",
+		Description: "Get beginner-friendly care instructions as a numbered list of concrete actions (placement, watering, temperature, humidity, feeding) derived from the plant's ranges",
+		InputSchema: careStepsSchema,
+	}, s.handleCareSteps)
+
+	// Tool 23: top_recommendation
+	topRecommendationSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Current sensor readings: moisture (%), temperature (°C), light_lux, humidity (%)",
+			},
+		},
+		Required: []string{"pid", "current_conditions"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "top_recommendation",
+		Description: "Compare readings against the plant's ideal ranges and return only the single most impactful change as a one-sentence instruction, or a 'nothing to change' message when everything is in range",
+		InputSchema: topRecommendationSchema,
+	}, s.handleTopRecommendation)
+
+	// Tool 24: validate_pids
+	validatePidsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to check (exact 'pid' values from search_plants)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "validate_pids",
+		Description: "Check a list of plant IDs concurrently and report which resolve to a plant (with display name), which don't exist, and which failed to look up. Use before batch operations to filter bad entries",
+		InputSchema: validatePidsSchema,
+	}, s.handleValidatePids)
+
+	// Tool 25: temperature_risk
+	temperatureRiskSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"day_temp": map[string]interface{}{
+				"type":        "number",
+				"description": "Typical daytime high temperature",
+			},
+			"night_temp": map[string]interface{}{
+				"type":        "number",
+				"description": "Typical nighttime low temperature",
+			},
+			"metric": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Temperatures are in °C (default: true); false for °F",
+			},
+		},
+		Required: []string{"pid", "day_temp", "night_temp"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "temperature_risk",
+		Description: "Check a day/night temperature pair against the plant's range, flagging an extreme that falls outside even when the average is fine, and report how much more day/night swing the plant can tolerate",
+		InputSchema: temperatureRiskSchema,
+	}, s.handleTemperatureRisk)
+
+	// Tool 26: sensor_recommendation
+	sensorRecommendationSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "sensor_recommendation",
+		Description: "Recommend which monitoring sensors are worth buying for a plant, ranked by how tight and how unusual each care range is compared to a typical houseplant",
+		InputSchema: sensorRecommendationSchema,
+	}, s.handleSensorRecommendation)
+
+	// Tool 27: collection_watering_plan
+	collectionWateringPlanSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"plants": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"pid": map[string]interface{}{
+							"type":        "string",
+							"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+						},
+						"nickname": map[string]interface{}{
+							"type":        "string",
+							"description": "Name to show for this plant (optional)",
+						},
+						"current_moisture": map[string]interface{}{
+							"type":        "number",
+							"description": "Current soil moisture percentage (0-100)",
+						},
+						"pot_size": map[string]interface{}{
+							"type":        "string",
+							"enum":        potSizes,
+							"description": "Pot size, which sets the estimated drying rate (default: medium)",
+						},
+					},
+					"required": []string{"pid", "current_moisture"},
+				},
+				"description": "Plants in the collection with their current soil moisture",
+			},
+		},
+		Required: []string{"plants"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "collection_watering_plan",
+		Description: "Build a consolidated watering schedule for a whole collection, grouping plants by the day they'll next need water (most urgent first) so watering can be batched",
+		InputSchema: collectionWateringPlanSchema,
+	}, s.handleCollectionWateringPlan)
+
+	// Tool 28: climate_comfort
+	climateComfortSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"temperature": map[string]interface{}{
+				"type":        "number",
+				"description": "Current air temperature (°C)",
+			},
+			"humidity": map[string]interface{}{
+				"type":        "number",
+				"description": "Current relative humidity (%)",
+			},
+		},
+		Required: []string{"pid", "temperature", "humidity"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "climate_comfort",
+		Description: "Assess temperature and humidity together, flagging risky combinations such as warm-and-dry for tropicals or cold-and-wet for succulents that separate range checks miss",
+		InputSchema: climateComfortSchema,
+	}, s.handleClimateComfort)
+
+	// Tool 29: pin_plant
+	pinPlantSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants",
+			},
+		},
+		Required: []string{"pid"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "pin_plant",
+		Description: "Fetch and cache a plant, returning a short token (e.g. pin-3fa9c1) that other tools accept in place of its pid for the rest of the conversation. Avoids retyping long scientific names; the token expires with the cached data",
+		InputSchema: pinPlantSchema,
+	}, s.handlePinPlant)
+
+	// Tool 30: care_matrix
+	careMatrixSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to compare (exact 'pid' values from search_plants, or pin_plant tokens)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "care_matrix",
+		Description: "Compare the care ranges of several plants in one table (plants as rows, metrics as columns) with a summary row naming the fussiest plant per metric and the most/least demanding plant overall. Also returns the matrix as structured JSON. Plants that fail to load are marked unavailable",
+		InputSchema: careMatrixSchema,
+	}, s.handleCareMatrix)
+
+	// Tool 31: potting_advice
+	pottingAdviceSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"pot_diameter_cm": map[string]interface{}{
+				"type":        "number",
+				"description": "Inside diameter of the current pot in centimeters",
+			},
+		},
+		Required: []string{"pid", "pot_diameter_cm"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "potting_advice",
+		Description: "Check whether a pot size suits the plant's moisture needs and family, flagging common mistakes such as a large pot for a plant that likes to dry out (root rot risk) or a small pot for a moisture lover",
+		InputSchema: pottingAdviceSchema,
+	}, s.handlePottingAdvice)
+
+	// Tool 32: misting_schedule
+	mistingScheduleSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"ambient_humidity": map[string]interface{}{
+				"type":        "number",
+				"description": "Typical relative humidity where the plant lives (%)",
+			},
+		},
+		Required: []string{"pid", "ambient_humidity"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "misting_schedule",
+		Description: "Suggest how often to mist a humidity-loving plant given the ambient humidity, or say clearly when the gap is too large for misting and a humidifier is needed",
+		InputSchema: mistingScheduleSchema,
+	}, s.handleMistingSchedule)
+
+	// Tool 33: find_care_duplicates
+	findCareDuplicatesSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs in the collection (exact 'pid' values from search_plants, or pin_plant tokens)",
+			},
+			"threshold": map[string]interface{}{
+				"type":        "number",
+				"description": "Similarity (0-1) at or above which plants are grouped (optional, default: 0.8)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "find_care_duplicates",
+		Description: "Find plants in a collection whose care requirements are essentially identical, grouping them so they can share a spot and routine",
+		InputSchema: findCareDuplicatesSchema,
+	}, s.handleFindCareDuplicates)
+
+	// Tool 34: export_garden_planner
+	exportGardenPlannerSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs to export (exact 'pid' values from search_plants, or pin_plant tokens)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "export_garden_planner",
+		Description: "Export care data for one or more plants in a versioned, app-neutral JSON format for garden planning apps: watering interval, light category (full_sun/part_sun/part_shade/shade), temperature range in °C and °F, and season notes",
+		InputSchema: exportGardenPlannerSchema,
+	}, s.handleExportGardenPlanner)
+
+	// Tool 35: search_diff
+	searchDiffSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Search query previously run through search_plants or search_diff",
+			},
+			"limit": map[string]interface{}{
+				"type":        "integer",
+				"description": fmt.Sprintf("Maximum results to compare (default: %d); use the same limit each time", defaultSearchDiffLimit),
+				"minimum":     1,
+				"maximum":     100,
+			},
+		},
+		Required: []string{"query"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "search_diff",
+		Description: "Re-run a plant search against the API and report which pids appeared or disappeared since the last time that search was fetched. The first run for a query records a baseline. Returns JSON with added and removed arrays plus a count summary.",
+		InputSchema: searchDiffSchema,
+	}, s.handleSearchDiff)
+
+	// Tool 36: export_bundle
+	exportBundleSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Language code for the plant names (optional)",
+			},
+		},
+		Required: []string{"pid"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "export_bundle",
+		Description: "Export a plant's care profile as a self-contained, versioned JSON bundle (ranges, interpretations, source) for sharing with another user. Another instance of this server can load it with import_bundle. Profiles previously imported are exported as imported.",
+		InputSchema: exportBundleSchema,
+	}, s.handleExportBundle)
+
+	// Tool 37: import_bundle
+	importBundleSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"bundle": map[string]interface{}{
+				"type":        []string{"object", "string"},
+				"description": "Bundle produced by export_bundle, as an object or its JSON text",
+			},
+		},
+		Required: []string{"bundle"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "import_bundle",
+		Description: "Import a care bundle from export_bundle. The bundle is validated and malformed or unsupported versions are rejected. Afterwards every tool uses the imported care profile for that pid instead of OpenPlantbook data, until the server restarts.",
+		InputSchema: importBundleSchema,
+	}, s.handleImportBundle)
+
+	// Tool 38: ec_drift_advice
+	ecDriftSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"readings": timedReadingsSchema,
+		},
+		Required: []string{"pid", "readings"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "ec_drift_advice",
+		Description: "Check a series of soil EC readings against the plant's EC range and trend, and advise whether to flush the soil, repot, or leave it (fine). readings is an array of {timestamp, value} EC readings in µS/cm, at least two at different times. Rising EC over time points at salt buildup from fertilizer and hard water.",
+		InputSchema: ecDriftSchema,
+	}, s.handleECDriftAdvice)
+
+	// Tool 39: space_capacity
+	spaceCapacitySchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"length_m": map[string]interface{}{
+				"type":        "number",
+				"description": "Length of the space in meters (use with width_m)",
+				"minimum":     0,
+			},
+			"width_m": map[string]interface{}{
+				"type":        "number",
+				"description": "Width of the space in meters (use with length_m)",
+				"minimum":     0,
+			},
+			"area_m2": map[string]interface{}{
+				"type":        "number",
+				"description": "Area in square meters, when the dimensions aren't known",
+				"minimum":     0,
+			},
+		},
+		Required: []string{"pid"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "space_capacity",
+		Description: "Roughly estimate how many plants of a type fit a space, from a typical mature spread for the plant's family (or a generic size when unknown). Describe the space with length_m and width_m, or area_m2.",
+		InputSchema: spaceCapacitySchema,
+	}, s.handleSpaceCapacity)
+
+	// Tool 40: last_stress_event
+	lastStressEventSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"readings": map[string]interface{}{
+				"type":        "object",
+				"description": "Reading history per metric (moisture, temperature, light_lux, humidity), each an array of {timestamp, value} objects. Timestamps are RFC 3339 strings or Unix seconds",
+			},
+			"max_gap_hours": map[string]interface{}{
+				"type":        "number",
+				"description": fmt.Sprintf("Longest gap between readings a stress period is assumed to continue through (default: %g)", defaultStressMaxGapHours),
+				"minimum":     0,
+			},
+		},
+		Required: []string{"pid", "readings"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "last_stress_event",
+		Description: "Scan a reading history for the most recent period when any metric was outside the plant's ideal range: which metric, how far, when, and for how long. Helps connect visible damage to past conditions.",
+		InputSchema: lastStressEventSchema,
+	}, s.handleLastStressEvent)
+
+	// Tool 41: monthly_checklist
+	monthlyChecklistSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs in the collection - use the exact 'pid' values from search_plants, or pin_plant tokens",
+			},
+			"month": map[string]interface{}{
+				"type":        "number",
+				"description": "Month to plan, 1 (January) to 12 (December) (default: the current month)",
+				"minimum":     1,
+				"maximum":     12,
+			},
+			"hemisphere": map[string]interface{}{
+				"type":        "string",
+				"enum":        hemispheres,
+				"description": "Hemisphere, which decides the season for the month (default: north)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "monthly_checklist",
+		Description: "Build a printable monthly care checklist for a collection: watering, feeding and humidity checks organized by week, derived from each plant's ranges and adjusted for the season (cacti and succulents rest in winter).",
+		InputSchema: monthlyChecklistSchema,
+	}, s.handleMonthlyChecklist)
+
+	// Tool 42: triage
+	triageSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"current_conditions": map[string]interface{}{
+				"type":        "object",
+				"description": "Current sensor readings: moisture (%), temperature (°C), light_lux, humidity (%)",
+			},
+		},
+		Required: []string{"pid", "current_conditions"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "triage",
+		Description: "Emergency first aid for a struggling plant: when any reading is severely out of range, return a short, prioritized list of urgent actions (e.g. 'do not water - roots may be rotting') instead of routine care advice. Says so when there is no emergency.",
+		InputSchema: triageSchema,
+	}, s.handleTriage)
+
+	// Tool 43: reading_sanity
+	readingSanitySchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"readings": map[string]interface{}{
+				"type":        "object",
+				"description": "Readings to cross-check, any of: moisture (%), temperature (°C), light_lux, humidity (%), soil_ec (µS/cm)",
+			},
+			"indoor": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the sensors are indoors, which enables checks that only make sense inside (default: true)",
+			},
+		},
+		Required: []string{"readings"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "reading_sanity",
+		Description: "Cross-check a set of sensor readings for physical implausibility, such as bright sun with near-freezing air indoors or dry soil with a high EC, and flag likely mislabeled or misplaced sensors. Independent of any plant; the checks are heuristics.",
+		InputSchema: readingSanitySchema,
+	}, s.handleReadingSanity)
+
+	// Tool 44: resolve_plant
+	resolvePlantSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant name to resolve, common or scientific (e.g. 'swiss cheese plant')",
+			},
+		},
+		Required: []string{"query"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "resolve_plant",
+		Description: "Resolve a plant name to the single closest pid in the database, with its display name, a 0-1 match score and a short reason (exact, prefix, word, contains or fuzzy match on the common or scientific name), plus up to 3 alternatives. Use it to show the user why a name was mapped to a plant.",
+		InputSchema: resolvePlantSchema,
+	}, s.handleResolvePlant)
+
+	// Tool 45: care_reminders
+	careRemindersSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pid": map[string]interface{}{
+				"type":        "string",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
+			},
+			"current_moisture": map[string]interface{}{
+				"type":        "number",
+				"description": "Current soil moisture (%); the first watering is projected from it. Without it, the first watering is due today",
+			},
+			"pot_size": map[string]interface{}{
+				"type":        "string",
+				"enum":        potSizes,
+				"description": "Pot size, which sets how fast the soil dries (default: medium)",
+			},
+			"start": map[string]interface{}{
+				"type":        "string",
+				"description": "RFC 3339 start time; its offset is the time zone for due times (default: now, UTC)",
+			},
+			"days": map[string]interface{}{
+				"type":        "number",
+				"description": fmt.Sprintf("How many days ahead to generate reminders (default: %d, max: %d)", defaultReminderDays, maxReminderDays),
+			},
+			"hour": map[string]interface{}{
+				"type":        "number",
+				"description": fmt.Sprintf("Local hour of day reminders are due (default: %d)", defaultReminderHour),
+			},
+			"hemisphere": map[string]interface{}{
+				"type":        "string",
+				"enum":        hemispheres,
+				"description": "Hemisphere, which decides the season (default: north)",
+			},
+		},
+		Required: []string{"pid"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "care_reminders",
+		Description: "Generate structured notification payloads (id, task, title, body, due time, priority) for a plant's upcoming watering, feeding and humidity checks, ready to feed into ntfy, push or webhook systems. Uses the monthly_checklist schedule with the first watering projected from the current moisture.",
+		InputSchema: careRemindersSchema,
+	}, s.handleCareReminders)
+
+	// Tool 46: compare_plants
+	comparePlantsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"minItems":    2,
+				"description": "Two or more plant IDs - use the exact 'pid' values from search_plants, or pin_plant tokens",
+			},
+			"pid_a": map[string]interface{}{
 				"type":        "string",
-				"description": "Plant name to search for (common or scientific name)",
+				"description": "First plant ID, when comparing two plants",
 			},
-			"limit": map[string]interface{}{
-				"type":        "number",
-				"description": "Maximum number of results (optional, default: 10)",
+			"pid_b": map[string]interface{}{
+				"type":        "string",
+				"description": "Second plant ID, when comparing two plants",
 			},
 		},
-		Required: []string{"query"},
 	}
-
-	mcpServer.AddTool(mcp.Tool{
-		Name:        "search_plants",
-		Description: "Search for plants by common name or scientific name in the OpenPlantbook database",
-		InputSchema: searchPlantsSchema,
-	}, s.handleSearchPlants)
-
-	// Tool 2: get_plant_care
-	getPlantCareSchema := mcp.ToolInputSchema{
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "compare_plants",
+		Description: "Compare two or more plants side by side: a table of their light, temperature, humidity, soil moisture and EC ranges, with the range they all share where they overlap and the incompatible ranges where they don't. Useful for deciding whether plants can share a pot, shelf or spot",
+		InputSchema: comparePlantsSchema,
+	}, s.handleComparePlants)
+
+	// Tool 47: get_watering_schedule
+	getWateringScheduleSchema := mcp.ToolInputSchema{
 		Type: "object",
 		Properties: map[string]interface{}{
 			"pid": map[string]interface{}{
 				"type":        "string",
-				"description": "Plant ID (pid) from search results",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
 			},
-			"language": map[string]interface{}{
-				"type":        "string",
-				"description": "Preferred language code (e.g., 'en', 'de', 'es'), optional",
+			"pot_size_liters": map[string]interface{}{
+				"type":        "number",
+				"description": fmt.Sprintf("Pot volume in liters; smaller pots dry faster (default: %g)", referencePotLiters),
+			},
+			"current_moisture": map[string]interface{}{
+				"type":        "number",
+				"description": "Current soil moisture (%), to date the next watering from today's reading",
 			},
 		},
 		Required: []string{"pid"},
 	}
-
-	mcpServer.AddTool(mcp.Tool{
-		Name:        "get_plant_care",
-		Description: "Get detailed care requirements for a specific plant including moisture, temperature, light, and humidity ranges",
-		InputSchema: getPlantCareSchema,
-	}, s.handleGetPlantCare)
-
-	// Tool 3: get_care_summary
-	getCareSummarySchema := mcp.ToolInputSchema{
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "get_watering_schedule",
+		Description: "Estimate how many days a plant goes between waterings and when the next one is due, from its ideal soil moisture range, the pot volume and evaporation at its ideal temperature and humidity",
+		InputSchema: getWateringScheduleSchema,
+	}, s.handleGetWateringSchedule)
+
+	// Tool 48: get_watering_recommendation
+	getWateringRecommendationSchema := mcp.ToolInputSchema{
 		Type: "object",
 		Properties: map[string]interface{}{
 			"pid": map[string]interface{}{
 				"type":        "string",
-				"description": "Plant ID (pid) from search results",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
 			},
-			"metric": map[string]interface{}{
-				"type":        "boolean",
-				"description": "Use metric units (default: true)",
+			"moisture": map[string]interface{}{
+				"type":        "number",
+				"description": "Current soil moisture reading (%, 0-100)",
+				"minimum":     0,
+				"maximum":     100,
 			},
 		},
-		Required: []string{"pid"},
+		Required: []string{"pid", "moisture"},
 	}
-
-	mcpServer.AddTool(mcp.Tool{
-		Name:        "get_care_summary",
-		Description: "Get a human-readable summary of plant care requirements with interpreted ranges",
-		InputSchema: getCareSummarySchema,
-	}, s.handleGetCareSummary)
-
-	// Tool 4: compare_conditions
-	compareConditionsSchema := mcp.ToolInputSchema{
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "get_watering_recommendation",
+		Description: "Decide whether to water now from a soil moisture reading: 'water now', 'check in N days', or 'do not water - soil too wet', with how far the reading is from the middle of the plant's ideal moisture band",
+		InputSchema: getWateringRecommendationSchema,
+	}, s.handleGetWateringRecommendation)
+
+	// Tool 49: diagnose_symptoms
+	diagnoseSymptomsSchema := mcp.ToolInputSchema{
 		Type: "object",
 		Properties: map[string]interface{}{
 			"pid": map[string]interface{}{
 				"type":        "string",
-				"description": "Plant ID (pid) from search results",
+				"description": "Plant ID - use the exact 'pid' value from search_plants, or a pin_plant token",
 			},
-			"current_conditions": map[string]interface{}{
-				"type":        "object",
-				"description": "Current sensor readings",
-				"properties": map[string]interface{}{
-					"moisture": map[string]interface{}{
-						"type":        "number",
-						"description": "Current soil moisture percentage (0-100)",
-					},
-					"temperature": map[string]interface{}{
-						"type":        "number",
-						"description": "Current temperature in Celsius",
-					},
-					"light_lux": map[string]interface{}{
-						"type":        "number",
-						"description": "Current light level in lux",
-					},
-					"humidity": map[string]interface{}{
-						"type":        "number",
-						"description": "Current humidity percentage (0-100)",
-					},
-				},
+			"symptoms": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string", "enum": symptomNames()},
+				"minItems":    1,
+				"description": "Observed problems, e.g. yellow_leaves, brown_tips, drooping, leaf_drop",
 			},
 		},
-		Required: []string{"pid", "current_conditions"},
+		Required: []string{"pid", "symptoms"},
 	}
-
-	mcpServer.AddTool(mcp.Tool{
-		Name:        "compare_conditions",
-		Description: "Compare actual sensor readings against ideal plant care ranges and identify issues",
-		InputSchema: compareConditionsSchema,
-	}, s.handleCompareConditions)
-
-	// Tool 5: server_info
-	serverInfoSchema := mcp.ToolInputSchema{
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "diagnose_symptoms",
+		Description: "Map observed problems (yellow leaves, brown tips, drooping, leaf drop...) to their likely care causes, ranked by likelihood for this plant's ideal ranges, each with a corrective action quoting the plant's actual range values",
+		InputSchema: diagnoseSymptomsSchema,
+	}, s.handleDiagnoseSymptoms)
+
+	// Tool 50: recommend_plants
+	recommendPlantsSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"light_lux": map[string]interface{}{
+				"type":        "number",
+				"description": "Light level of the spot (lux)",
+			},
+			"temperature": map[string]interface{}{
+				"type":        "number",
+				"description": "Typical temperature of the spot (°C)",
+			},
+			"humidity": map[string]interface{}{
+				"type":        "number",
+				"description": "Typical relative humidity of the spot (%)",
+			},
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Candidate plant IDs to rank - use the exact 'pid' values from search_plants, or pin_plant tokens",
+			},
+			"query": map[string]interface{}{
+				"type":        "string",
+				"description": fmt.Sprintf("Search query whose first %d results are the candidates, e.g. a genus like 'ficus' (instead of pids)", maxRecommendSearchCandidates),
+			},
+			"limit": map[string]interface{}{
+				"type":        "integer",
+				"description": fmt.Sprintf("Number of plants to return (default: %d)", defaultRecommendLimit),
+				"minimum":     1,
+				"maximum":     maxRecommendLimit,
+			},
+		},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "recommend_plants",
+		Description: "Find which plants suit a spot: score candidate plants (a pids list or a search query) by how well the spot's light, temperature and humidity fit their ideal ranges, and return the best matches with a short rationale each. OpenPlantbook can't search by conditions, so candidates must be given",
+		InputSchema: recommendPlantsSchema,
+	}, s.handleRecommendPlants)
+
+	// Tool 51: calculate_vpd
+	calculateVPDSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"temperature": map[string]interface{}{
+				"type":        "number",
+				"description": "Air temperature (°C)",
+				"minimum":     minVPDTemperature,
+				"maximum":     maxVPDTemperature,
+			},
+			"humidity": map[string]interface{}{
+				"type":        "number",
+				"description": "Relative humidity (%, 0-100)",
+				"minimum":     0,
+				"maximum":     100,
+			},
+			"leaf_temp_offset": map[string]interface{}{
+				"type":        "number",
+				"description": "Leaf temperature minus air temperature (°C), usually -1 to -3 as transpiring leaves run cooler (default: 0, air VPD)",
+				"minimum":     -maxLeafTempOffset,
+				"maximum":     maxLeafTempOffset,
+			},
+		},
+		Required: []string{"temperature", "humidity"},
+	}
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "calculate_vpd",
+		Description: "Calculate the vapor pressure deficit (VPD, kPa) from air temperature and humidity, optionally at leaf temperature, with its band: too humid, propagation, vegetative, flowering or too dry. Needs no plant ID",
+		InputSchema: calculateVPDSchema,
+	}, s.handleCalculateVPD)
+
+	// Tool 52: health_check
+	healthCheckSchema := mcp.ToolInputSchema{
 		Type:       "object",
 		Properties: map[string]interface{}{},
 		Required:   []string{},
 	}
 
-	mcpServer.AddTool(mcp.Tool{
-		Name:        "server_info",
-		Description: "Get server version, build information, and runtime status",
-		InputSchema: serverInfoSchema,
-	}, s.handleServerInfo)
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "health_check",
+		Description: "Check that the server can reach OpenPlantbook and authenticate: makes one small uncached search and reports the auth method in use (never the credentials), whether it succeeded, latency, a failure category with a hint, and the cache and transport settings. Use when tools are failing",
+		InputSchema: healthCheckSchema,
+	}, s.handleHealthCheck)
+
+	// Tool 53: get_plant_care_batch
+	getPlantCareBatchSchema := mcp.ToolInputSchema{
+		Type: "object",
+		Properties: map[string]interface{}{
+			"pids": map[string]interface{}{
+				"type":        "array",
+				"items":       map[string]interface{}{"type": "string"},
+				"description": "Plant IDs (pid) from search results, or pin_plant tokens",
+			},
+			"language": map[string]interface{}{
+				"type":        "string",
+				"description": "Preferred language code (e.g., 'en', 'de', 'es'), optional",
+			},
+			"no_cache": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Skip the cache and fetch fresh data (the cache is still updated)",
+			},
+		},
+		Required: []string{"pids"},
+	}
+
+	s.addTool(mcpServer, readAccess, mcp.Tool{
+		Name:        "get_plant_care_batch",
+		Description: "Get care details for several plants in one call, fetched concurrently. Returns a map of pid to details, or to an error for plants that couldn't be fetched; one failure doesn't fail the call",
+		InputSchema: getPlantCareBatchSchema,
+	}, s.handleGetPlantCareBatch)
 
-	s.logger.Info("registered tools", "count", 5)
+	registered := make([]string, 0, len(mcpServer.ListTools()))
+	for name := range mcpServer.ListTools() {
+		registered = append(registered, name)
+	}
+	sort.Strings(registered)
+
+	s.toolCount = len(registered)
+	s.logger.Info("registered tools", "count", s.toolCount, "auth_method", tier, "tools", registered)
 	return nil
 }
 
 // handleSearchPlants handles the search_plants tool
 func (s *Server) handleSearchPlants(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	traceID := xid.New().String()
+	traceID := traceIDFromContext(ctx)
 	logger := s.logger.With("trace_id", traceID, "tool", "search_plants")
 
 	// Extract parameters using helper methods
@@ -239,15 +1718,35 @@ func (s *Server) handleSearchPlants(ctx context.Context, request mcp.CallToolReq
 		return mcp.NewToolResultError("query parameter is required and must be a string"), nil
 	}
 
-	// Build search options
+	limit := request.GetInt("limit", defaultSearchLimit)
+	if limit < 1 {
+		logger.Warn("invalid limit parameter", "limit", limit)
+		return mcp.NewToolResultError("limit must be at least 1"), nil
+	}
+	if limit > maxSearchLimit {
+		logger.Info("clamping search limit", "limit", limit, "max", maxSearchLimit)
+		limit = maxSearchLimit
+	}
+
+	offset := request.GetInt("offset", 0)
+	if offset < 0 {
+		logger.Warn("invalid offset parameter", "offset", offset)
+		return mcp.NewToolResultError("offset must not be negative"), nil
+	}
+
+	// Build search options; the page is cut from the first offset+limit+1 results
 	opts := &openplantbook.SearchOptions{
-		Limit: request.GetInt("limit", 10),
+		Limit: searchFetchLimit(offset, limit),
 	}
 
-	logger.Info("searching plants", "query", query, "limit", opts.Limit)
+	if request.GetBool("no_cache", false) {
+		ctx = withNoCache(ctx)
+	}
+
+	logger.Info("searching plants", "query", query, "limit", limit, "offset", offset)
 
 	// Call SDK
-	results, err := s.client.SearchPlants(ctx, query, opts)
+	results, err := s.searchPlants(ctx, query, opts)
 	if err != nil {
 		logger.Error("search failed", "error", err)
 		return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
@@ -255,8 +1754,25 @@ func (s *Server) handleSearchPlants(ctx context.Context, request mcp.CallToolReq
 
 	logger.Info("search completed", "results", len(results))
 
+	// Optionally retry with the genus when the exact query found nothing
+	response := paginateSearch(results, offset, limit)
+	if len(results) == 0 && request.GetBool("broaden_on_empty", false) {
+		if genus, ok := genusQuery(query); ok {
+			logger.Info("no results, broadening to genus", "query", query, "genus", genus)
+			broader, err := s.searchPlants(ctx, genus, opts)
+			if err != nil {
+				logger.Error("genus search failed", "error", err)
+				return mcp.NewToolResultError(fmt.Sprintf("search failed: %v", err)), nil
+			}
+			if len(broader) > 0 {
+				response = newBroadenedSearch(query, genus, paginateSearch(broader, offset, limit))
+			}
+			logger.Info("genus search completed", "results", len(broader))
+		}
+	}
+
 	// Format response
-	data, err := json.MarshalIndent(results, "", "  ")
+	data, err := json.MarshalIndent(response, "", "  ")
 	if err != nil {
 		logger.Error("marshal results failed", "error", err)
 		return mcp.NewToolResultError("failed to format results"), nil
@@ -267,7 +1783,7 @@ func (s *Server) handleSearchPlants(ctx context.Context, request mcp.CallToolReq
 
 // handleGetPlantCare handles the get_plant_care tool
 func (s *Server) handleGetPlantCare(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	traceID := xid.New().String()
+	traceID := traceIDFromContext(ctx)
 	logger := s.logger.With("trace_id", traceID, "tool", "get_plant_care")
 
 	// Extract parameters
@@ -277,15 +1793,16 @@ func (s *Server) handleGetPlantCare(ctx context.Context, request mcp.CallToolReq
 		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
 	}
 
-	// Build detail options
-	opts := &openplantbook.DetailOptions{
-		Language: request.GetString("language", s.config.DefaultLang),
+	language := request.GetString("language", "")
+
+	if request.GetBool("no_cache", false) {
+		ctx = withNoCache(ctx)
 	}
 
-	logger.Info("getting plant care", "pid", pid, "language", opts.Language)
+	logger.Info("getting plant care", "pid", pid, "language", language)
 
-	// Call SDK
-	details, err := s.client.GetPlantDetails(ctx, pid, opts)
+	// Call SDK, walking the language fallback chain
+	details, err := s.getPlantDetails(ctx, pid, language)
 	if err != nil {
 		logger.Error("get details failed", "error", err)
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
@@ -305,7 +1822,7 @@ func (s *Server) handleGetPlantCare(ctx context.Context, request mcp.CallToolReq
 
 // handleGetCareSummary handles the get_care_summary tool
 func (s *Server) handleGetCareSummary(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	traceID := xid.New().String()
+	traceID := traceIDFromContext(ctx)
 	logger := s.logger.With("trace_id", traceID, "tool", "get_care_summary")
 
 	// Extract parameters
@@ -316,20 +1833,88 @@ func (s *Server) handleGetCareSummary(ctx context.Context, request mcp.CallToolR
 	}
 
 	metric := request.GetBool("metric", true)
+	language := request.GetString("language", "")
+
+	maxChars := request.GetInt("max_summary_chars", 0)
+	if maxChars < 0 {
+		logger.Warn("invalid max_summary_chars parameter", "max_summary_chars", maxChars)
+		return mcp.NewToolResultError("max_summary_chars must be a positive number of characters, or 0 for unlimited"), nil
+	}
+
+	profile := request.GetString("profile", summaryProfileFull)
+	if profile != summaryProfileFull && profile != summaryProfileBeginner {
+		logger.Warn("invalid profile parameter", "profile", profile)
+		return mcp.NewToolResultError(fmt.Sprintf("profile must be one of: %s", strings.Join(summaryProfiles, ", "))), nil
+	}
+
+	format := request.GetString("format", summaryFormatMarkdown)
+	if format != summaryFormatMarkdown && format != summaryFormatJSON {
+		logger.Warn("invalid format parameter", "format", format)
+		return mcp.NewToolResultError(fmt.Sprintf("format must be one of: %s", strings.Join(summaryFormats, ", "))), nil
+	}
+
+	// Interpretation text is only written in some languages; fall back to English
+	interpretationLang, fellBack := resolveInterpretationLang(request.GetString("interpretation_lang", ""))
 
-	logger.Info("generating care summary", "pid", pid, "metric", metric)
+	// The data language decides whether interpretation gets its own section; without an
+	// explicit language it is the first the chain tries, the session's or DefaultLang
+	dataLang := s.languageChain(ctx, language)[0]
+
+	logger.Info("generating care summary", "pid", pid, "metric", metric, "language", language, "interpretation_lang", interpretationLang)
 
 	// Get plant details
-	details, err := s.client.GetPlantDetails(ctx, pid, &openplantbook.DetailOptions{
-		Language: s.config.DefaultLang,
-	})
+	details, err := s.getPlantDetails(ctx, pid, language)
 	if err != nil {
 		logger.Error("get details failed", "error", err)
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
 	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	// Structured output carries the numbers and their interpretation, without prose
+	if format == summaryFormatJSON {
+		data, err := json.MarshalIndent(buildCareSummaryJSON(details, metric), "", "  ")
+		if err != nil {
+			logger.Error("failed to marshal care summary", "error", err)
+			return mcp.NewToolResultError(fmt.Sprintf("failed to format care summary: %v", err)), nil
+		}
+		logger.Info("structured care summary generated", "pid", details.PID)
+		return mcp.NewToolResultText(string(data)), nil
+	}
+
+	// The beginner profile replaces every range with plain-language guidance
+	if profile == summaryProfileBeginner {
+		summary := renderBeginnerSummary(details, interpretationLang)
+		if fellBack {
+			summary += fmt.Sprintf("\n_Interpretation is not yet available in %q; showing %s._\n", request.GetString("interpretation_lang", ""), interpretationLang)
+		}
+		if request.GetBool("include_footer", false) {
+			summary += formatProvenanceFooter(s.detailsProvenance(ctx, pid, language), time.Now())
+		}
+		logger.Info("beginner care summary generated", "pid", details.PID)
+		return mcp.NewToolResultText(summary), nil
+	}
 
 	// Generate human-readable summary
-	summary := formatCareSummary(details, metric)
+	opts := summaryLanguageOptions(metric, dataLang, interpretationLang)
+	opts.thresholdTable = request.GetBool("include_table", false)
+	opts.dualUnits = request.GetBool("dual_units", false)
+	opts.precision = s.numberPrecision()
+	opts.beginner = s.beginnerMode(request)
+	sections := careSummarySections(details, opts)
+	if request.GetBool("compare_to_baseline", false) {
+		baseline := s.baselineProfile()
+		sections = append(sections, summarySection{"\n" + formatBaselineDeltas(computeBaselineDeltas(details, baseline), baseline), sectionSupplementary})
+	}
+	if fellBack {
+		sections = append(sections, summarySection{fmt.Sprintf("\n_Interpretation is not yet available in %q; showing %s._\n", request.GetString("interpretation_lang", ""), interpretationLang), sectionSupplementary})
+	}
+	if request.GetBool("include_footer", false) {
+		sections = append(sections, summarySection{formatProvenanceFooter(s.detailsProvenance(ctx, pid, language), time.Now()), sectionSupplementary})
+	}
+	summary := joinSummarySections(sections, maxChars)
 
 	logger.Info("care summary generated", "pid", details.PID)
 
@@ -338,7 +1923,7 @@ func (s *Server) handleGetCareSummary(ctx context.Context, request mcp.CallToolR
 
 // handleCompareConditions handles the compare_conditions tool
 func (s *Server) handleCompareConditions(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	traceID := xid.New().String()
+	traceID := traceIDFromContext(ctx)
 	logger := s.logger.With("trace_id", traceID, "tool", "compare_conditions")
 
 	// Extract parameters
@@ -355,19 +1940,61 @@ func (s *Server) handleCompareConditions(ctx context.Context, request mcp.CallTo
 		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
 	}
 
-	logger.Info("comparing conditions", "pid", pid)
+	aggregation := request.GetString("aggregation", s.defaultAggregation())
+	if !validAggregation(aggregation) {
+		logger.Warn("invalid aggregation parameter", "aggregation", aggregation)
+		return mcp.NewToolResultError(fmt.Sprintf("aggregation must be one of: %s", strings.Join(aggregationMethods, ", "))), nil
+	}
+
+	// Check for a dislodged moisture probe before aggregation hides sudden drops
+	sensorCheck := detectSensorInAir(conditions, s.sensorInAirThresholds())
+
+	conditions, sampleCounts, err := aggregateConditions(conditions, aggregation)
+	if err != nil {
+		logger.Warn("invalid current_conditions samples", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	settling, err := parseSettlingWindow(request)
+	if err != nil {
+		logger.Warn("invalid settling parameters", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	language := request.GetString("language", "")
+	metric := request.GetBool("metric", true)
+
+	logger.Info("comparing conditions", "pid", pid, "language", language, "aggregation", aggregation, "metric", metric)
 
 	// Get plant details
-	details, err := s.client.GetPlantDetails(ctx, pid, &openplantbook.DetailOptions{
-		Language: s.config.DefaultLang,
-	})
+	details, err := s.getPlantDetails(ctx, pid, language)
 	if err != nil {
 		logger.Error("get details failed", "error", err)
 		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
 	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
 
-	// Compare conditions
-	analysis := compareConditions(details, conditions)
+	// Compare conditions; a likely dislodged sensor replaces the drought alert
+	if sensorCheck.likely() {
+		logger.Warn("moisture sensor likely not in soil", "pid", pid, "moisture", sensorCheck.moisture)
+		conditions = withoutProbeReadings(conditions)
+	}
+	// Moisture and humidity swings right after watering or moving the plant are expected
+	conditions, settlingNotes := settleConditions(details, conditions, settling)
+	if len(settlingNotes) > 0 {
+		logger.Info("downgraded alerts inside settling window", "pid", pid, "metrics", len(settlingNotes))
+	}
+	analysis := compareConditions(details, conditions, metric, s.numberPrecision(), s.beginnerMode(request))
+	if len(settlingNotes) > 0 {
+		analysis += "\n" + formatSettlingNotes(settlingNotes, settling)
+	}
+	if sensorCheck.suspicious {
+		analysis += "\n" + formatSensorInAirWarning(sensorCheck)
+	}
+	analysis += formatAggregationNote(aggregation, sampleCounts)
 
 	logger.Info("condition comparison completed", "pid", details.PID)
 
@@ -376,70 +2003,227 @@ func (s *Server) handleCompareConditions(ctx context.Context, request mcp.CallTo
 
 // formatCareSummary creates a human-readable care summary
 func formatCareSummary(details *openplantbook.PlantDetails, metric bool) string {
-	tempUnit := "°C"
-	if !metric {
-		tempUnit = "°F"
+	return renderCareSummary(details, summaryOptions{metric: metric})
+}
+
+// summaryOptions controls how a care summary is rendered
+type summaryOptions struct {
+	metric bool
+
+	// separateInterpretation moves the interpretive text out of the range lines
+	// into its own section, labeled with the data and interpretation languages
+	separateInterpretation bool
+	dataLang               string
+	interpretationLang     string
+
+	// thresholdTable appends a markdown table of every metric's min/max
+	thresholdTable bool
+
+	// precision overrides the decimal places of every number
+	precision numberPrecision
+
+	// dualUnits shows temperature in both °C and °F, and light in foot-candles alongside lux
+	dualUnits bool
+
+	// beginner replaces lux, EC and percentage ranges with plain-language levels and drops
+	// the unit line and threshold table
+	beginner bool
+}
+
+// renderCareSummary renders a care summary with the given options
+func renderCareSummary(details *openplantbook.PlantDetails, opts summaryOptions) string {
+	return joinSummarySections(careSummarySections(details, opts), 0)
+}
+
+// careSummarySections builds the care summary as prioritized sections, in display order
+func careSummarySections(details *openplantbook.PlantDetails, opts summaryOptions) []summarySection {
+	metric := opts.metric
+	p := opts.precision
+
+	// interpret returns the inline interpretation, or nothing when it has its own section
+	interpret := func(text string) string {
+		if opts.separateInterpretation {
+			return ""
+		}
+		return text
 	}
 
-	summary := fmt.Sprintf("# %s (%s)\n\n", details.Alias, details.DisplayPID)
-	summary += fmt.Sprintf("Category: %s\n\n", details.Category)
-	summary += "## Care Requirements\n\n"
+	header := fmt.Sprintf("# %s (%s)\n\n", details.Alias, details.DisplayPID)
+	header += fmt.Sprintf("Category: %s\n\n", details.Category)
+	if !opts.beginner {
+		header += fmt.Sprintf("Units: %s\n\n", unitSystemLabel(metric))
+	}
+	if opts.separateInterpretation {
+		header += fmt.Sprintf("## Plant data (%s)\n\n", opts.dataLang)
+	} else {
+		header += "## Care Requirements\n\n"
+	}
+	sections := []summarySection{{header, sectionEssential}}
+	add := func(text string, priority sectionPriority) {
+		if text != "" {
+			sections = append(sections, summarySection{text, priority})
+		}
+	}
 
-	// Light
+	// Light; the interpretation always keys off lux
 	if details.MaxLightLux > 0 {
-		summary += fmt.Sprintf("**Light**: %d - %d lux", details.MinLightLux, details.MaxLightLux)
-		summary += interpretLightLevel(details.MinLightLux, details.MaxLightLux)
-		summary += "\n\n"
+		lux := p.formatRange(float64(details.MinLightLux), float64(details.MaxLightLux), 0) + " lux"
+		fc := p.formatRange(luxToFootCandles(float64(details.MinLightLux)), luxToFootCandles(float64(details.MaxLightLux)), 0) + " fc"
+		var light string
+		switch {
+		case opts.beginner:
+			light = "**Light**: " + lightBandFor(float64(details.MinLightLux+details.MaxLightLux)/2).description
+		case opts.dualUnits && metric:
+			light = fmt.Sprintf("**Light**: %s (%s)", lux, fc)
+		case opts.dualUnits:
+			light = fmt.Sprintf("**Light**: %s (%s)", fc, lux)
+		case metric:
+			light = fmt.Sprintf("**Light**: %s", lux)
+		default:
+			light = fmt.Sprintf("**Light**: %s", fc)
+		}
+		if !opts.beginner {
+			light += interpret(fmt.Sprintf(" (%s)", bandInterpretation(details, "light_lux", metric)))
+		}
+		add(light+"\n\n", sectionCritical)
 	}
 
 	// Temperature
 	if details.MaxTemp > 0 {
-		if metric {
-			summary += fmt.Sprintf("**Temperature**: %.1f - %.1f%s\n\n", details.MinTemp, details.MaxTemp, tempUnit)
-		} else {
-			minF := details.MinTemp*9/5 + 32
-			maxF := details.MaxTemp*9/5 + 32
-			summary += fmt.Sprintf("**Temperature**: %.1f - %.1f%s\n\n", minF, maxF, tempUnit)
+		celsius := p.formatRange(details.MinTemp, details.MaxTemp, 1) + "°C"
+		fahrenheit := p.formatRange(celsiusToFahrenheit(details.MinTemp), celsiusToFahrenheit(details.MaxTemp), 1) + "°F"
+		var temperature string
+		switch {
+		case opts.dualUnits && metric:
+			temperature = fmt.Sprintf("**Temperature**: %s (%s)", celsius, fahrenheit)
+		case opts.dualUnits:
+			temperature = fmt.Sprintf("**Temperature**: %s (%s)", fahrenheit, celsius)
+		case metric:
+			temperature = fmt.Sprintf("**Temperature**: %s", celsius)
+		default:
+			temperature = fmt.Sprintf("**Temperature**: %s", fahrenheit)
 		}
+		temperature += interpret(fmt.Sprintf(" (%s)", bandInterpretation(details, "temperature", metric)))
+		add(temperature+"\n\n", sectionCore)
 	}
 
 	// Humidity
 	if details.MaxEnvHumid > 0 {
-		summary += fmt.Sprintf("**Humidity**: %d - %d%%\n\n", details.MinEnvHumid, details.MaxEnvHumid)
+		humidity := "**Humidity**: " + humidityPhrase(float64(details.MinEnvHumid+details.MaxEnvHumid)/2)
+		if !opts.beginner {
+			humidity = fmt.Sprintf("**Humidity**: %s%%", p.formatRange(float64(details.MinEnvHumid), float64(details.MaxEnvHumid), 0))
+			humidity += interpret(fmt.Sprintf(" (%s)", bandInterpretation(details, "humidity", metric)))
+		}
+		add(humidity+"\n\n", sectionCore)
 	}
 
 	// Soil Moisture
 	if details.MaxSoilMoist > 0 {
-		summary += fmt.Sprintf("**Soil Moisture**: %d - %d%%", details.MinSoilMoist, details.MaxSoilMoist)
-		summary += interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist)
-		summary += "\n\n"
+		moisture := "**Soil Moisture**: " + bandInterpretation(details, "moisture", metric)
+		if !opts.beginner {
+			moisture = fmt.Sprintf("**Soil Moisture**: %s%%", p.formatRange(float64(details.MinSoilMoist), float64(details.MaxSoilMoist), 0))
+			moisture += interpret(fmt.Sprintf(" (%s)", bandInterpretation(details, "moisture", metric)))
+		}
+		add(moisture+"\n\n", sectionCritical)
 	}
 
 	// Soil EC (Conductivity/Fertilizer)
-	if details.MaxSoilEC > 0 {
-		summary += fmt.Sprintf("**Fertilizer (EC)**: %d - %d µS/cm\n\n", details.MinSoilEC, details.MaxSoilEC)
+	if details.MaxSoilEC > 0 && opts.beginner {
+		level := fertilizerLevel(float64(details.MinSoilEC+details.MaxSoilEC) / 2)
+		add(fmt.Sprintf("**Fertilizer**: %s (%s)\n\n", level, fertilizerAdvice[level]), sectionCore)
+	} else if details.MaxSoilEC > 0 {
+		add(fmt.Sprintf("**Fertilizer (EC)**: %s µS/cm\n\n", p.formatRange(float64(details.MinSoilEC), float64(details.MaxSoilEC), 0)), sectionCore)
+	}
+
+	if opts.separateInterpretation {
+		add(formatInterpretationSection(details, opts.interpretationLang, metric), sectionSupplementary)
+	}
+
+	if opts.thresholdTable && !opts.beginner {
+		add(formatThresholdTable(details, metric, p), sectionSupplementary)
 	}
 
 	if details.ImageURL != "" {
-		summary += fmt.Sprintf("\n[Plant Image](%s)\n", details.ImageURL)
+		add(fmt.Sprintf("\n[Plant Image](%s)\n", details.ImageURL), sectionOptional)
+	}
+
+	return sections
+}
+
+// unitSystemLabel names the unit system in a summary header. Only temperature and light
+// change between systems; percentages and EC read the same in both.
+func unitSystemLabel(metric bool) string {
+	if metric {
+		return "metric (°C, lux; humidity and soil moisture in %, EC in µS/cm)"
+	}
+	return "imperial (°F, foot-candles; humidity and soil moisture in %, EC in µS/cm)"
+}
+
+// formatThresholdTable renders the plant's ranges as a markdown table.
+// Rows follow the prose sections and, like them, skip metrics without data.
+func formatThresholdTable(details *openplantbook.PlantDetails, metric bool, p numberPrecision) string {
+	row := func(label string, min, max float64, usual int, unit string) string {
+		return fmt.Sprintf("| %s | %s | %s | %s |", label, p.format(min, usual), p.format(max, usual), unit)
+	}
+
+	var rows []string
+	if details.MaxLightLux > 0 {
+		if metric {
+			rows = append(rows, row("Light", float64(details.MinLightLux), float64(details.MaxLightLux), 0, "lux"))
+		} else {
+			rows = append(rows, row("Light", luxToFootCandles(float64(details.MinLightLux)), luxToFootCandles(float64(details.MaxLightLux)), 0, "fc"))
+		}
+	}
+	if details.MaxTemp > 0 {
+		if metric {
+			rows = append(rows, row("Temperature", details.MinTemp, details.MaxTemp, 1, "°C"))
+		} else {
+			rows = append(rows, row("Temperature", celsiusToFahrenheit(details.MinTemp), celsiusToFahrenheit(details.MaxTemp), 1, "°F"))
+		}
+	}
+	if details.MaxEnvHumid > 0 {
+		rows = append(rows, row("Humidity", float64(details.MinEnvHumid), float64(details.MaxEnvHumid), 0, "%"))
+	}
+	if details.MaxSoilMoist > 0 {
+		rows = append(rows, row("Soil Moisture", float64(details.MinSoilMoist), float64(details.MaxSoilMoist), 0, "%"))
+	}
+	if details.MaxSoilEC > 0 {
+		rows = append(rows, row("Fertilizer (EC)", float64(details.MinSoilEC), float64(details.MaxSoilEC), 0, "µS/cm"))
+	}
+	if len(rows) == 0 {
+		return ""
 	}
 
-	return summary
+	table := "## Thresholds\n\n| Metric | Min | Max | Unit |\n|--------|-----|-----|------|\n"
+	return table + strings.Join(rows, "\n") + "\n\n"
 }
 
 // interpretLightLevel provides human interpretation of light levels
 func interpretLightLevel(min, max int) string {
 	avg := (min + max) / 2
-	switch {
-	case avg < 2000:
-		return " (Low light - suitable for shade-tolerant plants)"
-	case avg < 10000:
-		return " (Medium indirect light - typical indoor lighting)"
-	case avg < 25000:
-		return " (Bright indirect light - near windows)"
-	default:
-		return " (Full sun or very bright light - direct sunlight)"
+	band := lightBands[len(lightBands)-1]
+	for _, b := range lightBands {
+		if avg < b.below {
+			band = b
+			break
+		}
 	}
+	return fmt.Sprintf(" (%s)", band.description)
+}
+
+// lightBand is a named light level band, keyed by the average of a lux range
+type lightBand struct {
+	below       int // the band covers averages below this value; the last band is open-ended
+	description string
+}
+
+// lightBands lists the light level bands from dimmest to brightest.
+// estimate_lux maps placements back onto these bands.
+var lightBands = []lightBand{
+	{2000, "Low light - suitable for shade-tolerant plants"},
+	{10000, "Medium indirect light - typical indoor lighting"},
+	{25000, "Bright indirect light - near windows"},
+	{0, "Full sun or very bright light - direct sunlight"},
 }
 
 // interpretMoistureLevel provides human interpretation of moisture levels
@@ -457,51 +2241,105 @@ func interpretMoistureLevel(min, max int) string {
 	}
 }
 
-// compareConditions compares current conditions against ideal ranges
-func compareConditions(details *openplantbook.PlantDetails, conditions map[string]interface{}) string {
+// interpretTemperatureRange provides human interpretation of temperature ranges (in °C),
+// quoting the limit that matters in the requested unit
+func interpretTemperatureRange(min, max float64, metric bool) string {
+	limit := func(c float64) string {
+		if metric {
+			return fmt.Sprintf("%.0f°C", c)
+		}
+		return fmt.Sprintf("%.0f°F", celsiusToFahrenheit(c))
+	}
+	avg := (min + max) / 2
+	switch {
+	case avg < 16:
+		return fmt.Sprintf(" (Cool-growing - keep below %s)", limit(max))
+	case avg < 22:
+		return " (Average room temperature - suits most homes)"
+	case avg < 26:
+		return fmt.Sprintf(" (Warm-growing - keep above %s)", limit(min))
+	default:
+		return fmt.Sprintf(" (Tropical warm - keep above %s, away from cold drafts)", limit(min))
+	}
+}
+
+// interpretHumidityLevel provides human interpretation of humidity levels
+func interpretHumidityLevel(min, max int) string {
+	avg := (min + max) / 2
+	switch {
+	case avg < 40:
+		return " (Low humidity - dry indoor air is fine)"
+	case avg < 60:
+		return " (Moderate humidity - mist occasionally in dry weather)"
+	case avg < 75:
+		return " (High humidity - use a pebble tray or humidifier)"
+	default:
+		return " (Very high humidity - best in a terrarium or bathroom)"
+	}
+}
+
+// compareConditions compares current conditions against ideal ranges. With beginner set,
+// light and EC readings are described in plain-language levels instead of lux and µS/cm.
+func compareConditions(details *openplantbook.PlantDetails, conditions map[string]interface{}, metric bool, p numberPrecision, beginner bool) string {
 	analysis := fmt.Sprintf("# Condition Analysis for %s\n\n", details.Alias)
 	issues := []string{}
 	ok := []string{}
 
+	// Temperatures are checked in °C and shown in the caller's unit
+	temp, delta, unit := func(c float64) float64 { return c }, func(c float64) float64 { return c }, "°C"
+	if !metric {
+		temp, delta, unit = celsiusToFahrenheit, func(c float64) float64 { return c * 9 / 5 }, "°F"
+	}
+
 	// Check moisture
 	if moisture, exists := conditions["moisture"].(float64); exists && details.MaxSoilMoist > 0 {
 		min, max := float64(details.MinSoilMoist), float64(details.MaxSoilMoist)
 		if moisture < min {
 			diff := min - moisture
-			issues = append(issues, fmt.Sprintf("❌ **Soil Moisture Too Low**: Current %.1f%%, needs %.0f-%.0f%% (%.1f%% below minimum)", moisture, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Soil Moisture Too Low**: Current %s%%, needs %s-%s%% (%s%% below minimum)", p.format(moisture, 1), p.format(min, 0), p.format(max, 0), p.format(diff, 1)))
 		} else if moisture > max {
 			diff := moisture - max
-			issues = append(issues, fmt.Sprintf("❌ **Soil Moisture Too High**: Current %.1f%%, needs %.0f-%.0f%% (%.1f%% above maximum)", moisture, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Soil Moisture Too High**: Current %s%%, needs %s-%s%% (%s%% above maximum)", p.format(moisture, 1), p.format(min, 0), p.format(max, 0), p.format(diff, 1)))
 		} else {
-			ok = append(ok, fmt.Sprintf("✅ **Soil Moisture**: %.1f%% (within %.0f-%.0f%% range)", moisture, min, max))
+			ok = append(ok, fmt.Sprintf("✅ **Soil Moisture**: %s%% (within %s-%s%% range)", p.format(moisture, 1), p.format(min, 0), p.format(max, 0)))
 		}
 	}
 
 	// Check temperature
-	if temp, exists := conditions["temperature"].(float64); exists && details.MaxTemp > 0 {
+	if reading, exists := conditions["temperature"].(float64); exists && details.MaxTemp > 0 {
+		current := reading
+		if !metric {
+			current = fahrenheitToCelsius(reading)
+		}
 		min, max := details.MinTemp, details.MaxTemp
-		if temp < min {
-			diff := min - temp
-			issues = append(issues, fmt.Sprintf("❌ **Temperature Too Low**: Current %.1f°C, needs %.1f-%.1f°C (%.1f°C below minimum)", temp, min, max, diff))
-		} else if temp > max {
-			diff := temp - max
-			issues = append(issues, fmt.Sprintf("❌ **Temperature Too High**: Current %.1f°C, needs %.1f-%.1f°C (%.1f°C above maximum)", temp, min, max, diff))
+		if current < min {
+			diff := min - current
+			issues = append(issues, fmt.Sprintf("❌ **Temperature Too Low**: Current %s%s, needs %s-%s%s (%s%s below minimum)", p.format(reading, 1), unit, p.format(temp(min), 1), p.format(temp(max), 1), unit, p.format(delta(diff), 1), unit))
+		} else if current > max {
+			diff := current - max
+			issues = append(issues, fmt.Sprintf("❌ **Temperature Too High**: Current %s%s, needs %s-%s%s (%s%s above maximum)", p.format(reading, 1), unit, p.format(temp(min), 1), p.format(temp(max), 1), unit, p.format(delta(diff), 1), unit))
 		} else {
-			ok = append(ok, fmt.Sprintf("✅ **Temperature**: %.1f°C (within %.1f-%.1f°C range)", temp, min, max))
+			ok = append(ok, fmt.Sprintf("✅ **Temperature**: %s%s (within %s-%s%s range)", p.format(reading, 1), unit, p.format(temp(min), 1), p.format(temp(max), 1), unit))
 		}
 	}
 
 	// Check light
 	if light, exists := conditions["light_lux"].(float64); exists && details.MaxLightLux > 0 {
 		min, max := float64(details.MinLightLux), float64(details.MaxLightLux)
-		if light < min {
+		if beginner {
+			if line, inRange := beginnerLightCheck(light, min, max); inRange {
+				ok = append(ok, line)
+			} else {
+				issues = append(issues, line)
+			}
+		} else if light < min {
 			diff := min - light
-			issues = append(issues, fmt.Sprintf("❌ **Light Too Low**: Current %.0f lux, needs %.0f-%.0f lux (%.0f lux below minimum)", light, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Light Too Low**: Current %s lux, needs %s-%s lux (%s lux below minimum)", p.format(light, 0), p.format(min, 0), p.format(max, 0), p.format(diff, 0)))
 		} else if light > max {
 			diff := light - max
-			issues = append(issues, fmt.Sprintf("❌ **Light Too High**: Current %.0f lux, needs %.0f-%.0f lux (%.0f lux above maximum)", light, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Light Too High**: Current %s lux, needs %s-%s lux (%s lux above maximum)", p.format(light, 0), p.format(min, 0), p.format(max, 0), p.format(diff, 0)))
 		} else {
-			ok = append(ok, fmt.Sprintf("✅ **Light**: %.0f lux (within %.0f-%.0f lux range)", light, min, max))
+			ok = append(ok, fmt.Sprintf("✅ **Light**: %s lux (within %s-%s lux range)", p.format(light, 0), p.format(min, 0), p.format(max, 0)))
 		}
 	}
 
@@ -510,12 +2348,32 @@ func compareConditions(details *openplantbook.PlantDetails, conditions map[strin
 		min, max := float64(details.MinEnvHumid), float64(details.MaxEnvHumid)
 		if humid < min {
 			diff := min - humid
-			issues = append(issues, fmt.Sprintf("❌ **Humidity Too Low**: Current %.1f%%, needs %.0f-%.0f%% (%.1f%% below minimum)", humid, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Humidity Too Low**: Current %s%%, needs %s-%s%% (%s%% below minimum)", p.format(humid, 1), p.format(min, 0), p.format(max, 0), p.format(diff, 1)))
 		} else if humid > max {
 			diff := humid - max
-			issues = append(issues, fmt.Sprintf("❌ **Humidity Too High**: Current %.1f%%, needs %.0f-%.0f%% (%.1f%% above maximum)", humid, min, max, diff))
+			issues = append(issues, fmt.Sprintf("❌ **Humidity Too High**: Current %s%%, needs %s-%s%% (%s%% above maximum)", p.format(humid, 1), p.format(min, 0), p.format(max, 0), p.format(diff, 1)))
+		} else {
+			ok = append(ok, fmt.Sprintf("✅ **Humidity**: %s%% (within %s-%s%% range)", p.format(humid, 1), p.format(min, 0), p.format(max, 0)))
+		}
+	}
+
+	// Check soil EC
+	if ec, exists := conditions["soil_ec"].(float64); exists && details.MaxSoilEC > 0 {
+		min, max := float64(details.MinSoilEC), float64(details.MaxSoilEC)
+		if beginner {
+			if line, inRange := beginnerFertilizerCheck(ec, min, max); inRange {
+				ok = append(ok, line)
+			} else {
+				issues = append(issues, line)
+			}
+		} else if ec < min {
+			diff := min - ec
+			issues = append(issues, fmt.Sprintf("❌ **Soil EC Too Low**: Current %s µS/cm, needs %s-%s µS/cm (%s µS/cm below minimum)", p.format(ec, 0), p.format(min, 0), p.format(max, 0), p.format(diff, 0)))
+		} else if ec > max {
+			diff := ec - max
+			issues = append(issues, fmt.Sprintf("❌ **Soil EC Too High**: Current %s µS/cm, needs %s-%s µS/cm (%s µS/cm above maximum)", p.format(ec, 0), p.format(min, 0), p.format(max, 0), p.format(diff, 0)))
 		} else {
-			ok = append(ok, fmt.Sprintf("✅ **Humidity**: %.1f%% (within %.0f-%.0f%% range)", humid, min, max))
+			ok = append(ok, fmt.Sprintf("✅ **Soil EC**: %s µS/cm (within %s-%s µS/cm range)", p.format(ec, 0), p.format(min, 0), p.format(max, 0)))
 		}
 	}
 
@@ -549,7 +2407,7 @@ func compareConditions(details *openplantbook.PlantDetails, conditions map[strin
 
 // handleServerInfo handles the server_info tool
 func (s *Server) handleServerInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	traceID := xid.New().String()
+	traceID := traceIDFromContext(ctx)
 	logger := s.logger.With("trace_id", traceID, "tool", "server_info")
 
 	logger.Info("retrieving server info")
@@ -557,7 +2415,7 @@ func (s *Server) handleServerInfo(ctx context.Context, request mcp.CallToolReque
 	// Build info response
 	info := map[string]interface{}{
 		"server": map[string]interface{}{
-			"name":    "openplantbook-mcp",
+			"name":    s.serverName(),
 			"version": s.version,
 		},
 		"sdk": map[string]interface{}{
@@ -571,15 +2429,21 @@ func (s *Server) handleServerInfo(ctx context.Context, request mcp.CallToolReque
 		},
 		"runtime": map[string]interface{}{
 			"pid":             os.Getpid(),
-			"tools_available": 5,
+			"tools_available": s.toolCount,
+			"api_usage":       s.usageSnapshot(),
 		},
 		"config": map[string]interface{}{
-			"cache_enabled":    s.config.CacheEnabled,
-			"cache_ttl_hours":  s.config.CacheTTL,
-			"default_language": s.config.DefaultLang,
-			"log_level":        s.config.LogLevel.String(),
-			"log_file":         s.config.LogFile,
-			"auth_method":      getAuthMethod(s.config),
+			"cache_enabled":         s.config.CacheEnabled,
+			"cache_ttl_hours":       s.config.CacheTTL,
+			"cache_max_entries":     s.cacheMaxEntries(),
+			"cache_backend":         s.cacheBackend(),
+			"transport":             s.transport(),
+			"rate_limit_per_minute": s.config.RateLimitPerMinute,
+			"default_language":      s.config.DefaultLang,
+			"language_fallback":     s.config.LanguageFallback,
+			"log_level":             s.config.LogLevel.String(),
+			"log_file":              s.config.LogFile,
+			"auth_method":           getAuthMethod(s.config),
 		},
 	}
 
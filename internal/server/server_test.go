@@ -3,11 +3,18 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	mcp "github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
 	openplantbook "github.com/rmrfslashbin/openplantbook-go"
 )
 
@@ -35,11 +42,99 @@ func setupTestServer(t *testing.T) *Server {
 	return srv
 }
 
+// fakeClient is an in-memory plantClient for tests that must not hit the API
+type fakeClient struct {
+	mu sync.Mutex
+
+	// details is keyed by "pid|language"; a "pid|" key matches any language
+	details map[string]*openplantbook.PlantDetails
+	search  map[string][]openplantbook.PlantSearchResult
+
+	// detailErrs forces GetPlantDetails to fail for a pid, in any language
+	detailErrs map[string]error
+
+	// searchErr forces every SearchPlants call to fail
+	searchErr error
+
+	detailCalls []string // "pid|language" in call order
+	searchCalls []string
+}
+
+func (f *fakeClient) SearchPlants(ctx context.Context, query string, opts *openplantbook.SearchOptions) ([]openplantbook.PlantSearchResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.searchCalls = append(f.searchCalls, query)
+	if f.searchErr != nil {
+		return nil, f.searchErr
+	}
+	return f.search[query], nil
+}
+
+func (f *fakeClient) GetPlantDetails(ctx context.Context, pid string, opts *openplantbook.DetailOptions) (*openplantbook.PlantDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	lang := ""
+	if opts != nil {
+		lang = opts.Language
+	}
+	f.detailCalls = append(f.detailCalls, pid+"|"+lang)
+
+	if err, ok := f.detailErrs[pid]; ok {
+		return nil, err
+	}
+
+	details, ok := f.details[pid+"|"+lang]
+	if !ok {
+		details, ok = f.details[pid+"|"]
+	}
+	if !ok {
+		return nil, fmt.Errorf("get plant details: %w", openplantbook.ErrNotFound)
+	}
+
+	// Return a copy so handlers can't mutate the fixture
+	copied := *details
+	return &copied, nil
+}
+
+// newTestServer builds a Server around a fake client without touching the network
+func newTestServer(t *testing.T, client plantClient) *Server {
+	t.Helper()
+
+	return &Server{
+		client: client,
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		config: &Config{
+			APIKey:           "test-key",
+			LogLevel:         slog.LevelDebug,
+			DefaultLang:      "en",
+			LanguageFallback: []string{"en"},
+		},
+		version: "test-version",
+	}
+}
+
+// resultText returns the text of the first content item of a tool result
+func resultText(t *testing.T, result *mcp.CallToolResult) string {
+	t.Helper()
+
+	if result == nil || len(result.Content) == 0 {
+		t.Fatal("expected content in result")
+	}
+	textContent, ok := mcp.AsTextContent(result.Content[0])
+	if !ok {
+		t.Fatal("expected TextContent")
+	}
+	return textContent.Text
+}
+
 func TestServer_New(t *testing.T) {
 	tests := []struct {
-		name    string
-		config  *Config
-		wantErr bool
+		name        string
+		config      *Config
+		wantErr     bool
+		wantAuthErr bool
 	}{
 		{
 			name: "valid API key config",
@@ -66,7 +161,59 @@ func TestServer_New(t *testing.T) {
 				LogLevel:    slog.LevelInfo,
 				DefaultLang: "en",
 			},
-			wantErr: true,
+			wantErr:     true,
+			wantAuthErr: true,
+		},
+		{
+			name: "API key with trailing newline",
+			config: &Config{
+				APIKey:      "test-key\n",
+				LogLevel:    slog.LevelInfo,
+				DefaultLang: "en",
+			},
+			wantErr:     true,
+			wantAuthErr: true,
+		},
+		{
+			name: "API key with embedded space",
+			config: &Config{
+				APIKey:      "test key",
+				LogLevel:    slog.LevelInfo,
+				DefaultLang: "en",
+			},
+			wantErr:     true,
+			wantAuthErr: true,
+		},
+		{
+			name: "OAuth2 missing secret",
+			config: &Config{
+				ClientID:    "test-id",
+				LogLevel:    slog.LevelInfo,
+				DefaultLang: "en",
+			},
+			wantErr:     true,
+			wantAuthErr: true,
+		},
+		{
+			name: "OAuth2 missing client ID",
+			config: &Config{
+				ClientSecret: "test-secret",
+				LogLevel:     slog.LevelInfo,
+				DefaultLang:  "en",
+			},
+			wantErr:     true,
+			wantAuthErr: true,
+		},
+		{
+			name: "OAuth2 secret with whitespace",
+			config: &Config{
+				ClientID:     "test-id",
+				ClientSecret: " test-secret",
+				LogLevel:     slog.LevelInfo,
+				DefaultLang:  "en",
+			},
+			wantErr:     true,
+			wantAuthErr: true,
 		},
 		{
 			name: "multiple auth config - API key takes precedence",
@@ -87,6 +234,9 @@ func TestServer_New(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if tt.wantAuthErr && !errors.Is(err, ErrAuthConfig) {
+				t.Errorf("New() error = %v, want ErrAuthConfig", err)
+			}
 		})
 	}
 }
@@ -122,18 +272,18 @@ func TestServer_HandleSearchPlants(t *testing.T) {
 				}
 
 				// Parse the JSON response
-				var searchResults []openplantbook.PlantSearchResult
-				if err := json.Unmarshal([]byte(textContent.Text), &searchResults); err != nil {
+				var page searchPage
+				if err := json.Unmarshal([]byte(textContent.Text), &page); err != nil {
 					t.Errorf("failed to parse result: %v", err)
 					return
 				}
 
-				if len(searchResults) == 0 {
+				if len(page.Results) == 0 {
 					t.Error("expected search results")
 				}
 
-				t.Logf("Found %d plants", len(searchResults))
-				for _, plant := range searchResults {
+				t.Logf("Found %d plants", len(page.Results))
+				for _, plant := range page.Results {
 					t.Logf("  - %s (PID: %s)", plant.DisplayPID, plant.PID)
 				}
 			},
@@ -332,9 +482,14 @@ func TestServer_HandleGetCareSummary(t *testing.T) {
 					return
 				}
 
-				// Imperial units should show °F
-				// Note: We'll add this in the future
 				t.Logf("Care summary (imperial):\n%s", textContent.Text)
+
+				// Imperial units show °F and foot-candles
+				for _, want := range []string{"Units: imperial", "°F", " fc"} {
+					if !strings.Contains(textContent.Text, want) {
+						t.Errorf("imperial summary missing %q", want)
+					}
+				}
 			},
 		},
 	}
@@ -478,6 +633,85 @@ func TestServer_HandleCompareConditions(t *testing.T) {
 	}
 }
 
+func TestCompareConditions_SoilEC(t *testing.T) {
+	details := &openplantbook.PlantDetails{Alias: "Monstera", MinSoilEC: 350, MaxSoilEC: 1200, MinTemp: 12, MaxTemp: 32}
+
+	tests := []struct {
+		name    string
+		details *openplantbook.PlantDetails
+		ec      float64
+		want    []string
+		notWant string
+	}{
+		{"above max", details, 1800, []string{"❌ **Soil EC Too High**: Current 1800 µS/cm, needs 350-1200 µS/cm (600 µS/cm above maximum)", "1 condition(s) need attention"}, "✅ **Soil EC**"},
+		{"below min", details, 200, []string{"❌ **Soil EC Too Low**: Current 200 µS/cm", "(150 µS/cm below minimum)"}, "Too High"},
+		{"in range", details, 800, []string{"✅ **Soil EC**: 800 µS/cm (within 350-1200 µS/cm range)", "All monitored conditions"}, "❌"},
+		{"no EC data", &openplantbook.PlantDetails{Alias: "Monstera", MinTemp: 12, MaxTemp: 32}, 5000, []string{"✅ **Temperature**"}, "Soil EC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := compareConditions(tt.details, map[string]interface{}{"soil_ec": tt.ec, "temperature": 22.0}, true, numberPrecision{}, false)
+			for _, want := range tt.want {
+				if !strings.Contains(analysis, want) {
+					t.Errorf("analysis missing %q:\n%s", want, analysis)
+				}
+			}
+			if strings.Contains(analysis, tt.notWant) {
+				t.Errorf("analysis should not contain %q:\n%s", tt.notWant, analysis)
+			}
+		})
+	}
+}
+
+func TestServer_EmptyPlantDetails(t *testing.T) {
+	// An entry stub: the plant exists but the API returned no care ranges
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"stub plant|": {PID: "stub plant", DisplayPID: "Stub plant", Alias: "stub"},
+	}}
+	srv := newTestServer(t, client)
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		handler func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args    map[string]interface{}
+	}{
+		{"get_care_summary", srv.handleGetCareSummary, map[string]interface{}{"pid": "stub plant"}},
+		{"compare_conditions", srv.handleCompareConditions, map[string]interface{}{
+			"pid":                "stub plant",
+			"current_conditions": map[string]interface{}{"moisture": 40.0},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+
+			result, err := tt.handler(ctx, request)
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if !result.IsError {
+				t.Error("expected an error result for a plant without care data")
+			}
+			if text := resultText(t, result); !strings.Contains(text, "care data is unavailable for this plant") {
+				t.Errorf("unexpected message: %s", text)
+			}
+		})
+	}
+}
+
+func TestHasCareData(t *testing.T) {
+	if hasCareData(nil) || hasCareData(&openplantbook.PlantDetails{Alias: "stub"}) {
+		t.Error("expected no care data for nil and stub details")
+	}
+	if !hasCareData(&openplantbook.PlantDetails{MaxSoilEC: 1000}) {
+		t.Error("expected an EC range alone to count as care data")
+	}
+}
+
 func TestInterpretLightLevel(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -528,3 +762,229 @@ func TestInterpretMoistureLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestInterpretTemperatureRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max float64
+		metric   bool
+		expected string
+	}{
+		{"cool", 5, 20, true, " (Cool-growing - keep below 20°C)"},
+		{"cool imperial", 5, 20, false, " (Cool-growing - keep below 68°F)"},
+		{"average", 15, 25, true, " (Average room temperature - suits most homes)"},
+		{"warm", 18, 30, true, " (Warm-growing - keep above 18°C)"},
+		{"tropical", 20, 35, true, " (Tropical warm - keep above 20°C, away from cold drafts)"},
+		{"tropical imperial", 20, 35, false, " (Tropical warm - keep above 68°F, away from cold drafts)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := interpretTemperatureRange(tt.min, tt.max, tt.metric)
+			if result != tt.expected {
+				t.Errorf("interpretTemperatureRange(%v, %v, %v) = %q, want %q",
+					tt.min, tt.max, tt.metric, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInterpretHumidityLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		minHumid int
+		maxHumid int
+		expected string
+	}{
+		{"low", 10, 40, " (Low humidity - dry indoor air is fine)"},
+		{"moderate", 40, 60, " (Moderate humidity - mist occasionally in dry weather)"},
+		{"high", 60, 80, " (High humidity - use a pebble tray or humidifier)"},
+		{"very high", 70, 90, " (Very high humidity - best in a terrarium or bathroom)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := interpretHumidityLevel(tt.minHumid, tt.maxHumid)
+			if result != tt.expected {
+				t.Errorf("interpretHumidityLevel(%d, %d) = %q, want %q",
+					tt.minHumid, tt.maxHumid, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClassifyClientError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected error
+	}{
+		{"no auth", openplantbook.ErrNoAuthProvided, ErrAuthConfig},
+		{"multiple auth", openplantbook.ErrMultipleAuthMethods, ErrAuthConfig},
+		{"empty credential", openplantbook.ErrInvalidConfig("API key cannot be empty"), ErrAuthConfig},
+		{"other failure", errors.New("dial tcp: connection refused"), ErrClientInit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := classifyClientError(tt.err)
+			if !errors.Is(err, tt.expected) {
+				t.Errorf("classifyClientError() = %v, want %v", err, tt.expected)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("classifyClientError() = %v, should wrap %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestServer_AddToolAuthTier(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *Config
+		wantWrite bool
+	}{
+		{"api key is read-only", &Config{APIKey: "test-key"}, false},
+		{"oauth2 allows writes", &Config{ClientID: "test-id", ClientSecret: "test-secret"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, &fakeClient{})
+			srv.config = tt.config
+			mcpServer := server.NewMCPServer("test", "test")
+
+			noop := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				return mcp.NewToolResultText("ok"), nil
+			}
+			srv.addTool(mcpServer, readAccess, mcp.Tool{Name: "read_tool"}, noop)
+			srv.addTool(mcpServer, writeAccess, mcp.Tool{Name: "write_tool"}, noop)
+
+			tools := mcpServer.ListTools()
+			if _, ok := tools["read_tool"]; !ok {
+				t.Error("read tool should always be registered")
+			}
+			if _, ok := tools["write_tool"]; ok != tt.wantWrite {
+				t.Errorf("write tool registered = %v, want %v", ok, tt.wantWrite)
+			}
+		})
+	}
+}
+
+func TestServer_NewMCPServerIdentity(t *testing.T) {
+	tests := []struct {
+		name             string
+		serverName       string
+		instructions     string
+		wantName         string
+		wantInstructions string
+	}{
+		{"defaults", "", "", defaultServerName, ""},
+		{"overridden", "acme-plants", "Ask about houseplants.", "acme-plants", "Ask about houseplants."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newTestServer(t, &fakeClient{})
+			srv.config.ServerName = tt.serverName
+			srv.config.ServerInstructions = tt.instructions
+
+			init := `{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}}`
+			response := srv.newMCPServer().HandleMessage(context.Background(), json.RawMessage(init))
+
+			raw, err := json.Marshal(response)
+			if err != nil {
+				t.Fatalf("marshal response: %v", err)
+			}
+			var decoded struct {
+				Result mcp.InitializeResult `json:"result"`
+			}
+			if err := json.Unmarshal(raw, &decoded); err != nil {
+				t.Fatalf("decode response: %v", err)
+			}
+
+			if decoded.Result.ServerInfo.Name != tt.wantName {
+				t.Errorf("server name = %q, want %q", decoded.Result.ServerInfo.Name, tt.wantName)
+			}
+			if decoded.Result.ServerInfo.Version != "test-version" {
+				t.Errorf("server version = %q, want test-version", decoded.Result.ServerInfo.Version)
+			}
+			if decoded.Result.Instructions != tt.wantInstructions {
+				t.Errorf("instructions = %q, want %q", decoded.Result.Instructions, tt.wantInstructions)
+			}
+
+			result, err := srv.handleServerInfo(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatalf("handleServerInfo() error = %v", err)
+			}
+			var info struct {
+				Server struct {
+					Name string `json:"name"`
+				} `json:"server"`
+			}
+			if err := json.Unmarshal([]byte(resultText(t, result)), &info); err != nil {
+				t.Fatalf("decode server_info: %v", err)
+			}
+			if info.Server.Name != tt.wantName {
+				t.Errorf("server_info name = %q, want %q", info.Server.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestServer_LazyClient(t *testing.T) {
+	t.Run("New does not build the client", func(t *testing.T) {
+		srv, err := New(&Config{APIKey: "test-key", DefaultLang: "en"}, "test")
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if srv.client != nil {
+			t.Error("client should not be created until first use")
+		}
+		if err := srv.Ping(); err != nil {
+			t.Fatalf("Ping() error = %v", err)
+		}
+		if srv.client == nil {
+			t.Error("Ping should create the client")
+		}
+	})
+
+	t.Run("concurrent first use builds once", func(t *testing.T) {
+		srv := newTestServer(t, nil)
+		var built atomic.Int32
+		srv.newClient = func() (plantClient, error) {
+			built.Add(1)
+			return &fakeClient{}, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := srv.apiClient(); err != nil {
+					t.Errorf("apiClient() error = %v", err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if built.Load() != 1 {
+			t.Errorf("client built %d times, want 1", built.Load())
+		}
+	})
+
+	t.Run("construction error reaches tool calls", func(t *testing.T) {
+		srv := newTestServer(t, nil)
+		srv.newClient = func() (plantClient, error) {
+			return nil, fmt.Errorf("%w: boom", ErrClientInit)
+		}
+
+		if err := srv.Ping(); !errors.Is(err, ErrClientInit) {
+			t.Errorf("Ping() error = %v, want ErrClientInit", err)
+		}
+		if _, err := srv.fetchDetails(context.Background(), "monstera deliciosa", "en"); !errors.Is(err, ErrClientInit) {
+			t.Errorf("fetchDetails() error = %v, want ErrClientInit", err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// settlingMetrics are the readings that swing right after watering or moving a plant
+var settlingMetrics = map[string]bool{
+	"moisture": true,
+	"humidity": true,
+}
+
+// settlingWindow is the opt-in grace period after a care action
+type settlingWindow struct {
+	minutes    float64 // length of the window after the disturbance
+	ageMinutes float64 // minutes between the disturbance and the reading
+}
+
+// active reports whether the reading was taken inside the window
+func (w settlingWindow) active() bool {
+	return w.minutes > 0 && w.ageMinutes < w.minutes
+}
+
+// parseSettlingWindow reads settling_minutes and reading_age_minutes. Both are optional,
+// but a window needs the reading's age to mean anything.
+func parseSettlingWindow(request mcp.CallToolRequest) (settlingWindow, error) {
+	args := request.GetArguments()
+	minutes, hasWindow := args["settling_minutes"].(float64)
+	age, hasAge := args["reading_age_minutes"].(float64)
+
+	switch {
+	case !hasWindow && args["settling_minutes"] != nil:
+		return settlingWindow{}, fmt.Errorf("settling_minutes must be a number of minutes")
+	case !hasWindow:
+		return settlingWindow{}, nil
+	case minutes < 0:
+		return settlingWindow{}, fmt.Errorf("settling_minutes must not be negative")
+	case !hasAge:
+		return settlingWindow{}, fmt.Errorf("reading_age_minutes is required with settling_minutes: minutes between the watering or move and the reading")
+	case age < 0:
+		return settlingWindow{}, fmt.Errorf("reading_age_minutes must not be negative")
+	}
+	return settlingWindow{minutes: minutes, ageMinutes: age}, nil
+}
+
+// settleConditions removes out-of-range moisture and humidity readings taken inside the
+// settling window and describes them as informational notes instead. In-range readings
+// stay, so they are still reported as fine.
+func settleConditions(details *openplantbook.PlantDetails, conditions map[string]interface{}, w settlingWindow) (map[string]interface{}, []string) {
+	if !w.active() {
+		return conditions, nil
+	}
+
+	kept := make(map[string]interface{}, len(conditions))
+	var notes []string
+	for key, value := range conditions {
+		kept[key] = value
+	}
+	for _, m := range careMetrics {
+		value, ok := conditions[m.key].(float64)
+		if !ok || !settlingMetrics[m.key] {
+			continue
+		}
+		min, max, hasData := m.ideal(details)
+		if !hasData || (value >= min && value <= max) {
+			continue
+		}
+		direction := "below"
+		if value > max {
+			direction = "above"
+		}
+		notes = append(notes, fmt.Sprintf("ℹ️ **%s**: %g%s is %s the %g-%g%s range, which is expected while the plant settles", m.label, value, m.unit, direction, min, max, m.unit))
+		delete(kept, m.key)
+	}
+	return kept, notes
+}
+
+// formatSettlingNotes renders the informational section
+func formatSettlingNotes(notes []string, w settlingWindow) string {
+	if len(notes) == 0 {
+		return ""
+	}
+	output := "## Settling (informational)\n\n"
+	for _, note := range notes {
+		output += note + "\n\n"
+	}
+	output += fmt.Sprintf("_The reading was taken %g minutes into a %g-minute settling window after watering or moving the plant. Check again once the window has passed._\n", w.ageMinutes, w.minutes)
+	return output
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestParseSettlingWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		want    settlingWindow
+		wantErr bool
+	}{
+		{"not requested", map[string]interface{}{}, settlingWindow{}, false},
+		{"window and age", map[string]interface{}{"settling_minutes": 60.0, "reading_age_minutes": 15.0}, settlingWindow{minutes: 60, ageMinutes: 15}, false},
+		{"window without age", map[string]interface{}{"settling_minutes": 60.0}, settlingWindow{}, true},
+		{"negative window", map[string]interface{}{"settling_minutes": -5.0, "reading_age_minutes": 1.0}, settlingWindow{}, true},
+		{"negative age", map[string]interface{}{"settling_minutes": 60.0, "reading_age_minutes": -1.0}, settlingWindow{}, true},
+		{"non-numeric window", map[string]interface{}{"settling_minutes": "an hour"}, settlingWindow{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			got, err := parseSettlingWindow(request)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSettlingWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseSettlingWindow() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSettleConditions(t *testing.T) {
+	details := &openplantbook.PlantDetails{MinSoilMoist: 20, MaxSoilMoist: 60, MinTemp: 18, MaxTemp: 27, MinEnvHumid: 40, MaxEnvHumid: 70}
+	conditions := map[string]interface{}{"moisture": 85.0, "humidity": 55.0, "temperature": 35.0}
+
+	kept, notes := settleConditions(details, conditions, settlingWindow{minutes: 60, ageMinutes: 10})
+	if _, ok := kept["moisture"]; ok {
+		t.Error("expected out-of-range moisture to be settled")
+	}
+	if _, ok := kept["humidity"]; !ok {
+		t.Error("expected in-range humidity to be kept")
+	}
+	if _, ok := kept["temperature"]; !ok {
+		t.Error("expected temperature to always be kept")
+	}
+	if len(notes) != 1 || !strings.Contains(notes[0], "85% is above the 20-60% range") {
+		t.Errorf("unexpected notes: %v", notes)
+	}
+	if _, ok := conditions["moisture"]; !ok {
+		t.Error("settleConditions must not modify its input")
+	}
+
+	kept, notes = settleConditions(details, conditions, settlingWindow{minutes: 60, ageMinutes: 90})
+	if len(kept) != len(conditions) || notes != nil {
+		t.Errorf("expected no change outside the window, got %v %v", kept, notes)
+	}
+}
+
+func TestHandleCompareConditions_Settling(t *testing.T) {
+	plant := &openplantbook.PlantDetails{PID: "basil", Alias: "basil", MinSoilMoist: 20, MaxSoilMoist: 60, MinTemp: 18, MaxTemp: 27}
+	s := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{"basil|": plant, "basil|en": plant}})
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"pid":                 "basil",
+		"current_conditions":  map[string]interface{}{"moisture": 85.0, "temperature": 35.0},
+		"settling_minutes":    120.0,
+		"reading_age_minutes": 30.0,
+	}
+	result, err := s.handleCompareConditions(context.Background(), req)
+	if err != nil || result.IsError {
+		t.Fatalf("compare_conditions failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Temperature Too High", "## Settling (informational)", "Soil Moisture**: 85%", "30 minutes into a 120-minute settling window"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Soil Moisture Too High") {
+		t.Errorf("expected moisture alert to be downgraded:\n%s", text)
+	}
+}
@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// shelfLevel is one level of a shelf and the light it receives
+type shelfLevel struct {
+	name string
+	lux  float64
+}
+
+// parseShelfLevels accepts an array of lux numbers or {"name", "lux"} objects, top shelf first
+func parseShelfLevels(raw interface{}) ([]shelfLevel, error) {
+	items, ok := raw.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("shelves parameter is required and must be a non-empty array")
+	}
+
+	levels := make([]shelfLevel, 0, len(items))
+	for i, item := range items {
+		level := shelfLevel{name: fmt.Sprintf("Shelf %d", i+1)}
+		switch v := item.(type) {
+		case float64:
+			level.lux = v
+		case map[string]interface{}:
+			lux, ok := v["lux"].(float64)
+			if !ok {
+				return nil, fmt.Errorf("shelf %d: lux must be a number", i+1)
+			}
+			level.lux = lux
+			if name, ok := v["name"].(string); ok && name != "" {
+				level.name = name
+			}
+		default:
+			return nil, fmt.Errorf("shelf %d must be a lux number or a {name, lux} object", i+1)
+		}
+		if level.lux < 0 {
+			return nil, fmt.Errorf("shelf %d: lux must not be negative", i+1)
+		}
+		levels = append(levels, level)
+	}
+	return levels, nil
+}
+
+// shelfAssignment is the placement decision for one plant
+type shelfAssignment struct {
+	plant  *openplantbook.PlantDetails
+	shelf  *shelfLevel // nil when the plant can't be placed
+	reason string
+}
+
+// logLuxDistance measures how far lux is from the middle of [min, max] on a log scale,
+// since perceived brightness (and plant response) is roughly logarithmic
+func logLuxDistance(lux, min, max float64) float64 {
+	mid := math.Sqrt(math.Max(min, 1) * math.Max(max, 1))
+	return math.Abs(math.Log(math.Max(lux, 1)) - math.Log(mid))
+}
+
+// placeOnShelf picks the shelf whose lux falls within the plant's range and is closest
+// to the middle of it. Plants with no shelf in range are unplaceable; the nearest shelf
+// is named in the reason.
+func placeOnShelf(details *openplantbook.PlantDetails, levels []shelfLevel) shelfAssignment {
+	assignment := shelfAssignment{plant: details}
+	if details.MaxLightLux <= 0 {
+		assignment.reason = "no light data for this plant"
+		return assignment
+	}
+
+	min, max := float64(details.MinLightLux), float64(details.MaxLightLux)
+	best, nearest := -1, -1
+	for i, level := range levels {
+		if nearest < 0 || distanceOutside(level.lux, min, max) < distanceOutside(levels[nearest].lux, min, max) {
+			nearest = i
+		}
+		if level.lux < min || level.lux > max {
+			continue
+		}
+		if best < 0 || logLuxDistance(level.lux, min, max) < logLuxDistance(levels[best].lux, min, max) {
+			best = i
+		}
+	}
+
+	if best < 0 {
+		n := levels[nearest]
+		direction := "too dim"
+		if n.lux > max {
+			direction = "too bright"
+		}
+		assignment.reason = fmt.Sprintf("needs %g-%g lux; the closest shelf (%s, %g lux) is %s", min, max, n.name, n.lux, direction)
+		return assignment
+	}
+
+	assignment.shelf = &levels[best]
+	assignment.reason = fmt.Sprintf("%g lux is within its %g-%g lux range", levels[best].lux, min, max)
+	return assignment
+}
+
+// handleShelfPlacement handles the shelf_placement tool
+func (s *Server) handleShelfPlacement(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "shelf_placement")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	levels, err := parseShelfLevels(request.GetArguments()["shelves"])
+	if err != nil {
+		logger.Warn("invalid shelves parameter", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("planning shelf placement", "pids", len(pids), "shelves", len(levels))
+
+	placed := make(map[string][]string, len(levels))
+	var unplaceable, failed []string
+	for _, fetched := range s.fetchPlantsConcurrently(ctx, pids) {
+		if fetched.err != nil {
+			failed = append(failed, fmt.Sprintf("%s (%v)", fetched.pid, fetched.err))
+			continue
+		}
+		a := placeOnShelf(fetched.details, levels)
+		label := fmt.Sprintf("%s (%s)", a.plant.Alias, a.plant.PID)
+		if a.shelf == nil {
+			unplaceable = append(unplaceable, fmt.Sprintf("%s: %s", label, a.reason))
+			continue
+		}
+		placed[a.shelf.name] = append(placed[a.shelf.name], fmt.Sprintf("%s: %s", label, a.reason))
+	}
+
+	output := "# Shelf Placement\n\n"
+	for _, level := range levels {
+		output += fmt.Sprintf("## %s (%g lux)\n\n", level.name, level.lux)
+		if len(placed[level.name]) == 0 {
+			output += "_Empty_\n\n"
+			continue
+		}
+		for _, line := range placed[level.name] {
+			output += fmt.Sprintf("- %s\n", line)
+		}
+		output += "\n"
+	}
+	if len(unplaceable) > 0 {
+		output += "## Unplaceable\n\n"
+		for _, line := range unplaceable {
+			output += fmt.Sprintf("- %s\n", line)
+		}
+		output += "\n"
+	}
+	if len(failed) > 0 {
+		output += "## Not Found\n\n"
+		for _, line := range failed {
+			output += fmt.Sprintf("- %s\n", line)
+		}
+	}
+
+	logger.Info("shelf placement completed", "unplaceable", len(unplaceable), "failed", len(failed))
+
+	return mcp.NewToolResultText(output), nil
+}
@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestPlaceOnShelf(t *testing.T) {
+	levels := []shelfLevel{{"Top", 20000}, {"Middle", 5000}, {"Bottom", 800}}
+
+	tests := []struct {
+		name      string
+		details   *openplantbook.PlantDetails
+		wantShelf string
+		wantInfo  string
+	}{
+		{"sun lover on top", &openplantbook.PlantDetails{MinLightLux: 10000, MaxLightLux: 80000}, "Top", ""},
+		{"middle of range preferred", &openplantbook.PlantDetails{MinLightLux: 500, MaxLightLux: 30000}, "Middle", ""},
+		{"shade plant at bottom", &openplantbook.PlantDetails{MinLightLux: 300, MaxLightLux: 2000}, "Bottom", ""},
+		{"too bright everywhere", &openplantbook.PlantDetails{MinLightLux: 50, MaxLightLux: 500}, "", "closest shelf (Bottom, 800 lux) is too bright"},
+		{"no light data", &openplantbook.PlantDetails{}, "", "no light data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := placeOnShelf(tt.details, levels)
+			got := ""
+			if a.shelf != nil {
+				got = a.shelf.name
+			}
+			if got != tt.wantShelf {
+				t.Errorf("shelf = %q, want %q (%s)", got, tt.wantShelf, a.reason)
+			}
+			if tt.wantInfo != "" && !strings.Contains(a.reason, tt.wantInfo) {
+				t.Errorf("reason = %q, want it to contain %q", a.reason, tt.wantInfo)
+			}
+		})
+	}
+}
+
+func TestParseShelfLevels(t *testing.T) {
+	levels, err := parseShelfLevels([]interface{}{12000.0, map[string]interface{}{"name": "Floor", "lux": 400.0}})
+	if err != nil {
+		t.Fatalf("parseShelfLevels() error = %v", err)
+	}
+	if levels[0].name != "Shelf 1" || levels[0].lux != 12000 || levels[1].name != "Floor" || levels[1].lux != 400 {
+		t.Errorf("levels = %+v", levels)
+	}
+
+	for _, bad := range []interface{}{nil, []interface{}{}, []interface{}{"bright"}, []interface{}{-5.0}} {
+		if _, err := parseShelfLevels(bad); err == nil {
+			t.Errorf("expected error for %v", bad)
+		}
+	}
+}
+
+func TestHandleShelfPlacement(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"cactus|": {PID: "cactus", Alias: "Cactus", MinLightLux: 15000, MaxLightLux: 80000},
+		"moss|":   {PID: "moss", Alias: "Moss"},
+	}}
+	s := newTestServer(t, client)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"pids":    []interface{}{"cactus", "moss", "missing"},
+		"shelves": []interface{}{20000.0, 1000.0},
+	}
+
+	result, err := s.handleShelfPlacement(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handleShelfPlacement() error = %v", err)
+	}
+	text := resultText(t, result)
+	for _, want := range []string{
+		"## Shelf 1 (20000 lux)\n\n- Cactus (cactus)",
+		"## Shelf 2 (1000 lux)\n\n_Empty_",
+		"## Unplaceable\n\n- Moss (moss): no light data",
+		"## Not Found\n\n- missing",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+}
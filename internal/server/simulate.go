@@ -0,0 +1,220 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// changeEffect describes how an environment change moves one metric relative to the ideal range
+type changeEffect string
+
+const (
+	effectToward    changeEffect = "toward"    // closer to (or into) the ideal range
+	effectAway      changeEffect = "away"      // further from (or out of) the ideal range
+	effectUnchanged changeEffect = "unchanged" // same distance from the ideal range
+)
+
+// metricChange is the effect of a change on one metric of one plant
+type metricChange struct {
+	metric     careMetric
+	from, to   float64
+	idealMin   float64
+	idealMax   float64
+	distBefore float64
+	distAfter  float64
+	effect     changeEffect
+}
+
+// plantChange collects the per-metric effects for one plant
+type plantChange struct {
+	details  *openplantbook.PlantDetails
+	changes  []metricChange
+	improved int
+	worsened int
+}
+
+// net is positive when the plant benefits from the change overall
+func (p plantChange) net() int {
+	return p.improved - p.worsened
+}
+
+// distanceOutside returns how far value lies outside [min, max]; zero when inside
+func distanceOutside(value, min, max float64) float64 {
+	switch {
+	case value < min:
+		return min - value
+	case value > max:
+		return value - max
+	}
+	return 0
+}
+
+// simulatePlantChange evaluates each metric present in both condition sets against the plant's ideal range
+func simulatePlantChange(details *openplantbook.PlantDetails, from, to map[string]interface{}) plantChange {
+	result := plantChange{details: details}
+
+	for _, m := range careMetrics {
+		before, beforeOK := from[m.key].(float64)
+		after, afterOK := to[m.key].(float64)
+		if !beforeOK || !afterOK {
+			continue
+		}
+		min, max, ok := m.ideal(details)
+		if !ok {
+			continue
+		}
+
+		change := metricChange{
+			metric:     m,
+			from:       before,
+			to:         after,
+			idealMin:   min,
+			idealMax:   max,
+			distBefore: distanceOutside(before, min, max),
+			distAfter:  distanceOutside(after, min, max),
+			effect:     effectUnchanged,
+		}
+		switch {
+		case change.distAfter < change.distBefore:
+			change.effect = effectToward
+			result.improved++
+		case change.distAfter > change.distBefore:
+			change.effect = effectAway
+			result.worsened++
+		}
+		result.changes = append(result.changes, change)
+	}
+
+	return result
+}
+
+// formatSimulation renders the per-plant effects and the winners/losers summary
+func formatSimulation(results []plantChange, failures []string) string {
+	var winners, losers, neutral []string
+	for _, r := range results {
+		switch {
+		case r.net() > 0:
+			winners = append(winners, r.details.Alias)
+		case r.net() < 0:
+			losers = append(losers, r.details.Alias)
+		default:
+			neutral = append(neutral, r.details.Alias)
+		}
+	}
+
+	output := "# Simulated Environment Change\n\n"
+	output += "## Summary\n\n"
+	output += fmt.Sprintf("- **Benefit**: %s\n", joinOrNone(winners))
+	output += fmt.Sprintf("- **Suffer**: %s\n", joinOrNone(losers))
+	output += fmt.Sprintf("- **No net change**: %s\n\n", joinOrNone(neutral))
+
+	for _, r := range results {
+		output += fmt.Sprintf("## %s (%s)\n\n", r.details.Alias, r.details.DisplayPID)
+		if len(r.changes) == 0 {
+			output += "No comparable metrics for this plant.\n\n"
+			continue
+		}
+		for _, c := range r.changes {
+			icon := "➖"
+			switch c.effect {
+			case effectToward:
+				icon = "✅"
+			case effectAway:
+				icon = "❌"
+			}
+			output += fmt.Sprintf("%s **%s**: %g → %g%s moves %s the ideal %g-%g%s\n",
+				icon, c.metric.label, c.from, c.to, c.metric.unit, effectPhrase(c), c.idealMin, c.idealMax, c.metric.unit)
+		}
+		output += "\n"
+	}
+
+	if len(failures) > 0 {
+		output += "## Not Evaluated\n\n"
+		for _, f := range failures {
+			output += fmt.Sprintf("- %s\n", f)
+		}
+	}
+
+	return output
+}
+
+// effectPhrase describes a metric change in words
+func effectPhrase(c metricChange) string {
+	switch c.effect {
+	case effectToward:
+		if c.distAfter == 0 {
+			return "into"
+		}
+		return "toward"
+	case effectAway:
+		if c.distBefore == 0 {
+			return "out of"
+		}
+		return "away from"
+	}
+	if c.distAfter == 0 {
+		return "within"
+	}
+	return "no closer to"
+}
+
+// joinOrNone joins names for display, or returns "none"
+func joinOrNone(names []string) string {
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// handleSimulateChange handles the simulate_change tool
+func (s *Server) handleSimulateChange(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "simulate_change")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	from, ok := request.GetArguments()["from_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid from_conditions parameter")
+		return mcp.NewToolResultError("from_conditions parameter is required and must be an object"), nil
+	}
+
+	to, ok := request.GetArguments()["to_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid to_conditions parameter")
+		return mcp.NewToolResultError("to_conditions parameter is required and must be an object"), nil
+	}
+
+	logger.Info("simulating environment change", "pids", pids)
+
+	var results []plantChange
+	var failures []string
+	for _, pid := range pids {
+		details, err := s.getPlantDetails(ctx, pid, "")
+		if err != nil {
+			logger.Warn("get details failed", "pid", pid, "error", err)
+			failures = append(failures, fmt.Sprintf("%s: %v", pid, err))
+			continue
+		}
+		results = append(results, simulatePlantChange(details, from, to))
+	}
+
+	if len(results) == 0 {
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %s", strings.Join(failures, "; "))), nil
+	}
+
+	return mcp.NewToolResultText(formatSimulation(results, failures)), nil
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestSimulatePlantChange(t *testing.T) {
+	fern := &openplantbook.PlantDetails{Alias: "Fern", MinLightLux: 500, MaxLightLux: 5000, MinEnvHumid: 50, MaxEnvHumid: 80}
+	cactus := &openplantbook.PlantDetails{Alias: "Cactus", MinLightLux: 20000, MaxLightLux: 80000, MinEnvHumid: 10, MaxEnvHumid: 40}
+
+	from := map[string]interface{}{"light_lux": 3000.0, "humidity": 45.0}
+	to := map[string]interface{}{"light_lux": 25000.0, "humidity": 45.0}
+
+	t.Run("shade plant suffers", func(t *testing.T) {
+		r := simulatePlantChange(fern, from, to)
+		if r.net() >= 0 {
+			t.Fatalf("expected fern to suffer, net = %d", r.net())
+		}
+		if r.changes[0].effect != effectAway || effectPhrase(r.changes[0]) != "out of" {
+			t.Errorf("light effect = %s (%s), want away (out of)", r.changes[0].effect, effectPhrase(r.changes[0]))
+		}
+		if r.changes[1].effect != effectUnchanged {
+			t.Errorf("humidity effect = %s, want unchanged", r.changes[1].effect)
+		}
+	})
+
+	t.Run("sun plant benefits", func(t *testing.T) {
+		r := simulatePlantChange(cactus, from, to)
+		if r.net() <= 0 {
+			t.Fatalf("expected cactus to benefit, net = %d", r.net())
+		}
+		if effectPhrase(r.changes[0]) != "into" {
+			t.Errorf("light phrase = %q, want into", effectPhrase(r.changes[0]))
+		}
+	})
+
+	t.Run("metric missing from one side is skipped", func(t *testing.T) {
+		r := simulatePlantChange(fern, map[string]interface{}{"light_lux": 3000.0}, map[string]interface{}{"humidity": 60.0})
+		if len(r.changes) != 0 {
+			t.Errorf("expected no changes, got %d", len(r.changes))
+		}
+	})
+}
+
+func TestHandleSimulateChange(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"fern|":   {PID: "fern", Alias: "Fern", MinLightLux: 500, MaxLightLux: 5000},
+		"cactus|": {PID: "cactus", Alias: "Cactus", MinLightLux: 20000, MaxLightLux: 80000},
+	}}
+	s := newTestServer(t, client)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"pids":            []interface{}{"fern", "cactus", "missing"},
+		"from_conditions": map[string]interface{}{"light_lux": 3000.0},
+		"to_conditions":   map[string]interface{}{"light_lux": 25000.0},
+	}
+
+	result, err := s.handleSimulateChange(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handleSimulateChange() error = %v", err)
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"**Benefit**: Cactus", "**Suffer**: Fern", "## Not Evaluated", "missing"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+}
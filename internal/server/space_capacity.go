@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// sizeHint is the typical mature spread of a plant family. OpenPlantbook has no size
+// data, so these are broad indoor/garden averages by category.
+type sizeHint struct {
+	name     string   // shown in the output
+	keywords []string // matched against the lowercase category and pid
+	spreadCM float64  // typical mature width
+}
+
+// sizeHints is checked in order and the first match wins, so narrower groups come first
+var sizeHints = []sizeHint{
+	{name: "cacti and small succulents", keywords: []string{"cactaceae", "aizoaceae", "cact"}, spreadCM: 15},
+	{name: "succulents", keywords: []string{"crassulaceae", "succulent"}, spreadCM: 20},
+	{name: "herbs", keywords: []string{"lamiaceae", "apiaceae"}, spreadCM: 30},
+	{name: "orchids and bromeliads", keywords: []string{"orchidaceae", "bromeliaceae"}, spreadCM: 30},
+	{name: "ferns", keywords: []string{"polypodiaceae", "pteridaceae", "nephrolepidaceae", "aspleniaceae", "fern"}, spreadCM: 50},
+	{name: "aroids", keywords: []string{"araceae", "aroid"}, spreadCM: 60},
+	{name: "vegetables", keywords: []string{"solanaceae", "cucurbitaceae", "brassicaceae"}, spreadCM: 60},
+	{name: "palms", keywords: []string{"arecaceae", "palm"}, spreadCM: 100},
+	{name: "shrubs and trees", keywords: []string{"rosaceae", "moraceae", "ficus", "tree", "shrub"}, spreadCM: 120},
+}
+
+// genericSizeHint is used when no category matches
+var genericSizeHint = sizeHint{name: "generic plant", spreadCM: 40}
+
+// sizeHintFor returns the size hint for a plant and whether it came from the table
+func sizeHintFor(details *openplantbook.PlantDetails) (sizeHint, bool) {
+	category := strings.ToLower(details.Category + " " + details.PID)
+	for _, hint := range sizeHints {
+		if categoryHasAny(category, hint.keywords...) {
+			return hint, true
+		}
+	}
+	return genericSizeHint, false
+}
+
+// spaceArea is the described space; a bed with both sides known is packed as a grid,
+// a bare area as if it were square
+type spaceArea struct {
+	lengthM, widthM float64 // zero when only the area is known
+	areaM2          float64
+}
+
+// plantCapacity estimates how many plants with the given spread fit in the space, each
+// needing a spread-by-spread square
+func plantCapacity(space spaceArea, spreadCM float64) int {
+	spacing := spreadCM / 100
+	// whole rounds down, tolerating float error so 1.2 m / 0.4 m is 3 plants, not 2
+	whole := func(v float64) float64 { return math.Floor(v + 1e-9) }
+	if space.lengthM > 0 && space.widthM > 0 {
+		return int(whole(space.lengthM/spacing) * whole(space.widthM/spacing))
+	}
+	return int(whole(space.areaM2 / (spacing * spacing)))
+}
+
+// parseSpaceArea reads either length_m and width_m, or area_m2
+func parseSpaceArea(request mcp.CallToolRequest) (spaceArea, error) {
+	length := request.GetFloat("length_m", 0)
+	width := request.GetFloat("width_m", 0)
+	area := request.GetFloat("area_m2", 0)
+
+	switch {
+	case length < 0 || width < 0 || area < 0:
+		return spaceArea{}, fmt.Errorf("length_m, width_m and area_m2 must be positive")
+	case length > 0 && width > 0:
+		return spaceArea{lengthM: length, widthM: width, areaM2: length * width}, nil
+	case area > 0:
+		return spaceArea{areaM2: area}, nil
+	}
+	return spaceArea{}, fmt.Errorf("describe the space with length_m and width_m, or with area_m2")
+}
+
+// formatSpaceCapacity renders the estimate
+func formatSpaceCapacity(details *openplantbook.PlantDetails, space spaceArea, hint sizeHint, matched bool, count int) string {
+	output := fmt.Sprintf("# Space Capacity for %s\n\n", details.Alias)
+	output += fmt.Sprintf("**Rough estimate: about %d plant(s)**", count)
+	if space.lengthM > 0 {
+		output += fmt.Sprintf(" in %g m × %g m\n\n", space.lengthM, space.widthM)
+	} else {
+		output += fmt.Sprintf(" in %g m²\n\n", space.areaM2)
+	}
+	if count == 0 {
+		output += "The space is smaller than one mature plant's footprint; it may still fit a young plant for a while.\n\n"
+	}
+
+	if matched {
+		output += fmt.Sprintf("Spacing assumes a mature spread of about %.0f cm, typical for %s.\n\n", hint.spreadCM, hint.name)
+	} else {
+		output += fmt.Sprintf("No size data for this plant's category, so a generic spread of %.0f cm is assumed.\n\n", hint.spreadCM)
+	}
+	output += "_Sizes vary widely with variety, pruning and pot size; treat this as a starting point, not a planting plan._\n"
+	return output
+}
+
+// handleSpaceCapacity handles the space_capacity tool
+func (s *Server) handleSpaceCapacity(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "space_capacity")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	space, err := parseSpaceArea(request)
+	if err != nil {
+		logger.Warn("invalid space parameters", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("estimating space capacity", "pid", pid, "area_m2", space.areaM2)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	hint, matched := sizeHintFor(details)
+	count := plantCapacity(space, hint.spreadCM)
+
+	logger.Info("space capacity estimated", "pid", details.PID, "count", count, "size_hint", hint.name)
+
+	return mcp.NewToolResultText(formatSpaceCapacity(details, space, hint, matched, count)), nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestSizeHintFor(t *testing.T) {
+	tests := []struct {
+		category string
+		pid      string
+		want     string
+		matched  bool
+	}{
+		{"Cactaceae", "echinopsis", "cacti and small succulents", true},
+		{"Araceae", "monstera deliciosa", "aroids", true},
+		{"Moraceae", "ficus lyrata", "shrubs and trees", true},
+		{"", "nephrolepis fern", "ferns", true},
+		{"Asteraceae", "gerbera", "generic plant", false},
+	}
+
+	for _, tt := range tests {
+		hint, matched := sizeHintFor(&openplantbook.PlantDetails{Category: tt.category, PID: tt.pid})
+		if hint.name != tt.want || matched != tt.matched {
+			t.Errorf("sizeHintFor(%q, %q) = %q, %v; want %q, %v", tt.category, tt.pid, hint.name, matched, tt.want, tt.matched)
+		}
+	}
+}
+
+func TestPlantCapacity(t *testing.T) {
+	tests := []struct {
+		name   string
+		space  spaceArea
+		spread float64
+		want   int
+	}{
+		{"grid packs whole plants", spaceArea{lengthM: 2, widthM: 1, areaM2: 2}, 60, 3},
+		{"area as a square", spaceArea{areaM2: 2}, 60, 5},
+		{"small plants", spaceArea{lengthM: 0.9, widthM: 0.3, areaM2: 0.27}, 15, 12},
+		{"too small", spaceArea{areaM2: 0.5}, 120, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plantCapacity(tt.space, tt.spread); got != tt.want {
+				t.Errorf("plantCapacity = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleSpaceCapacity(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"gerbera jamesonii|en": {PID: "gerbera jamesonii", Alias: "Gerbera", Category: "Asteraceae"},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{"pid": "gerbera jamesonii", "length_m": 1.2, "width_m": 0.8}
+	result, err := srv.handleSpaceCapacity(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("space_capacity failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Rough estimate: about 6 plant(s)", "generic spread of 40 cm"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	request.Params.Arguments = map[string]interface{}{"pid": "gerbera jamesonii", "length_m": 1.2}
+	result, _ = srv.handleSpaceCapacity(context.Background(), request)
+	if !result.IsError {
+		t.Error("expected a missing dimension to be rejected")
+	}
+}
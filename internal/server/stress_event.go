@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// defaultStressMaxGapHours is the longest silence between readings that a stress episode
+// is assumed to continue through
+const defaultStressMaxGapHours = 12.0
+
+// stressEpisode is a run of consecutive out-of-range readings for one metric
+type stressEpisode struct {
+	metric    careMetric
+	min, max  float64
+	high      bool // above the range rather than below
+	start     time.Time
+	end       time.Time // last out-of-range reading
+	worst     float64   // reading furthest outside the range
+	deviation float64   // worst reading's deviation, as for status_badge
+	readings  int
+	ongoing   bool // the metric's latest reading is still out of range
+}
+
+// hours is the time between the first and last out-of-range reading; a lower bound,
+// since the stress may have started or ended between readings
+func (e stressEpisode) hours() float64 {
+	return e.end.Sub(e.start).Hours()
+}
+
+// stressHistory is the outcome of scanning a reading history
+type stressHistory struct {
+	episodes []stressEpisode // every episode, oldest first per metric
+	latest   *stressEpisode  // the episode that ended most recently
+	gaps     int             // silences longer than the max gap, which split episodes
+	from, to time.Time
+}
+
+// findStressEpisodes checks every reading against the plant's ideal range, grouping
+// consecutive out-of-range readings on the same side into episodes. A gap longer than
+// maxGap ends an episode, because nothing is known about the conditions in between.
+func findStressEpisodes(details *openplantbook.PlantDetails, series map[string][]timedReading, maxGap time.Duration) stressHistory {
+	var history stressHistory
+
+	for _, m := range careMetrics {
+		readings := series[m.key]
+		min, max, ok := m.ideal(details)
+		if !ok || len(readings) == 0 {
+			continue
+		}
+
+		var current *stressEpisode
+		closeEpisode := func() {
+			if current != nil {
+				history.episodes = append(history.episodes, *current)
+				current = nil
+			}
+		}
+
+		for i, r := range readings {
+			if history.from.IsZero() || r.at.Before(history.from) {
+				history.from = r.at
+			}
+			if r.at.After(history.to) {
+				history.to = r.at
+			}
+			if i > 0 && r.at.Sub(readings[i-1].at) > maxGap {
+				history.gaps++
+				closeEpisode()
+			}
+
+			deviation := rangeDeviation(r.value, min, max)
+			if deviation == 0 {
+				closeEpisode()
+				continue
+			}
+
+			high := r.value > max
+			if current != nil && current.high != high {
+				closeEpisode()
+			}
+			if current == nil {
+				current = &stressEpisode{metric: m, min: min, max: max, high: high, start: r.at}
+			}
+			current.end = r.at
+			current.readings++
+			if deviation > current.deviation {
+				current.deviation, current.worst = deviation, r.value
+			}
+		}
+		if current != nil {
+			current.ongoing = true
+		}
+		closeEpisode()
+	}
+
+	for i := range history.episodes {
+		if history.latest == nil || history.episodes[i].end.After(history.latest.end) {
+			history.latest = &history.episodes[i]
+		}
+	}
+	return history
+}
+
+// stressSeverity reuses the status_badge scale for an episode's worst reading
+func stressSeverity(e stressEpisode, criticalDeviation float64) string {
+	if e.deviation >= criticalDeviation {
+		return "critically"
+	}
+	return "slightly"
+}
+
+// formatStressHistory renders the most recent episode relative to now
+func formatStressHistory(details *openplantbook.PlantDetails, h stressHistory, criticalDeviation float64, now time.Time) string {
+	output := fmt.Sprintf("# Last Stress Event for %s\n\n", details.Alias)
+
+	if h.latest == nil {
+		output += fmt.Sprintf("✅ Every reading from %s to %s was within the ideal range.\n", h.from.Format(time.RFC3339), h.to.Format(time.RFC3339))
+	} else {
+		e := *h.latest
+		direction := "low"
+		if e.high {
+			direction = "high"
+		}
+		output += fmt.Sprintf("**%s was %s %s**: worst reading %g%s (ideal %g-%g%s).\n\n",
+			e.metric.label, stressSeverity(e, criticalDeviation), direction, e.worst, e.metric.unit, e.min, e.max, e.metric.unit)
+
+		if e.readings == 1 {
+			output += fmt.Sprintf("- **When**: a single reading at %s\n", e.start.Format(time.RFC3339))
+		} else {
+			output += fmt.Sprintf("- **When**: %s to %s\n", e.start.Format(time.RFC3339), e.end.Format(time.RFC3339))
+			output += fmt.Sprintf("- **Lasted**: at least %s (%d readings)\n", formatDuration(e.hours()), e.readings)
+		}
+		if e.ongoing {
+			output += "- **Status**: still out of range at the latest reading\n"
+		} else {
+			output += fmt.Sprintf("- **Ended**: about %s ago\n", formatDuration(now.Sub(e.end).Hours()))
+		}
+
+		if others := len(h.episodes) - 1; others > 0 {
+			output += fmt.Sprintf("\n%d earlier stress period(s) in the history.\n", others)
+		}
+	}
+
+	if h.gaps > 0 {
+		output += fmt.Sprintf("\n_The history has %d gap(s) between readings; stress periods are not assumed to continue across them._\n", h.gaps)
+	}
+	return output
+}
+
+// handleLastStressEvent handles the last_stress_event tool
+func (s *Server) handleLastStressEvent(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "last_stress_event")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	readings, ok := request.GetArguments()["readings"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid readings parameter")
+		return mcp.NewToolResultError("readings parameter is required and must be an object keyed by metric"), nil
+	}
+
+	series := make(map[string][]timedReading, len(readings))
+	for _, metric := range careMetrics {
+		raw, exists := readings[metric.key]
+		if !exists {
+			continue
+		}
+		parsed, err := parseTimedReadings(raw)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("readings.%s %v", metric.key, err)), nil
+		}
+		series[metric.key] = parsed
+	}
+
+	maxGapHours := request.GetFloat("max_gap_hours", defaultStressMaxGapHours)
+	if maxGapHours <= 0 {
+		logger.Warn("invalid max_gap_hours parameter", "max_gap_hours", maxGapHours)
+		return mcp.NewToolResultError("max_gap_hours must be a positive number of hours"), nil
+	}
+
+	logger.Info("finding last stress event", "pid", pid, "metrics", len(series))
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+
+	history := findStressEpisodes(details, series, time.Duration(maxGapHours*float64(time.Hour)))
+	if history.to.IsZero() {
+		return mcp.NewToolResultError("no usable readings provided: supply moisture, temperature, light_lux, or humidity readings for metrics this plant has data for"), nil
+	}
+
+	logger.Info("stress history scanned", "pid", details.PID, "episodes", len(history.episodes), "gaps", history.gaps)
+
+	return mcp.NewToolResultText(formatStressHistory(details, history, s.badgeCriticalDeviation(), time.Now())), nil
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestFindStressEpisodes(t *testing.T) {
+	details := &openplantbook.PlantDetails{MinSoilMoist: 30, MaxSoilMoist: 60, MinTemp: 18, MaxTemp: 27}
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	series := func(values ...float64) []timedReading {
+		readings := make([]timedReading, len(values))
+		for i, v := range values {
+			readings[i] = timedReading{at: start.Add(time.Duration(i*6) * time.Hour), value: v}
+		}
+		return readings
+	}
+
+	t.Run("latest episode across metrics", func(t *testing.T) {
+		h := findStressEpisodes(details, map[string][]timedReading{
+			"moisture":    series(40, 20, 10, 15, 45, 50, 50),
+			"temperature": series(20, 30, 20, 20, 20, 20, 20),
+		}, 12*time.Hour)
+
+		if len(h.episodes) != 2 || h.latest == nil {
+			t.Fatalf("expected 2 episodes, got %+v", h.episodes)
+		}
+		e := *h.latest
+		if e.metric.key != "moisture" || e.high || e.readings != 3 || e.worst != 10 || e.ongoing {
+			t.Errorf("latest episode = %+v", e)
+		}
+		if e.hours() != 12 {
+			t.Errorf("episode lasted %v hours, want 12", e.hours())
+		}
+	})
+
+	t.Run("gap splits an episode", func(t *testing.T) {
+		readings := series(20, 20)
+		readings = append(readings, timedReading{at: readings[1].at.Add(48 * time.Hour), value: 20})
+		h := findStressEpisodes(details, map[string][]timedReading{"moisture": readings}, 12*time.Hour)
+		if h.gaps != 1 || len(h.episodes) != 2 {
+			t.Fatalf("expected the gap to split the episode, got gaps=%d episodes=%d", h.gaps, len(h.episodes))
+		}
+		if !h.latest.ongoing || h.latest.readings != 1 {
+			t.Errorf("expected an ongoing single-reading episode after the gap, got %+v", *h.latest)
+		}
+	})
+
+	t.Run("direction change splits an episode", func(t *testing.T) {
+		h := findStressEpisodes(details, map[string][]timedReading{"moisture": series(20, 70)}, 12*time.Hour)
+		if len(h.episodes) != 2 || !h.latest.high {
+			t.Errorf("expected separate low and high episodes, got %+v", h.episodes)
+		}
+	})
+
+	t.Run("no stress", func(t *testing.T) {
+		h := findStressEpisodes(details, map[string][]timedReading{"moisture": series(40, 50)}, 12*time.Hour)
+		if h.latest != nil || h.to.IsZero() {
+			t.Errorf("expected a clean history, got %+v", h)
+		}
+	})
+}
+
+func TestHandleLastStressEvent(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MinSoilMoist: 30, MaxSoilMoist: 60},
+	}})
+
+	ago := func(hours int) string {
+		return time.Now().Add(-time.Duration(hours) * time.Hour).UTC().Format(time.RFC3339)
+	}
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"pid": "monstera deliciosa",
+		"readings": map[string]interface{}{
+			"moisture": []interface{}{
+				map[string]interface{}{"timestamp": ago(180), "value": 45.0},
+				map[string]interface{}{"timestamp": ago(170), "value": 5.0},
+				map[string]interface{}{"timestamp": ago(160), "value": 8.0},
+				map[string]interface{}{"timestamp": ago(120), "value": 12.0},
+				map[string]interface{}{"timestamp": ago(118), "value": 40.0},
+			},
+		},
+	}
+	result, err := srv.handleLastStressEvent(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("last_stress_event failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Soil Moisture was critically low", "worst reading 12%", "a single reading", "Ended**: about 5.0 days ago", "1 earlier stress period", "1 gap(s)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+}
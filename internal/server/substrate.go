@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// substrateProfile is the subset of care data the substrate heuristics look at
+type substrateProfile struct {
+	category    string // lowercase botanical family/category
+	moistureAvg int    // midpoint of the soil moisture range, -1 when unknown
+	minEC       int
+	maxEC       int // 0 when unknown
+}
+
+// substrateRule maps a care profile to a suggested soil mix
+type substrateRule struct {
+	mix        string
+	components string
+	rationale  string
+	matches    func(p substrateProfile) bool
+}
+
+// substrateRules is the heuristic table behind substrate_recommendation.
+// Rules are evaluated in order and the first match wins:
+//
+//	cacti/succulents or moisture midpoint < 20%  -> gritty mix
+//	orchids/bromeliads                           -> chunky epiphyte mix
+//	aroids                                       -> well-draining aroid mix
+//	moisture midpoint >= 60%                     -> moisture-retentive mix
+//	moisture midpoint < 40%                      -> free-draining mix
+//	anything else                                -> all-purpose mix
+var substrateRules = []substrateRule{
+	{
+		mix:        "Cactus/succulent gritty mix",
+		components: "50% mineral grit (pumice or perlite), 25% coarse sand, 25% cactus soil",
+		rationale:  "prefers dry soil, so the mix should drain almost immediately and dry out fast",
+		matches: func(p substrateProfile) bool {
+			return categoryHasAny(p.category, "cactaceae", "crassulaceae", "aizoaceae", "cact", "succulent") ||
+				(p.moistureAvg >= 0 && p.moistureAvg < 20)
+		},
+	},
+	{
+		mix:        "Chunky epiphyte mix",
+		components: "60% orchid bark, 20% sphagnum moss, 10% perlite, 10% horticultural charcoal",
+		rationale:  "epiphytic family whose roots need airflow and rot in dense soil",
+		matches: func(p substrateProfile) bool {
+			return categoryHasAny(p.category, "orchidaceae", "bromeliaceae", "orchid", "bromeliad")
+		},
+	},
+	{
+		mix:        "Well-draining aroid mix",
+		components: "30% orchid bark, 30% coco coir, 30% perlite, 10% worm castings",
+		rationale:  "aroids like moisture at the roots but need the airy structure of a chunky mix",
+		matches: func(p substrateProfile) bool {
+			return categoryHasAny(p.category, "araceae", "aroid")
+		},
+	},
+	{
+		mix:        "Moisture-retentive mix",
+		components: "60% peat-free potting compost or coco coir, 20% perlite, 20% compost",
+		rationale:  "likes consistently wet soil, so the mix should hold water between waterings",
+		matches: func(p substrateProfile) bool {
+			return p.moistureAvg >= 60
+		},
+	},
+	{
+		mix:        "Free-draining mix",
+		components: "60% potting soil, 40% perlite or pumice",
+		rationale:  "prefers the soil to dry between waterings, so extra drainage helps",
+		matches: func(p substrateProfile) bool {
+			return p.moistureAvg >= 0 && p.moistureAvg < 40
+		},
+	},
+	{
+		mix:        "All-purpose potting mix",
+		components: "75% potting soil, 25% perlite",
+		rationale:  "moderate moisture needs suit a standard mix with a little added drainage",
+		matches: func(p substrateProfile) bool {
+			return true
+		},
+	},
+}
+
+// EC thresholds (µS/cm) used to adjust the fertilizer content of the mix
+const (
+	substrateLowFeederMaxEC   = 1000
+	substrateHeavyFeederMinEC = 1200
+)
+
+// substrateRecommendation is the result of the substrate heuristics
+type substrateRecommendation struct {
+	Mix        string
+	Components string
+	Rationale  []string
+}
+
+// recommendSubstrate derives a soil mix suggestion from a plant's care profile
+func recommendSubstrate(details *openplantbook.PlantDetails) substrateRecommendation {
+	profile := substrateProfile{
+		category:    strings.ToLower(details.Category),
+		moistureAvg: -1,
+		minEC:       details.MinSoilEC,
+		maxEC:       details.MaxSoilEC,
+	}
+	if details.MaxSoilMoist > 0 {
+		profile.moistureAvg = (details.MinSoilMoist + details.MaxSoilMoist) / 2
+	}
+
+	var rec substrateRecommendation
+	for _, rule := range substrateRules {
+		if rule.matches(profile) {
+			rec.Mix = rule.mix
+			rec.Components = rule.components
+			rec.Rationale = append(rec.Rationale, fmt.Sprintf("This plant %s.", rule.rationale))
+			break
+		}
+	}
+
+	if profile.moistureAvg >= 0 {
+		rec.Rationale = append(rec.Rationale, fmt.Sprintf("Ideal soil moisture is %d-%d%%.", details.MinSoilMoist, details.MaxSoilMoist))
+	} else {
+		rec.Rationale = append(rec.Rationale, "No soil moisture data is available, so the mix is based on category alone.")
+	}
+
+	switch {
+	case profile.maxEC == 0:
+		// No EC data, nothing to adjust
+	case profile.maxEC < substrateLowFeederMaxEC:
+		rec.Rationale = append(rec.Rationale, fmt.Sprintf("Light feeder (EC %d-%d µS/cm): use an unfertilized base mix and skip slow-release fertilizer.", profile.minEC, profile.maxEC))
+	case profile.minEC >= substrateHeavyFeederMinEC:
+		rec.Rationale = append(rec.Rationale, fmt.Sprintf("Heavy feeder (EC %d-%d µS/cm): blend in compost or a slow-release fertilizer.", profile.minEC, profile.maxEC))
+	}
+
+	return rec
+}
+
+// categoryHasAny reports whether the lowercase category contains any of the keywords
+func categoryHasAny(category string, keywords ...string) bool {
+	for _, keyword := range keywords {
+		if strings.Contains(category, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleSubstrateRecommendation handles the substrate_recommendation tool
+func (s *Server) handleSubstrateRecommendation(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "substrate_recommendation")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	logger.Info("recommending substrate", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	rec := recommendSubstrate(details)
+
+	output := fmt.Sprintf("# Substrate Recommendation for %s\n\n", details.Alias)
+	output += fmt.Sprintf("**Suggested mix**: %s\n\n", rec.Mix)
+	output += fmt.Sprintf("**Components**: %s\n\n", rec.Components)
+	output += "## Rationale\n\n"
+	for _, reason := range rec.Rationale {
+		output += "- " + reason + "\n"
+	}
+	output += "\n_General guidance derived from care ranges and plant family; adjust for your pot, climate, and watering habits._\n"
+
+	logger.Info("substrate recommendation generated", "pid", details.PID, "mix", rec.Mix)
+
+	return mcp.NewToolResultText(output), nil
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestRecommendSubstrate(t *testing.T) {
+	tests := []struct {
+		name         string
+		details      *openplantbook.PlantDetails
+		expectedMix  string
+		expectedNote string
+	}{
+		{
+			name:        "cactus by category",
+			details:     &openplantbook.PlantDetails{Category: "Cactaceae", MinSoilMoist: 30, MaxSoilMoist: 50},
+			expectedMix: "Cactus/succulent gritty mix",
+		},
+		{
+			name:        "dry plant by moisture",
+			details:     &openplantbook.PlantDetails{Category: "Asparagaceae", MinSoilMoist: 7, MaxSoilMoist: 25},
+			expectedMix: "Cactus/succulent gritty mix",
+		},
+		{
+			name:        "orchid",
+			details:     &openplantbook.PlantDetails{Category: "Orchidaceae", MinSoilMoist: 15, MaxSoilMoist: 60},
+			expectedMix: "Chunky epiphyte mix",
+		},
+		{
+			name:        "aroid",
+			details:     &openplantbook.PlantDetails{Category: "Araceae", MinSoilMoist: 15, MaxSoilMoist: 60},
+			expectedMix: "Well-draining aroid mix",
+		},
+		{
+			name:        "wet lover",
+			details:     &openplantbook.PlantDetails{Category: "Cyperaceae", MinSoilMoist: 50, MaxSoilMoist: 80},
+			expectedMix: "Moisture-retentive mix",
+		},
+		{
+			name:        "dries between waterings",
+			details:     &openplantbook.PlantDetails{Category: "Lamiaceae", MinSoilMoist: 20, MaxSoilMoist: 50},
+			expectedMix: "Free-draining mix",
+		},
+		{
+			name:         "no data falls back to all-purpose",
+			details:      &openplantbook.PlantDetails{},
+			expectedMix:  "All-purpose potting mix",
+			expectedNote: "No soil moisture data",
+		},
+		{
+			name:         "light feeder",
+			details:      &openplantbook.PlantDetails{Category: "Lamiaceae", MinSoilMoist: 40, MaxSoilMoist: 60, MinSoilEC: 350, MaxSoilEC: 900},
+			expectedMix:  "All-purpose potting mix",
+			expectedNote: "Light feeder",
+		},
+		{
+			name:         "heavy feeder",
+			details:      &openplantbook.PlantDetails{Category: "Solanaceae", MinSoilMoist: 40, MaxSoilMoist: 60, MinSoilEC: 1500, MaxSoilEC: 3000},
+			expectedMix:  "All-purpose potting mix",
+			expectedNote: "Heavy feeder",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := recommendSubstrate(tt.details)
+			if rec.Mix != tt.expectedMix {
+				t.Errorf("recommendSubstrate() mix = %q, want %q", rec.Mix, tt.expectedMix)
+			}
+			if rec.Components == "" {
+				t.Error("expected components")
+			}
+			if tt.expectedNote != "" && !strings.Contains(strings.Join(rec.Rationale, " "), tt.expectedNote) {
+				t.Errorf("expected rationale to contain %q, got %v", tt.expectedNote, rec.Rationale)
+			}
+		})
+	}
+}
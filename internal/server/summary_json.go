@@ -0,0 +1,113 @@
+package server
+
+import (
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Output formats accepted by get_care_summary
+const (
+	summaryFormatMarkdown = "markdown"
+	summaryFormatJSON     = "json"
+)
+
+// summaryFormats lists the accepted output formats, default first
+var summaryFormats = []string{summaryFormatMarkdown, summaryFormatJSON}
+
+// careSummaryJSON is the structured form of a care summary
+type careSummaryJSON struct {
+	PID        string          `json:"pid"`
+	DisplayPID string          `json:"display_pid"`
+	Alias      string          `json:"alias,omitempty"`
+	Category   string          `json:"category,omitempty"`
+	Units      string          `json:"units"`
+	Care       careSummaryCare `json:"care"`
+	ImageURL   string          `json:"image_url,omitempty"`
+}
+
+// careSummaryCare holds one band per care metric; bands without data are omitted
+type careSummaryCare struct {
+	Light        *careBand `json:"light,omitempty"`
+	Temperature  *careBand `json:"temperature,omitempty"`
+	Humidity     *careBand `json:"humidity,omitempty"`
+	SoilMoisture *careBand `json:"soil_moisture,omitempty"`
+	SoilEC       *careBand `json:"soil_ec,omitempty"`
+}
+
+// careBand is one ideal range in the requested unit system, with the plain-language
+// interpretation the markdown summary shows next to it
+type careBand struct {
+	Min            float64 `json:"min"`
+	Max            float64 `json:"max"`
+	Unit           string  `json:"unit"`
+	Interpretation string  `json:"interpretation,omitempty"`
+}
+
+// bandInterpretation returns the plain-language reading of a care band, or "" when the
+// band has none or the plant has no data for it. Both summary formats use it; metric picks
+// the unit of any temperature quoted.
+func bandInterpretation(details *openplantbook.PlantDetails, key string, metric bool) string {
+	switch {
+	case key == "light_lux" && details.MaxLightLux > 0:
+		return stripInterpretation(interpretLightLevel(details.MinLightLux, details.MaxLightLux))
+	case key == "temperature" && details.MaxTemp > 0:
+		return stripInterpretation(interpretTemperatureRange(details.MinTemp, details.MaxTemp, metric))
+	case key == "humidity" && details.MaxEnvHumid > 0:
+		return stripInterpretation(interpretHumidityLevel(details.MinEnvHumid, details.MaxEnvHumid))
+	case key == "moisture" && details.MaxSoilMoist > 0:
+		return stripInterpretation(interpretMoistureLevel(details.MinSoilMoist, details.MaxSoilMoist))
+	}
+	return ""
+}
+
+// buildCareSummaryJSON assembles the structured summary. Temperature and light follow
+// metric like the markdown summary; converted values are rounded to one decimal.
+func buildCareSummaryJSON(details *openplantbook.PlantDetails, metric bool) careSummaryJSON {
+	summary := careSummaryJSON{
+		PID:        details.PID,
+		DisplayPID: details.DisplayPID,
+		Alias:      details.Alias,
+		Category:   details.Category,
+		Units:      unitsMetric,
+		ImageURL:   details.ImageURL,
+	}
+	if !metric {
+		summary.Units = unitsImperial
+	}
+
+	if details.MaxLightLux > 0 {
+		band := &careBand{Min: float64(details.MinLightLux), Max: float64(details.MaxLightLux), Unit: "lux"}
+		if !metric {
+			band.Min, band.Max, band.Unit = roundTo(luxToFootCandles(band.Min), 1), roundTo(luxToFootCandles(band.Max), 1), "fc"
+		}
+		band.Interpretation = bandInterpretation(details, "light_lux", metric)
+		summary.Care.Light = band
+	}
+	if details.MaxTemp > 0 {
+		band := &careBand{Min: details.MinTemp, Max: details.MaxTemp, Unit: "°C"}
+		if !metric {
+			band.Min, band.Max, band.Unit = roundTo(celsiusToFahrenheit(band.Min), 1), roundTo(celsiusToFahrenheit(band.Max), 1), "°F"
+		}
+		band.Interpretation = bandInterpretation(details, "temperature", metric)
+		summary.Care.Temperature = band
+	}
+	if details.MaxEnvHumid > 0 {
+		summary.Care.Humidity = &careBand{
+			Min:            float64(details.MinEnvHumid),
+			Max:            float64(details.MaxEnvHumid),
+			Unit:           "%",
+			Interpretation: bandInterpretation(details, "humidity", metric),
+		}
+	}
+	if details.MaxSoilMoist > 0 {
+		summary.Care.SoilMoisture = &careBand{
+			Min:            float64(details.MinSoilMoist),
+			Max:            float64(details.MaxSoilMoist),
+			Unit:           "%",
+			Interpretation: bandInterpretation(details, "moisture", metric),
+		}
+	}
+	if details.MaxSoilEC > 0 {
+		summary.Care.SoilEC = &careBand{Min: float64(details.MinSoilEC), Max: float64(details.MaxSoilEC), Unit: "µS/cm"}
+	}
+	return summary
+}
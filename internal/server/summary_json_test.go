@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestBuildCareSummaryJSON(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera",
+		MinLightLux: 1500, MaxLightLux: 20000, MinTemp: 15, MaxTemp: 30,
+		MinSoilMoist: 20, MaxSoilMoist: 60,
+	}
+
+	summary := buildCareSummaryJSON(details, true)
+	if summary.Units != unitsMetric {
+		t.Errorf("units = %q, want metric", summary.Units)
+	}
+	if got, want := *summary.Care.Light, (careBand{Min: 1500, Max: 20000, Unit: "lux", Interpretation: "Bright indirect light - near windows"}); got != want {
+		t.Errorf("light = %+v, want %+v", got, want)
+	}
+	if got := summary.Care.SoilMoisture.Interpretation; got != "Evenly moist - keep soil consistently moist" {
+		t.Errorf("moisture interpretation = %q", got)
+	}
+	if summary.Care.Humidity != nil || summary.Care.SoilEC != nil {
+		t.Errorf("bands without data should be omitted, got %+v", summary.Care)
+	}
+
+	// Imperial converts temperature and light but keeps the lux-based interpretation
+	summary = buildCareSummaryJSON(details, false)
+	if got, want := *summary.Care.Temperature, (careBand{Min: 59, Max: 86, Unit: "°F", Interpretation: "Warm-growing - keep above 59°F"}); got != want {
+		t.Errorf("imperial temperature = %+v, want %+v", got, want)
+	}
+	if light := summary.Care.Light; light.Unit != "fc" || light.Min != 139.4 || light.Interpretation != "Bright indirect light - near windows" {
+		t.Errorf("imperial light = %+v", light)
+	}
+}
+
+func TestHandleGetCareSummary_Format(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera", MinSoilMoist: 20, MaxSoilMoist: 60},
+	}}
+	srv := newTestServer(t, client)
+
+	call := func(format interface{}) *mcp.CallToolResult {
+		t.Helper()
+		request := mcp.CallToolRequest{}
+		request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+		if format != nil {
+			request.GetArguments()["format"] = format
+		}
+		result, err := srv.handleGetCareSummary(context.Background(), request)
+		if err != nil {
+			t.Fatalf("handleGetCareSummary() error = %v", err)
+		}
+		return result
+	}
+
+	if text := resultText(t, call(nil)); !strings.HasPrefix(text, "# Monstera") {
+		t.Errorf("default format should stay markdown:\n%s", text)
+	}
+
+	var summary careSummaryJSON
+	if err := json.Unmarshal([]byte(resultText(t, call("json"))), &summary); err != nil {
+		t.Fatalf("json format is not valid JSON: %v", err)
+	}
+	if summary.PID != "monstera deliciosa" || summary.Care.SoilMoisture == nil || summary.Care.SoilMoisture.Max != 60 {
+		t.Errorf("json summary = %+v", summary)
+	}
+
+	if result := call("yaml"); !result.IsError || !strings.Contains(resultText(t, result), "format must be one of: markdown, json") {
+		t.Errorf("expected an invalid format error, got %s", resultText(t, result))
+	}
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// assertGolden compares got against testdata/name, rewriting it with -update
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("update golden file: %v", err)
+		}
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("output does not match %s\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+func TestFormatThresholdTable(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "basil", DisplayPID: "Ocimum basilicum",
+		MinLightLux: 2500, MaxLightLux: 30000, MinTemp: 10, MaxTemp: 35,
+		MinEnvHumid: 20, MaxEnvHumid: 70, MinSoilMoist: 15, MaxSoilMoist: 60,
+		MinSoilEC: 350, MaxSoilEC: 2000,
+	}
+
+	t.Run("metric", func(t *testing.T) {
+		assertGolden(t, "threshold_table_metric.golden", formatThresholdTable(details, true, numberPrecision{}))
+	})
+
+	t.Run("imperial", func(t *testing.T) {
+		assertGolden(t, "threshold_table_imperial.golden", formatThresholdTable(details, false, numberPrecision{}))
+	})
+
+	t.Run("rows match prose sections", func(t *testing.T) {
+		partial := &openplantbook.PlantDetails{MinTemp: 10, MaxTemp: 35, MinSoilMoist: 15, MaxSoilMoist: 60}
+		table := formatThresholdTable(partial, true, numberPrecision{})
+		if strings.Count(table, "\n| ") != 3 { // header plus two data rows
+			t.Errorf("expected only temperature and moisture rows:\n%s", table)
+		}
+		if strings.Contains(table, "Light") || strings.Contains(table, "EC") {
+			t.Errorf("metrics without data should be omitted:\n%s", table)
+		}
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		if table := formatThresholdTable(&openplantbook.PlantDetails{}, true, numberPrecision{}); table != "" {
+			t.Errorf("expected no table, got:\n%s", table)
+		}
+	})
+
+	t.Run("opt-in", func(t *testing.T) {
+		if strings.Contains(renderCareSummary(details, summaryOptions{metric: true}), "## Thresholds") {
+			t.Error("table should be off by default")
+		}
+		if !strings.Contains(renderCareSummary(details, summaryOptions{metric: true, thresholdTable: true}), "## Thresholds") {
+			t.Error("table should be appended when requested")
+		}
+	})
+}
+
+func TestRenderCareSummary_DualUnits(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "basil", DisplayPID: "Ocimum basilicum", Category: "Lamiaceae",
+		MinLightLux: 2500, MaxLightLux: 30000, MinTemp: 18, MaxTemp: 27,
+		MinEnvHumid: 20, MaxEnvHumid: 70, MinSoilMoist: 15, MaxSoilMoist: 60,
+		MinSoilEC: 350, MaxSoilEC: 2000,
+	}
+
+	t.Run("metric", func(t *testing.T) {
+		assertGolden(t, "care_summary_dual_metric.golden", renderCareSummary(details, summaryOptions{metric: true, dualUnits: true}))
+	})
+	t.Run("imperial", func(t *testing.T) {
+		assertGolden(t, "care_summary_dual_imperial.golden", renderCareSummary(details, summaryOptions{metric: false, dualUnits: true}))
+	})
+}
+
+func TestRenderCareSummary_Imperial(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		Alias: "basil", DisplayPID: "Ocimum basilicum", Category: "Lamiaceae",
+		MinLightLux: 2500, MaxLightLux: 30000, MinTemp: 18, MaxTemp: 27,
+		MinEnvHumid: 20, MaxEnvHumid: 70, MinSoilMoist: 15, MaxSoilMoist: 60,
+	}
+
+	imperial := renderCareSummary(details, summaryOptions{metric: false})
+	for _, want := range []string{
+		"Units: imperial (°F, foot-candles;",
+		// The interpretation still comes from the lux values
+		"**Light**: 232 - 2787 fc (Bright indirect light - near windows)",
+		"**Temperature**: 64.4 - 80.6°F",
+		"**Humidity**: 20 - 70%",
+		"**Soil Moisture**: 15 - 60%",
+	} {
+		if !strings.Contains(imperial, want) {
+			t.Errorf("imperial summary missing %q:\n%s", want, imperial)
+		}
+	}
+	if strings.Contains(imperial, "lux") || strings.Contains(imperial, "°C") {
+		t.Errorf("imperial summary should not show metric units:\n%s", imperial)
+	}
+
+	if metric := renderCareSummary(details, summaryOptions{metric: true}); !strings.Contains(metric, "Units: metric (°C, lux;") {
+		t.Errorf("metric summary should name its units:\n%s", metric)
+	}
+}
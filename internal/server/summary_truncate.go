@@ -0,0 +1,60 @@
+package server
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// sectionPriority ranks care summary sections; lower values are kept longest
+type sectionPriority int
+
+const (
+	sectionEssential     sectionPriority = iota // title and heading, never dropped
+	sectionCritical                             // light and watering
+	sectionCore                                 // temperature, humidity, fertilizer
+	sectionSupplementary                        // interpretation, tables, comparisons, footers
+	sectionOptional                             // image link
+)
+
+// truncationMarker is appended when sections were dropped to fit max_summary_chars
+const truncationMarker = "… (truncated)\n"
+
+// summarySection is one self-contained block of a care summary
+type summarySection struct {
+	text     string
+	priority sectionPriority
+}
+
+// joinSummarySections concatenates the sections in order. When maxChars is positive and
+// the result would be longer, whole sections are dropped, lowest priority and latest
+// first, until the rest plus the truncation marker fits. Essential sections are always
+// kept, so a very small limit can still be exceeded rather than cutting mid-sentence.
+func joinSummarySections(sections []summarySection, maxChars int) string {
+	full := concatSections(sections)
+	if maxChars <= 0 || utf8.RuneCountInString(full) <= maxChars {
+		return full
+	}
+
+	kept := append([]summarySection(nil), sections...)
+	budget := maxChars - utf8.RuneCountInString(truncationMarker)
+	for priority := sectionOptional; priority > sectionEssential; priority-- {
+		for i := len(kept) - 1; i >= 0; i-- {
+			if utf8.RuneCountInString(concatSections(kept)) <= budget {
+				return concatSections(kept) + truncationMarker
+			}
+			if kept[i].priority == priority {
+				kept = append(kept[:i], kept[i+1:]...)
+			}
+		}
+	}
+	return concatSections(kept) + truncationMarker
+}
+
+// concatSections joins section texts without separators; each section carries its own spacing
+func concatSections(sections []summarySection) string {
+	var b strings.Builder
+	for _, section := range sections {
+		b.WriteString(section.text)
+	}
+	return b.String()
+}
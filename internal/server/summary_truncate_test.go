@@ -0,0 +1,55 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestJoinSummarySections(t *testing.T) {
+	sections := []summarySection{
+		{"# Monstera\n\n", sectionEssential},
+		{"**Light**: 1500 - 15000 lux\n\n", sectionCritical},
+		{"**Temperature**: 18.0 - 27.0°C\n\n", sectionCore},
+		{"**Soil Moisture**: 30 - 60%\n\n", sectionCritical},
+		{"## Thresholds\n\n| table |\n\n", sectionSupplementary},
+		{"\n[Plant Image](https://example.com/m.jpg)\n", sectionOptional},
+	}
+	full := concatSections(sections)
+
+	tests := []struct {
+		name    string
+		max     int
+		want    []string
+		dropped []string
+	}{
+		{name: "unlimited", max: 0, want: []string{"Plant Image", "Thresholds"}},
+		{name: "fits", max: utf8.RuneCountInString(full), want: []string{"Plant Image"}},
+		{name: "drops image first", max: utf8.RuneCountInString(full) - 1, want: []string{"Thresholds", "Temperature"}, dropped: []string{"Plant Image"}},
+		{name: "keeps light and water", max: 90, want: []string{"Light", "Soil Moisture"}, dropped: []string{"Temperature", "Thresholds"}},
+		{name: "essentials only", max: 5, want: []string{"# Monstera"}, dropped: []string{"Light"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := joinSummarySections(sections, tt.max)
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("expected %q in:\n%s", w, got)
+				}
+			}
+			for _, d := range tt.dropped {
+				if strings.Contains(got, d) {
+					t.Errorf("expected %q dropped from:\n%s", d, got)
+				}
+			}
+			truncated := strings.HasSuffix(got, truncationMarker)
+			if truncated != (len(tt.dropped) > 0) {
+				t.Errorf("truncation marker present = %v, want %v", truncated, len(tt.dropped) > 0)
+			}
+			if truncated && tt.max > 5 && utf8.RuneCountInString(got) > tt.max {
+				t.Errorf("length %d exceeds max %d", utf8.RuneCountInString(got), tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// temperatureRisk is the assessment of a day/night temperature pair against a plant's range.
+// All values are in °C.
+type temperatureRisk struct {
+	min, max       float64
+	day, night     float64
+	average        float64
+	averageInRange bool
+	swing          float64 // absolute difference between day and night
+	tolerance      float64 // extra swing the range could absorb; negative when the swing is wider than the range
+	dayOutside     float64 // degrees the day reading lies outside the range; 0 when inside
+	nightOutside   float64 // degrees the night reading lies outside the range; 0 when inside
+}
+
+// extremesInRange reports whether both readings fall inside the plant's range
+func (r temperatureRisk) extremesInRange() bool {
+	return r.dayOutside == 0 && r.nightOutside == 0
+}
+
+// assessTemperatureRisk checks both extremes of a diurnal swing, not just their average
+func assessTemperatureRisk(min, max, day, night float64) temperatureRisk {
+	r := temperatureRisk{min: min, max: max, day: day, night: night}
+	r.average = (day + night) / 2
+	r.averageInRange = r.average >= min && r.average <= max
+	r.swing = math.Abs(day - night)
+	r.tolerance = (max - min) - r.swing
+	r.dayOutside = distanceOutside(day, min, max)
+	r.nightOutside = distanceOutside(night, min, max)
+	return r
+}
+
+// formatTemperatureRisk renders the assessment, converting to °F when metric is false
+func formatTemperatureRisk(alias string, r temperatureRisk, metric bool) string {
+	temp, delta, unit := func(c float64) float64 { return c }, func(c float64) float64 { return c }, "°C"
+	if !metric {
+		temp, delta, unit = celsiusToFahrenheit, func(c float64) float64 { return c * 9 / 5 }, "°F"
+	}
+
+	output := fmt.Sprintf("# Temperature Risk for %s\n\n", alias)
+	output += fmt.Sprintf("**Ideal range**: %.1f - %.1f%s\n\n", temp(r.min), temp(r.max), unit)
+	output += fmt.Sprintf("**Day**: %.1f%s · **Night**: %.1f%s · **Average**: %.1f%s · **Swing**: %.1f%s\n\n",
+		temp(r.day), unit, temp(r.night), unit, temp(r.average), unit, delta(r.swing), unit)
+
+	for _, reading := range []struct {
+		label   string
+		value   float64
+		outside float64
+	}{
+		{"Day", r.day, r.dayOutside},
+		{"Night", r.night, r.nightOutside},
+	} {
+		switch {
+		case reading.outside == 0:
+			output += fmt.Sprintf("✅ **%s**: within range\n\n", reading.label)
+		case reading.value < r.min:
+			output += fmt.Sprintf("❌ **%s**: %.1f%s below the minimum\n\n", reading.label, delta(reading.outside), unit)
+		default:
+			output += fmt.Sprintf("❌ **%s**: %.1f%s above the maximum\n\n", reading.label, delta(reading.outside), unit)
+		}
+	}
+
+	switch {
+	case r.extremesInRange():
+		output += fmt.Sprintf("**Summary**: Both extremes are within range. The plant could tolerate %.1f%s more day/night swing.\n", delta(r.tolerance), unit)
+	case r.averageInRange:
+		output += "**Summary**: ⚠️ The average looks fine, but the plant is stressed at one end of the day. "
+		output += "This is common near drafty windows or heaters; move it or buffer the extreme.\n"
+	default:
+		output += "**Summary**: The average temperature itself is outside the plant's range.\n"
+	}
+	if r.tolerance < 0 {
+		output += fmt.Sprintf("\nThe swing is %.1f%s wider than the plant's whole range, so no placement of these readings fits; reduce the swing.\n", delta(-r.tolerance), unit)
+	}
+
+	return output
+}
+
+// handleTemperatureRisk handles the temperature_risk tool
+func (s *Server) handleTemperatureRisk(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "temperature_risk")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	day, err := request.RequireFloat("day_temp")
+	if err != nil {
+		logger.Warn("invalid day_temp parameter", "error", err)
+		return mcp.NewToolResultError("day_temp parameter is required and must be a number"), nil
+	}
+
+	night, err := request.RequireFloat("night_temp")
+	if err != nil {
+		logger.Warn("invalid night_temp parameter", "error", err)
+		return mcp.NewToolResultError("night_temp parameter is required and must be a number"), nil
+	}
+
+	metric := request.GetBool("metric", true)
+	if !metric {
+		day, night = fahrenheitToCelsius(day), fahrenheitToCelsius(night)
+	}
+
+	logger.Info("assessing temperature risk", "pid", pid, "day_temp", day, "night_temp", night)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxTemp <= 0 {
+		logger.Warn("plant has no temperature data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("no temperature range is available for this plant (%s)", pid)), nil
+	}
+
+	risk := assessTemperatureRisk(details.MinTemp, details.MaxTemp, day, night)
+
+	logger.Info("temperature risk assessed", "pid", details.PID, "extremes_in_range", risk.extremesInRange())
+
+	return mcp.NewToolResultText(formatTemperatureRisk(details.Alias, risk, metric)), nil
+}
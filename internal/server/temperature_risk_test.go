@@ -0,0 +1,58 @@
+package server
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestAssessTemperatureRisk(t *testing.T) {
+	tests := []struct {
+		name            string
+		day, night      float64
+		extremesInRange bool
+		averageInRange  bool
+		tolerance       float64
+		nightOutside    float64
+	}{
+		{"comfortable", 24, 18, true, true, 9, 0},
+		{"cold nights hidden by average", 28, 10, false, true, -3, 5},
+		{"both too cold", 12, 8, false, false, 11, 7},
+		{"reversed readings", 18, 24, true, true, 9, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// range 15-30°C, width 15
+			r := assessTemperatureRisk(15, 30, tt.day, tt.night)
+			if r.extremesInRange() != tt.extremesInRange {
+				t.Errorf("extremesInRange = %v, want %v", r.extremesInRange(), tt.extremesInRange)
+			}
+			if r.averageInRange != tt.averageInRange {
+				t.Errorf("averageInRange = %v, want %v", r.averageInRange, tt.averageInRange)
+			}
+			if math.Abs(r.tolerance-tt.tolerance) > 1e-9 {
+				t.Errorf("tolerance = %g, want %g", r.tolerance, tt.tolerance)
+			}
+			if math.Abs(r.nightOutside-tt.nightOutside) > 1e-9 {
+				t.Errorf("nightOutside = %g, want %g", r.nightOutside, tt.nightOutside)
+			}
+		})
+	}
+}
+
+func TestFormatTemperatureRisk(t *testing.T) {
+	r := assessTemperatureRisk(15, 30, 28, 10)
+
+	metric := formatTemperatureRisk("Monstera", r, true)
+	for _, want := range []string{"**Night**: 5.0°C below the minimum", "average looks fine", "3.0°C wider than the plant's whole range"} {
+		if !strings.Contains(metric, want) {
+			t.Errorf("expected %q in output:\n%s", want, metric)
+		}
+	}
+
+	imperial := formatTemperatureRisk("Monstera", r, false)
+	if !strings.Contains(imperial, "**Night**: 9.0°F below the minimum") {
+		t.Errorf("expected degree differences converted to °F, got:\n%s", imperial)
+	}
+}
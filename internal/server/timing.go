@@ -0,0 +1,36 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// defaultSlowCallThresholdMs is deliberately generous: a cold call that walks the
+// language fallback chain can legitimately take a few seconds
+const defaultSlowCallThresholdMs = 5000
+
+// slowCallThreshold returns the configured threshold; zero disables slow-call warnings
+func (s *Server) slowCallThreshold() time.Duration {
+	return time.Duration(s.config.SlowCallThresholdMs) * time.Millisecond
+}
+
+// withTiming measures each call's wall-clock duration, logging it at debug level and
+// warning when it exceeds the slow-call threshold
+func (s *Server) withTiming(toolName string, handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		start := time.Now()
+		result, err := handler(ctx, request)
+		elapsed := time.Since(start)
+
+		logger := s.logger.With("trace_id", traceIDFromContext(ctx), "tool", toolName, "duration_ms", elapsed.Milliseconds())
+		if threshold := s.slowCallThreshold(); threshold > 0 && elapsed > threshold {
+			logger.Warn("slow tool call", "threshold_ms", threshold.Milliseconds())
+		} else {
+			logger.Debug("tool call completed")
+		}
+		return result, err
+	}
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+// slowClient delays every detail lookup
+type slowClient struct {
+	*fakeClient
+	delay time.Duration
+}
+
+func (c *slowClient) GetPlantDetails(ctx context.Context, pid string, opts *openplantbook.DetailOptions) (*openplantbook.PlantDetails, error) {
+	time.Sleep(c.delay)
+	return c.fakeClient.GetPlantDetails(ctx, pid, opts)
+}
+
+func TestWithTiming(t *testing.T) {
+	client := &slowClient{
+		fakeClient: &fakeClient{details: map[string]*openplantbook.PlantDetails{
+			"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MaxLightLux: 5000},
+		}},
+		delay: 30 * time.Millisecond,
+	}
+
+	tests := []struct {
+		name        string
+		thresholdMs int
+		wantSlow    bool
+	}{
+		{name: "over threshold", thresholdMs: 10, wantSlow: true},
+		{name: "under threshold", thresholdMs: 10000, wantSlow: false},
+		{name: "disabled", thresholdMs: 0, wantSlow: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			srv := newTestServer(t, client)
+			srv.logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			srv.config.SlowCallThresholdMs = tt.thresholdMs
+
+			handler := srv.withTrace(srv.withTiming("get_plant_care", srv.handleGetPlantCare))
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = map[string]interface{}{"pid": "monstera deliciosa"}
+			if _, err := handler(withNoCache(context.Background()), request); err != nil {
+				t.Fatalf("handler failed: %v", err)
+			}
+
+			out := logs.String()
+			if got := strings.Contains(out, `msg="slow tool call"`); got != tt.wantSlow {
+				t.Errorf("slow tool call logged = %v, want %v\n%s", got, tt.wantSlow, out)
+			}
+			if !tt.wantSlow && !strings.Contains(out, `msg="tool call completed"`) {
+				t.Errorf("expected debug timing line\n%s", out)
+			}
+			if !strings.Contains(out, "tool=get_plant_care") || !strings.Contains(out, "duration_ms=") || !strings.Contains(out, "trace_id=") {
+				t.Errorf("expected tool, duration and trace ID in the timing log\n%s", out)
+			}
+		})
+	}
+}
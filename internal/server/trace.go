@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/rs/xid"
+)
+
+// traceIDKey is the context key for the current tool call's trace ID
+type traceIDKey struct{}
+
+// withTraceID attaches a trace ID to the context
+func withTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey{}, traceID)
+}
+
+// traceIDFromContext returns the tool call's trace ID, or a fresh one when none is set
+func traceIDFromContext(ctx context.Context) string {
+	if traceID, ok := ctx.Value(traceIDKey{}).(string); ok && traceID != "" {
+		return traceID
+	}
+	return xid.New().String()
+}
+
+// traceIDMetaKey is the request _meta field a client can set to propagate its own
+// correlation ID, e.g. {"_meta": {"trace_id": "req-42"}}
+const traceIDMetaKey = "trace_id"
+
+// validTraceID limits injected IDs to short, log-safe tokens
+var validTraceID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// requestTraceID returns the caller-supplied trace ID from the request's _meta, if any
+func requestTraceID(request mcp.CallToolRequest) (string, bool) {
+	if request.Params.Meta == nil {
+		return "", false
+	}
+	traceID, ok := request.Params.Meta.AdditionalFields[traceIDMetaKey].(string)
+	if !ok || !validTraceID.MatchString(traceID) {
+		return "", false
+	}
+	return traceID, true
+}
+
+// withTrace wraps a tool handler so every call gets a trace ID that the handler logs with.
+// An ID already in the context wins, then one from the request's _meta; otherwise a fresh
+// one is generated. Reusing an ID makes retries and tests log under the same trace.
+// When IncludeTraceInErrors is enabled, error results carry the same ID so users can quote it.
+func (s *Server) withTrace(handler server.ToolHandlerFunc) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		traceID, ok := ctx.Value(traceIDKey{}).(string)
+		if !ok || traceID == "" {
+			if traceID, ok = requestTraceID(request); !ok {
+				traceID = traceIDFromContext(ctx)
+			}
+		}
+		result, err := handler(withTraceID(ctx, traceID), request)
+		if s.config.IncludeTraceInErrors && result != nil && result.IsError {
+			appendTraceID(result, traceID)
+		}
+		return result, err
+	}
+}
+
+// appendTraceID adds "(trace: <id>)" to the first text content of a result
+func appendTraceID(result *mcp.CallToolResult, traceID string) {
+	for i, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			text.Text = fmt.Sprintf("%s (trace: %s)", text.Text, traceID)
+			result.Content[i] = text
+			return
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestWithTrace(t *testing.T) {
+	var seen string
+	handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		seen = traceIDFromContext(ctx)
+		return mcp.NewToolResultError("failed to get plant details"), nil
+	}
+
+	tests := []struct {
+		name      string
+		include   bool
+		wantTrace bool
+	}{
+		{"default off", false, false},
+		{"enabled", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, &fakeClient{})
+			s.config.IncludeTraceInErrors = tt.include
+
+			result, err := s.withTrace(handler)(context.Background(), mcp.CallToolRequest{})
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			text := result.Content[0].(mcp.TextContent).Text
+			if seen == "" {
+				t.Fatal("handler saw no trace ID")
+			}
+
+			suffix := " (trace: " + seen + ")"
+			if got := strings.HasSuffix(text, suffix); got != tt.wantTrace {
+				t.Errorf("error text %q: has trace suffix = %v, want %v", text, got, tt.wantTrace)
+			}
+		})
+	}
+
+	t.Run("successful results untouched", func(t *testing.T) {
+		s := newTestServer(t, &fakeClient{})
+		s.config.IncludeTraceInErrors = true
+		ok := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("fine"), nil
+		}
+		result, _ := s.withTrace(ok)(context.Background(), mcp.CallToolRequest{})
+		if text := resultText(t, result); text != "fine" {
+			t.Errorf("result text = %q", text)
+		}
+	})
+}
+
+func TestWithTrace_InjectedID(t *testing.T) {
+	withMeta := func(fields map[string]any) mcp.CallToolRequest {
+		request := mcp.CallToolRequest{}
+		request.Params.Meta = &mcp.Meta{AdditionalFields: fields}
+		return request
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		request mcp.CallToolRequest
+		want    string // empty means a fresh ID is expected
+	}{
+		{"from context", withTraceID(context.Background(), "ctx-id"), withMeta(map[string]any{"trace_id": "meta-id"}), "ctx-id"},
+		{"from _meta", context.Background(), withMeta(map[string]any{"trace_id": "req-42"}), "req-42"},
+		{"malformed _meta ignored", context.Background(), withMeta(map[string]any{"trace_id": "bad id\nforged log line"}), ""},
+		{"none supplied", context.Background(), mcp.CallToolRequest{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServer(t, &fakeClient{})
+			var seen string
+			handler := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				seen = traceIDFromContext(ctx)
+				return mcp.NewToolResultText("ok"), nil
+			}
+
+			if _, err := s.withTrace(handler)(tt.ctx, tt.request); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			switch {
+			case tt.want != "" && seen != tt.want:
+				t.Errorf("trace ID = %q, want %q", seen, tt.want)
+			case tt.want == "" && (seen == "" || strings.Contains(seen, " ")):
+				t.Errorf("expected a freshly generated trace ID, got %q", seen)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// careTrait is a named care characteristic defined by a threshold on one of a plant's ranges
+type careTrait struct {
+	name     string
+	criteria string // human-readable threshold, shown with the results
+	// match reports whether the plant has the trait; known is false when the plant lacks data
+	match func(d *openplantbook.PlantDetails) (matches bool, known bool)
+}
+
+// careTraits is the trait table used by group_by_trait
+var careTraits = []careTrait{
+	{
+		name:     "high humidity",
+		criteria: "minimum humidity of 60% or more",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinEnvHumid >= 60, d.MaxEnvHumid > 0
+		},
+	},
+	{
+		name:     "low humidity tolerant",
+		criteria: "minimum humidity of 30% or less",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinEnvHumid <= 30, d.MaxEnvHumid > 0
+		},
+	},
+	{
+		name:     "low light tolerant",
+		criteria: "minimum light of 1500 lux or less",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinLightLux <= 1500, d.MaxLightLux > 0
+		},
+	},
+	{
+		name:     "bright light",
+		criteria: "minimum light of 10000 lux or more",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinLightLux >= 10000, d.MaxLightLux > 0
+		},
+	},
+	{
+		name:     "drought tolerant",
+		criteria: "minimum soil moisture of 15% or less",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinSoilMoist <= 15, d.MaxSoilMoist > 0
+		},
+	},
+	{
+		name:     "moisture loving",
+		criteria: "minimum soil moisture of 30% or more",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinSoilMoist >= 30, d.MaxSoilMoist > 0
+		},
+	},
+	{
+		name:     "cold tolerant",
+		criteria: "minimum temperature of 5°C or less",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinTemp <= 5, d.MaxTemp > 0
+		},
+	},
+	{
+		name:     "warmth loving",
+		criteria: "minimum temperature of 15°C or more",
+		match: func(d *openplantbook.PlantDetails) (bool, bool) {
+			return d.MinTemp >= 15, d.MaxTemp > 0
+		},
+	},
+}
+
+// lookupTrait finds a trait by name, ignoring case and separators
+func lookupTrait(name string) (careTrait, bool) {
+	normalized := strings.Join(strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-'
+	}), " ")
+	for _, t := range careTraits {
+		if t.name == normalized {
+			return t, true
+		}
+	}
+	return careTrait{}, false
+}
+
+// traitNames lists the supported trait names
+func traitNames() []string {
+	names := make([]string, len(careTraits))
+	for i, t := range careTraits {
+		names[i] = t.name
+	}
+	return names
+}
+
+// plantFetch is the result of fetching one plant's details
+type plantFetch struct {
+	pid     string
+	details *openplantbook.PlantDetails
+	err     error
+}
+
+// fetchPlantsConcurrently fetches details for every pid in the default language.
+// Results are returned in the same order as pids.
+func (s *Server) fetchPlantsConcurrently(ctx context.Context, pids []string) []plantFetch {
+	return s.fetchPlantsInLanguage(ctx, pids, "")
+}
+
+// fetchPlantsInLanguage fetches details for every pid in parallel on a pool of at most
+// fetchConcurrency workers, walking the fallback chain from language like get_plant_care.
+// Results are returned in the same order as pids.
+func (s *Server) fetchPlantsInLanguage(ctx context.Context, pids []string, language string) []plantFetch {
+	results := make([]plantFetch, len(pids))
+	s.runConcurrently(len(pids), func(i int) {
+		details, err := s.getPlantDetails(ctx, pids[i], language)
+		results[i] = plantFetch{pid: pids[i], details: details, err: err}
+	})
+	return results
+}
+
+// traitBuckets groups plants by whether they have a trait
+type traitBuckets struct {
+	matching    []string
+	notMatching []string
+	unknown     []string
+	failed      []string
+}
+
+// bucketByTrait sorts fetched plants into trait buckets
+func bucketByTrait(trait careTrait, plants []plantFetch) traitBuckets {
+	var b traitBuckets
+	for _, p := range plants {
+		if p.err != nil {
+			b.failed = append(b.failed, fmt.Sprintf("%s (%v)", p.pid, p.err))
+			continue
+		}
+		label := fmt.Sprintf("%s (%s)", p.details.Alias, p.details.PID)
+		matches, known := trait.match(p.details)
+		switch {
+		case !known:
+			b.unknown = append(b.unknown, label)
+		case matches:
+			b.matching = append(b.matching, label)
+		default:
+			b.notMatching = append(b.notMatching, label)
+		}
+	}
+	return b
+}
+
+// handleGroupByTrait handles the group_by_trait tool
+func (s *Server) handleGroupByTrait(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "group_by_trait")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	traitName, err := request.RequireString("trait")
+	if err != nil {
+		logger.Warn("invalid trait parameter", "error", err)
+		return mcp.NewToolResultError("trait parameter is required and must be a string"), nil
+	}
+
+	trait, ok := lookupTrait(traitName)
+	if !ok {
+		logger.Warn("unknown trait", "trait", traitName)
+		return mcp.NewToolResultError(fmt.Sprintf("unknown trait %q (supported: %s)", traitName, strings.Join(traitNames(), ", "))), nil
+	}
+
+	logger.Info("grouping plants by trait", "trait", trait.name, "pids", len(pids))
+
+	buckets := bucketByTrait(trait, s.fetchPlantsConcurrently(ctx, pids))
+
+	output := fmt.Sprintf("# Plants Grouped by Trait: %s\n\n", trait.name)
+	output += fmt.Sprintf("Criteria: %s\n\n", trait.criteria)
+	for _, section := range []struct {
+		title string
+		names []string
+	}{
+		{"Matches", buckets.matching},
+		{"Does Not Match", buckets.notMatching},
+		{"No Data", buckets.unknown},
+		{"Not Found", buckets.failed},
+	} {
+		if len(section.names) == 0 {
+			continue
+		}
+		sort.Strings(section.names)
+		output += fmt.Sprintf("## %s (%d)\n\n", section.title, len(section.names))
+		for _, name := range section.names {
+			output += fmt.Sprintf("- %s\n", name)
+		}
+		output += "\n"
+	}
+
+	logger.Info("grouping completed", "matching", len(buckets.matching), "not_matching", len(buckets.notMatching))
+
+	return mcp.NewToolResultText(output), nil
+}
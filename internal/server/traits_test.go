@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestLookupTrait(t *testing.T) {
+	for _, name := range []string{"high humidity", "High-Humidity", "high_humidity", "  high   humidity "} {
+		if trait, ok := lookupTrait(name); !ok || trait.name != "high humidity" {
+			t.Errorf("lookupTrait(%q) = %q, %v", name, trait.name, ok)
+		}
+	}
+	if _, ok := lookupTrait("purple leaves"); ok {
+		t.Error("expected unknown trait to fail")
+	}
+}
+
+func TestHandleGroupByTrait(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"fern|":   {PID: "fern", Alias: "Fern", MinEnvHumid: 60, MaxEnvHumid: 90},
+		"cactus|": {PID: "cactus", Alias: "Cactus", MinEnvHumid: 10, MaxEnvHumid: 40},
+		"moss|":   {PID: "moss", Alias: "Moss"},
+	}}
+	s := newTestServer(t, client)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"pids":  []interface{}{"fern", "cactus", "moss", "missing"},
+		"trait": "high humidity",
+	}
+
+	result, err := s.handleGroupByTrait(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handleGroupByTrait() error = %v", err)
+	}
+	text := resultText(t, result)
+	for _, want := range []string{
+		"Criteria: minimum humidity of 60% or more",
+		"## Matches (1)\n\n- Fern (fern)",
+		"## Does Not Match (1)\n\n- Cactus (cactus)",
+		"## No Data (1)\n\n- Moss (moss)",
+		"## Not Found (1)",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Transports selectable with -transport. "sse" is another name for the HTTP/SSE transport.
+const (
+	transportStdio = "stdio"
+	transportHTTP  = "http"
+	transportSSE   = "sse"
+)
+
+// transports lists the accepted transport values
+var transports = []string{transportStdio, transportHTTP, transportSSE}
+
+// defaultListenAddr is where the HTTP transport listens unless listen_addr says otherwise.
+// It only accepts local connections; bind to 0.0.0.0 explicitly to serve the network.
+const defaultListenAddr = "127.0.0.1:8080"
+
+// httpShutdownTimeout is how long in-flight HTTP requests get to finish on shutdown
+const httpShutdownTimeout = 10 * time.Second
+
+// transport returns the configured transport, stdio when unset
+func (s *Server) transport() string {
+	switch s.config.Transport {
+	case "":
+		return transportStdio
+	case transportSSE:
+		return transportHTTP
+	}
+	return s.config.Transport
+}
+
+// listenAddr returns the configured HTTP listen address or the default
+func (s *Server) listenAddr() string {
+	if s.config.ListenAddr != "" {
+		return s.config.ListenAddr
+	}
+	return defaultListenAddr
+}
+
+// httpContext prepares the context of each HTTP tool call: the session's Accept-Language
+// sets its default languages
+func httpContext(ctx context.Context, r *http.Request) context.Context {
+	return withSessionLanguages(ctx, parseAcceptLanguage(r.Header.Get("Accept-Language")))
+}
+
+// requireBearerToken rejects requests without an "Authorization: Bearer <token>" header
+// matching token. The comparison takes constant time so the token can't be guessed from
+// response timings.
+func requireBearerToken(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="openplantbook-mcp"`)
+			http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newSSEServer creates the mcp-go HTTP/SSE server: clients connect to /sse for events and
+// post requests to /message. Request bodies are capped at max_request_bytes, and every
+// request must carry server_auth_token when one is configured.
+func (s *Server) newSSEServer(mcpServer *server.MCPServer, httpServer *http.Server) *server.SSEServer {
+	sseServer := server.NewSSEServer(mcpServer,
+		server.WithHTTPServer(httpServer),
+		server.WithSSEContextFunc(httpContext),
+		server.WithKeepAlive(true),
+	)
+	handler := limitRequestBody(s.maxRequestBytes(), sseServer)
+	if s.config.ServerAuthToken != "" {
+		handler = requireBearerToken(s.config.ServerAuthToken, handler)
+	}
+	httpServer.Handler = handler
+	return sseServer
+}
+
+// serveHTTP serves MCP over HTTP/SSE until ctx is cancelled, then stops accepting
+// connections and gives in-flight requests httpShutdownTimeout to finish
+func (s *Server) serveHTTP(ctx context.Context, mcpServer *server.MCPServer) error {
+	addr := s.listenAddr()
+	httpServer := &http.Server{Addr: addr, ReadHeaderTimeout: 10 * time.Second}
+	sseServer := s.newSSEServer(mcpServer, httpServer)
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- sseServer.Start(addr)
+	}()
+	s.logger.Info("starting http server", "addr", addr, "auth", s.config.ServerAuthToken != "")
+	if s.config.ServerAuthToken == "" {
+		s.logger.Warn("http transport has no authentication; anyone who can reach the listen address can use this server and its OpenPlantbook credentials", "addr", addr)
+	}
+
+	select {
+	case err := <-errChan:
+		return fmt.Errorf("serve http: %w", err)
+	case <-ctx.Done():
+	}
+
+	s.logger.Info("shutting down http server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	defer cancel()
+	if err := sseServer.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("shutdown http: %w", err)
+	}
+	if err := <-errChan; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("serve http: %w", err)
+	}
+	return nil
+}
+
+// validateTransport checks the configured transport before serving
+func (s *Server) validateTransport() error {
+	for _, t := range transports {
+		if s.transport() == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("transport must be one of: %s", strings.Join(transports, ", "))
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPContext(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/message", nil)
+	r.Header.Set("Accept-Language", "de-AT,de;q=0.9,en;q=0.5")
+
+	ctx := httpContext(context.Background(), r)
+
+	if got, want := sessionLanguages(ctx), []string{"de", "en"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("session languages = %v, want %v", got, want)
+	}
+}
+
+func TestValidateTransport(t *testing.T) {
+	tests := []struct {
+		transport string
+		wantErr   bool
+	}{
+		{"", false},
+		{transportStdio, false},
+		{transportHTTP, false},
+		{transportSSE, false},
+		{"websocket", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.transport, func(t *testing.T) {
+			srv := newTestServer(t, &fakeClient{})
+			srv.config.Transport = tt.transport
+			if err := srv.validateTransport(); (err != nil) != tt.wantErr {
+				t.Errorf("validateTransport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTransport_SSEAlias(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.Transport = transportSSE
+	if got := srv.transport(); got != transportHTTP {
+		t.Errorf("transport() = %q, want %q", got, transportHTTP)
+	}
+}
+
+func TestRequireBearerToken(t *testing.T) {
+	handler := requireBearerToken("s3cret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name          string
+		authorization string
+		want          int
+	}{
+		{"valid token", "Bearer s3cret", http.StatusNoContent},
+		{"missing header", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer guess", http.StatusUnauthorized},
+		{"token prefix only", "Bearer s3c", http.StatusUnauthorized},
+		{"wrong scheme", "Basic s3cret", http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/sse", nil)
+			if tt.authorization != "" {
+				r.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+			if tt.want == http.StatusUnauthorized && !strings.HasPrefix(w.Header().Get("WWW-Authenticate"), "Bearer") {
+				t.Errorf("401 should challenge for a bearer token, got %q", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestServeHTTP_GracefulShutdown(t *testing.T) {
+	// Reserve a free port, then hand it to the server
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	srv := newTestServer(t, &fakeClient{})
+	srv.config.ListenAddr = addr
+	srv.config.MaxRequestBytes = 64
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.serveHTTP(ctx, srv.newMCPServer())
+	}()
+
+	// Wait for the listener, then check the body limit is in front of the MCP handler
+	var resp *http.Response
+	for i := 0; i < 50; i++ {
+		resp, err = http.Post("http://"+addr+"/message?sessionId=x", "application/json", strings.NewReader(strings.Repeat("x", 100)))
+		if err == nil {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("server never came up: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusRequestEntityTooLarge {
+		t.Errorf("oversized request status = %d, want %d", resp.StatusCode, http.StatusRequestEntityTooLarge)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("serveHTTP() after cancel = %v, want nil", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("serveHTTP() did not stop after the context was cancelled")
+	}
+}
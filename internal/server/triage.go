@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// triageCondition is a metric reading outside its range in one direction
+type triageCondition struct {
+	metric string
+	high   bool
+}
+
+// triageRule is a first-aid action for a crisis. The first condition must be severe
+// (critical on the status_badge scale); any further conditions only need to be out of
+// range, so combined rules can tailor the advice.
+type triageRule struct {
+	when     []triageCondition
+	priority int // 1 is most urgent
+	action   string
+}
+
+// triageRules is checked in order and a metric is only covered by the first rule that
+// matches it, so combined rules come before the single-metric ones they refine
+var triageRules = []triageRule{
+	{
+		when:     []triageCondition{{"moisture", true}, {"temperature", false}},
+		priority: 1,
+		action:   "Do not water - cold, waterlogged soil is how roots rot. Empty the saucer and move the plant somewhere warmer",
+	},
+	{
+		when:     []triageCondition{{"moisture", true}},
+		priority: 1,
+		action:   "Do not water - roots may be rotting. Empty the saucer, and if the soil stays soggy for days, unpot it and cut away brown, mushy roots",
+	},
+	{
+		when:     []triageCondition{{"moisture", false}, {"light_lux", true}},
+		priority: 1,
+		action:   "Move it out of direct sun immediately, then water slowly until it drains - a parched plant in full sun scorches within hours",
+	},
+	{
+		when:     []triageCondition{{"moisture", false}},
+		priority: 2,
+		action:   "Water thoroughly now; if the soil has shrunk away from the pot, soak the whole pot in a basin for 20 minutes",
+	},
+	{
+		when:     []triageCondition{{"temperature", true}},
+		priority: 1,
+		action:   "Move it somewhere cooler and out of direct sun immediately",
+	},
+	{
+		when:     []triageCondition{{"temperature", false}},
+		priority: 1,
+		action:   "Move it away from cold windows and drafts into a warm room immediately",
+	},
+	{
+		when:     []triageCondition{{"light_lux", true}},
+		priority: 2,
+		action:   "Move it out of direct sun immediately; scorched leaves won't recover, but new growth will",
+	},
+	{
+		when:     []triageCondition{{"light_lux", false}},
+		priority: 3,
+		action:   "Move it to the brightest spot you have, out of harsh midday sun, or put it under a grow light",
+	},
+	{
+		when:     []triageCondition{{"humidity", false}},
+		priority: 3,
+		action:   "Move it away from heaters and stand it on a pebble tray or next to a humidifier",
+	},
+	{
+		when:     []triageCondition{{"humidity", true}},
+		priority: 3,
+		action:   "Open a window or run a fan to move the air, and keep water off the leaves to stop fungal rot",
+	},
+}
+
+// triageStep is a matched first-aid action and the readings behind it
+type triageStep struct {
+	rule    triageRule
+	reasons []metricBadge // the severe metric first
+}
+
+// triageSteps matches each severe metric to its first-aid rule, most urgent first. Metrics
+// covered by a combined rule don't get a step of their own. less is the number of
+// out-of-range metrics that aren't severe.
+func triageSteps(rated []metricBadge) (steps []triageStep, less int) {
+	byKey := map[string]metricBadge{}
+	for _, b := range rated {
+		byKey[b.metric.key] = b
+		if b.status == badgeAttention {
+			less++
+		}
+	}
+	matches := func(c triageCondition) (metricBadge, bool) {
+		b, ok := byKey[c.metric]
+		return b, ok && b.status != badgeHealthy && (b.value > b.max) == c.high
+	}
+
+	covered := map[string]bool{}
+	for _, rule := range triageRules {
+		first, ok := matches(rule.when[0])
+		if !ok || first.status != badgeCritical || covered[first.metric.key] {
+			continue
+		}
+		reasons := []metricBadge{first}
+		for _, c := range rule.when[1:] {
+			if other, ok := matches(c); ok && !covered[other.metric.key] {
+				reasons = append(reasons, other)
+			}
+		}
+		if len(reasons) < len(rule.when) {
+			continue
+		}
+		for _, r := range reasons {
+			covered[r.metric.key] = true
+			if r.status == badgeAttention {
+				less--
+			}
+		}
+		steps = append(steps, triageStep{rule: rule, reasons: reasons})
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		return steps[i].rule.priority < steps[j].rule.priority
+	})
+	return steps, less
+}
+
+// formatTriage renders the emergency action list
+func formatTriage(details *openplantbook.PlantDetails, steps []triageStep, less, rated int) string {
+	if len(steps) == 0 {
+		output := fmt.Sprintf("# Triage for %s\n\n", details.Alias)
+		output += fmt.Sprintf("✅ No emergency: none of the %d readings is severely out of range.", rated)
+		if less > 0 {
+			output += fmt.Sprintf(" %d reading(s) need attention; use compare_conditions or top_recommendation for routine fixes.", less)
+		}
+		return output + "\n"
+	}
+
+	output := fmt.Sprintf("# 🚨 Emergency Triage for %s\n\n", details.Alias)
+	output += fmt.Sprintf("**%d severe problem(s)** - do these now, in order:\n\n", len(steps))
+	for i, step := range steps {
+		output += fmt.Sprintf("%d. **%s.**", i+1, step.rule.action)
+		for _, r := range step.reasons {
+			output += fmt.Sprintf(" _(%s)_", badgeReason(r))
+		}
+		output += "\n"
+	}
+	output += "\n"
+
+	if less > 0 {
+		output += fmt.Sprintf("%d other reading(s) are off but not severe; deal with them once the plant is stable.\n\n", less)
+	}
+	output += "_Don't fertilize or repot a plant in crisis; wait until it puts out new growth._\n"
+	return output
+}
+
+// handleTriage handles the triage tool
+func (s *Server) handleTriage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "triage")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	conditions, ok := request.GetArguments()["current_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid current_conditions parameter")
+		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
+	}
+
+	logger.Info("triaging plant", "pid", pid)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if !hasCareData(details) {
+		logger.Warn("plant has no care data", "pid", pid)
+		return mcp.NewToolResultError(noCareDataMessage(pid)), nil
+	}
+
+	rated := rateMetrics(details, conditions, s.badgeCriticalDeviation())
+	if len(rated) == 0 {
+		return mcp.NewToolResultError("no comparable conditions provided: supply moisture, temperature, light_lux, or humidity for metrics this plant has data for"), nil
+	}
+	steps, less := triageSteps(rated)
+
+	logger.Info("triage complete", "pid", details.PID, "steps", len(steps), "less_urgent", less)
+
+	return mcp.NewToolResultText(formatTriage(details, steps, less, len(rated))), nil
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestTriageSteps(t *testing.T) {
+	details := &openplantbook.PlantDetails{
+		MinSoilMoist: 20, MaxSoilMoist: 60, MinTemp: 18, MaxTemp: 27,
+		MinLightLux: 2000, MaxLightLux: 6000, MinEnvHumid: 40, MaxEnvHumid: 70,
+	}
+
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		want       []string // first words of each action, in order
+		less       int
+	}{
+		{"all fine", map[string]interface{}{"moisture": 40.0, "temperature": 22.0}, nil, 0},
+		{"mild issues are not a crisis", map[string]interface{}{"moisture": 65.0, "humidity": 35.0}, nil, 2},
+		{"soggy soil", map[string]interface{}{"moisture": 90.0}, []string{"Do not water - roots may be rotting"}, 0},
+		{"soggy and cold combine", map[string]interface{}{"moisture": 90.0, "temperature": 17.0}, []string{"Do not water - cold, waterlogged soil"}, 0},
+		{"parched in full sun", map[string]interface{}{"moisture": 5.0, "light_lux": 20000.0}, []string{"Move it out of direct sun immediately, then water"}, 0},
+		{"parched in the shade", map[string]interface{}{"moisture": 5.0, "light_lux": 3000.0}, []string{"Water thoroughly now"}, 0},
+		{"priority order", map[string]interface{}{"humidity": 10.0, "temperature": 35.0, "moisture": 5.0, "light_lux": 5000.0}, []string{"Move it somewhere cooler", "Water thoroughly now", "Move it away from heaters"}, 0},
+		{"cold snap", map[string]interface{}{"temperature": 10.0, "humidity": 35.0}, []string{"Move it away from cold windows"}, 1},
+		{"dark corner", map[string]interface{}{"light_lux": 200.0}, []string{"Move it to the brightest spot"}, 0},
+		{"muggy", map[string]interface{}{"humidity": 95.0}, []string{"Open a window"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps, less := triageSteps(rateMetrics(details, tt.conditions, defaultBadgeCriticalDeviation))
+			var got []string
+			for i, step := range steps {
+				if i >= len(tt.want) || !strings.HasPrefix(step.rule.action, tt.want[i]) {
+					got = append(got, step.rule.action)
+					continue
+				}
+				got = append(got, tt.want[i])
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("steps = %v, want prefixes %v", got, tt.want)
+			}
+			if less != tt.less {
+				t.Errorf("less urgent = %d, want %d", less, tt.less)
+			}
+		})
+	}
+}
+
+func TestTriageRulesCoverEveryMetric(t *testing.T) {
+	for _, m := range careMetrics {
+		for _, high := range []bool{false, true} {
+			found := false
+			for _, rule := range triageRules {
+				if len(rule.when) == 1 && rule.when[0] == (triageCondition{m.key, high}) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("no single-metric triage rule for %s (high=%v)", m.key, high)
+			}
+		}
+	}
+}
+
+func TestHandleTriage(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|en": {PID: "monstera deliciosa", Alias: "Monstera", MinSoilMoist: 20, MaxSoilMoist: 60, MinTemp: 18, MaxTemp: 27},
+	}})
+
+	request := mcp.CallToolRequest{}
+	request.Params.Arguments = map[string]interface{}{
+		"pid":                "monstera deliciosa",
+		"current_conditions": map[string]interface{}{"moisture": 95.0, "temperature": 24.0},
+	}
+	result, err := srv.handleTriage(context.Background(), request)
+	if err != nil || result.IsError {
+		t.Fatalf("triage failed: %v %s", err, resultText(t, result))
+	}
+	text := resultText(t, result)
+	for _, want := range []string{"Emergency Triage for Monstera", "1 severe problem(s)", "1. **Do not water", "Soil Moisture high: 95%"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in:\n%s", want, text)
+		}
+	}
+
+	request.Params.Arguments = map[string]interface{}{
+		"pid":                "monstera deliciosa",
+		"current_conditions": map[string]interface{}{"moisture": 40.0},
+	}
+	result, _ = srv.handleTriage(context.Background(), request)
+	if text := resultText(t, result); !strings.Contains(text, "No emergency") {
+		t.Errorf("expected no emergency, got:\n%s", text)
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// luxPerFootCandle is the number of lux in one foot-candle
+const luxPerFootCandle = 10.764
+
+// celsiusToFahrenheit converts a temperature from °C to °F
+func celsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+// fahrenheitToCelsius converts a temperature from °F to °C
+func fahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
+
+// luxToFootCandles converts an illuminance from lux to foot-candles
+func luxToFootCandles(lux float64) float64 {
+	return lux / luxPerFootCandle
+}
+
+// footCandlesToLux converts an illuminance from foot-candles to lux
+func footCandlesToLux(fc float64) float64 {
+	return fc * luxPerFootCandle
+}
+
+// Unit systems accepted by the conversion tools
+const (
+	unitsMetric   = "metric"
+	unitsImperial = "imperial"
+)
+
+// convertConditions converts a current_conditions object between unit systems.
+// Metric uses temperature (°C) and light_lux; imperial uses temperature (°F) and
+// light_fc (foot-candles). Percentages (moisture, humidity), soil_ec and any
+// unknown keys pass through unchanged.
+func convertConditions(conditions map[string]interface{}, from, to string) (map[string]interface{}, error) {
+	for _, system := range []string{from, to} {
+		if system != unitsMetric && system != unitsImperial {
+			return nil, fmt.Errorf("unknown unit system %q (use %q or %q)", system, unitsMetric, unitsImperial)
+		}
+	}
+
+	converted := make(map[string]interface{}, len(conditions))
+	for key, value := range conditions {
+		converted[key] = value
+	}
+	if from == to {
+		return converted, nil
+	}
+
+	if temp, ok := conditions["temperature"].(float64); ok {
+		if to == unitsImperial {
+			converted["temperature"] = roundTo(celsiusToFahrenheit(temp), 1)
+		} else {
+			converted["temperature"] = roundTo(fahrenheitToCelsius(temp), 1)
+		}
+	}
+
+	if to == unitsImperial {
+		if lux, ok := conditions["light_lux"].(float64); ok {
+			delete(converted, "light_lux")
+			converted["light_fc"] = roundTo(luxToFootCandles(lux), 1)
+		}
+	} else {
+		if fc, ok := conditions["light_fc"].(float64); ok {
+			delete(converted, "light_fc")
+			converted["light_lux"] = math.Round(footCandlesToLux(fc))
+		}
+	}
+
+	return converted, nil
+}
+
+// roundTo rounds a value to the given number of decimal places
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
+
+// handleConvertConditions handles the convert_conditions tool
+func (s *Server) handleConvertConditions(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "convert_conditions")
+
+	// Extract parameters
+	conditions, ok := request.GetArguments()["current_conditions"].(map[string]interface{})
+	if !ok {
+		logger.Warn("invalid current_conditions parameter")
+		return mcp.NewToolResultError("current_conditions parameter is required and must be an object"), nil
+	}
+
+	from, err := request.RequireString("from")
+	if err != nil {
+		logger.Warn("invalid from parameter", "error", err)
+		return mcp.NewToolResultError("from parameter is required and must be 'metric' or 'imperial'"), nil
+	}
+
+	to, err := request.RequireString("to")
+	if err != nil {
+		logger.Warn("invalid to parameter", "error", err)
+		return mcp.NewToolResultError("to parameter is required and must be 'metric' or 'imperial'"), nil
+	}
+
+	logger.Info("converting conditions", "from", from, "to", to)
+
+	converted, err := convertConditions(conditions, from, to)
+	if err != nil {
+		logger.Warn("conversion failed", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	// Format response
+	data, err := json.MarshalIndent(map[string]interface{}{
+		"unit_system":        to,
+		"current_conditions": converted,
+	}, "", "  ")
+	if err != nil {
+		logger.Error("marshal conditions failed", "error", err)
+		return mcp.NewToolResultError("failed to format converted conditions"), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
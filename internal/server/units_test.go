@@ -0,0 +1,129 @@
+package server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestConvertConditions(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions map[string]interface{}
+		from, to   string
+		expected   map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "imperial to metric",
+			conditions: map[string]interface{}{"temperature": 72.0, "light_fc": 200.0, "moisture": 40.0, "humidity": 55.0},
+			from:       "imperial",
+			to:         "metric",
+			expected:   map[string]interface{}{"temperature": 22.2, "light_lux": 2153.0, "moisture": 40.0, "humidity": 55.0},
+		},
+		{
+			name:       "metric to imperial",
+			conditions: map[string]interface{}{"temperature": 20.0, "light_lux": 10764.0, "soil_ec": 800.0},
+			from:       "metric",
+			to:         "imperial",
+			expected:   map[string]interface{}{"temperature": 68.0, "light_fc": 1000.0, "soil_ec": 800.0},
+		},
+		{
+			name:       "same system passes through",
+			conditions: map[string]interface{}{"temperature": 20.0},
+			from:       "metric",
+			to:         "metric",
+			expected:   map[string]interface{}{"temperature": 20.0},
+		},
+		{
+			name:       "imperial input already in lux",
+			conditions: map[string]interface{}{"light_lux": 500.0},
+			from:       "imperial",
+			to:         "metric",
+			expected:   map[string]interface{}{"light_lux": 500.0},
+		},
+		{
+			name:       "unknown system",
+			conditions: map[string]interface{}{},
+			from:       "kelvin",
+			to:         "metric",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertConditions(tt.conditions, tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("convertConditions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("convertConditions() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTemperatureConversionRoundTrip(t *testing.T) {
+	for _, c := range []float64{-40, 0, 18.5, 37} {
+		if got := fahrenheitToCelsius(celsiusToFahrenheit(c)); roundTo(got, 9) != c {
+			t.Errorf("round trip of %v°C = %v", c, got)
+		}
+	}
+}
+
+func TestCompareConditions_Units(t *testing.T) {
+	details := &openplantbook.PlantDetails{Alias: "Pothos", MinTemp: 15, MaxTemp: 30}
+
+	tests := []struct {
+		name        string
+		temperature float64
+		metric      bool
+		want        string
+		notWant     string
+	}{
+		// 72°F is 22.2°C: compared raw against 15-30 it would look too hot
+		{"fahrenheit in range", 72, false, "✅ **Temperature**: 72.0°F (within 59.0-86.0°F range)", "Too High"},
+		{"fahrenheit too cold", 50, false, "Current 50.0°F, needs 59.0-86.0°F (9.0°F below minimum)", "°C"},
+		{"fahrenheit too hot", 95, false, "(9.0°F above maximum)", "°C"},
+		{"celsius in range", 22, true, "✅ **Temperature**: 22.0°C (within 15.0-30.0°C range)", "°F"},
+		{"celsius too hot", 35, true, "(5.0°C above maximum)", "°F"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis := compareConditions(details, map[string]interface{}{"temperature": tt.temperature}, tt.metric, numberPrecision{}, false)
+			if !strings.Contains(analysis, tt.want) {
+				t.Errorf("analysis missing %q:\n%s", tt.want, analysis)
+			}
+			if strings.Contains(analysis, tt.notWant) {
+				t.Errorf("analysis should not contain %q:\n%s", tt.notWant, analysis)
+			}
+		})
+	}
+}
+
+func TestHandleCompareConditions_Fahrenheit(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"epipremnum aureum|en": {PID: "epipremnum aureum", Alias: "Pothos", MinTemp: 15, MaxTemp: 30},
+	}}
+	s := newTestServer(t, client)
+
+	req := mcp.CallToolRequest{}
+	req.Params.Arguments = map[string]interface{}{
+		"pid":                "epipremnum aureum",
+		"current_conditions": map[string]interface{}{"temperature": []interface{}{70.0, 74.0}},
+		"metric":             false,
+	}
+	result, err := s.handleCompareConditions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("handleCompareConditions() error = %v", err)
+	}
+	if text := resultText(t, result); !strings.Contains(text, "✅ **Temperature**: 72.0°F") {
+		t.Errorf("expected the averaged °F reading in range:\n%s", text)
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// apiUsage counts upstream OpenPlantbook API calls and the calls saved by caching.
+// Counters are updated atomically so concurrent tool calls can share them.
+type apiUsage struct {
+	started time.Time
+
+	searchCalls atomic.Int64
+	detailCalls atomic.Int64
+	failedCalls atomic.Int64
+
+	// rateLimited counts calls the local rate limiter stopped before they reached the API
+	rateLimited atomic.Int64
+
+	searchCacheHits  atomic.Int64
+	detailCacheHits  atomic.Int64
+	suggestCacheHits atomic.Int64
+}
+
+// apiUsageSnapshot is a point-in-time copy of the counters
+type apiUsageSnapshot struct {
+	Since         string           `json:"since,omitempty"`
+	UpstreamCalls apiCallCounts    `json:"upstream_calls"`
+	FailedCalls   int64            `json:"failed_calls"`
+	RateLimited   int64            `json:"rate_limited"`
+	CacheHits     apiCacheHitCount `json:"cache_hits"`
+
+	// Caches reports each in-memory cache's size and LRU evictions; omitted when caching is off
+	Caches map[string]cacheStats `json:"caches,omitempty"`
+}
+
+// apiCallCounts breaks upstream calls down by endpoint
+type apiCallCounts struct {
+	Total   int64 `json:"total"`
+	Search  int64 `json:"search"`
+	Details int64 `json:"details"`
+}
+
+// apiCacheHitCount breaks down calls answered from cache, i.e. upstream calls saved
+type apiCacheHitCount struct {
+	Total        int64 `json:"total"`
+	Search       int64 `json:"search"`
+	Details      int64 `json:"details"`
+	Autocomplete int64 `json:"autocomplete"`
+}
+
+// recordCall counts an upstream call and whether it failed. A call the rate limiter
+// rejected or timed out never reached the API, so it is only counted as rate limited.
+func (u *apiUsage) recordCall(counter *atomic.Int64, err error) {
+	if errors.Is(err, ErrRateLimited) {
+		u.rateLimited.Add(1)
+		return
+	}
+	counter.Add(1)
+	if err != nil {
+		u.failedCalls.Add(1)
+	}
+}
+
+// snapshot reads the counters
+func (u *apiUsage) snapshot() apiUsageSnapshot {
+	search, details := u.searchCalls.Load(), u.detailCalls.Load()
+	searchHits, detailHits, suggestHits := u.searchCacheHits.Load(), u.detailCacheHits.Load(), u.suggestCacheHits.Load()
+
+	snap := apiUsageSnapshot{
+		UpstreamCalls: apiCallCounts{Total: search + details, Search: search, Details: details},
+		FailedCalls:   u.failedCalls.Load(),
+		RateLimited:   u.rateLimited.Load(),
+		CacheHits: apiCacheHitCount{
+			Total:        searchHits + detailHits + suggestHits,
+			Search:       searchHits,
+			Details:      detailHits,
+			Autocomplete: suggestHits,
+		},
+	}
+	if !u.started.IsZero() {
+		snap.Since = u.started.UTC().Format(time.RFC3339)
+	}
+	return snap
+}
+
+// cacheStats reports every enabled cache by name
+func (s *Server) cacheStats() map[string]cacheStats {
+	caches := map[string]*responseCache{
+		"responses":    s.cache,
+		"autocomplete": s.suggestCache,
+		"not_found":    s.missingCache,
+		"validators":   s.validators,
+	}
+	stats := map[string]cacheStats{}
+	for name, c := range caches {
+		if c != nil {
+			stats[name] = c.stats()
+		}
+	}
+	if s.fileCache != nil {
+		stats["file"] = cacheStats{Entries: s.fileCache.entries()}
+	}
+	if len(stats) == 0 {
+		return nil
+	}
+	return stats
+}
+
+// usageSnapshot is the API usage counters plus the current cache sizes
+func (s *Server) usageSnapshot() apiUsageSnapshot {
+	snap := s.usage.snapshot()
+	snap.Caches = s.cacheStats()
+	return snap
+}
+
+// handleAPIUsage handles the api_usage tool
+func (s *Server) handleAPIUsage(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "api_usage")
+
+	logger.Info("retrieving API usage")
+
+	data, err := json.MarshalIndent(s.usageSnapshot(), "", "  ")
+	if err != nil {
+		logger.Error("marshal API usage failed", "error", err)
+		return mcp.NewToolResultError("failed to format API usage"), nil
+	}
+
+	return mcp.NewToolResultText(string(data)), nil
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestAPIUsage_Counts(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"basil|": {PID: "basil", Alias: "basil"},
+	}}
+	s := newTestServer(t, client)
+	s.cache = newResponseCache(time.Hour, 0)
+	ctx := context.Background()
+
+	// Concurrent fetches must all be counted
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = s.fetchDetails(withNoCache(ctx), "basil", "en")
+		}()
+	}
+	wg.Wait()
+
+	// One cache hit, one failed lookup, one search
+	if _, err := s.fetchDetails(ctx, "basil", "en"); err != nil {
+		t.Fatalf("fetchDetails() error = %v", err)
+	}
+	_, _ = s.fetchDetails(ctx, "missing", "en")
+	_, _ = s.searchPlants(ctx, "basil", &openplantbook.SearchOptions{Limit: 5})
+
+	snap := s.usage.snapshot()
+	if snap.UpstreamCalls.Details != 21 || snap.UpstreamCalls.Search != 1 || snap.UpstreamCalls.Total != 22 {
+		t.Errorf("upstream calls = %+v, want 21 details and 1 search", snap.UpstreamCalls)
+	}
+	if snap.FailedCalls != 1 {
+		t.Errorf("failed calls = %d, want 1", snap.FailedCalls)
+	}
+	if snap.CacheHits.Details != 1 || snap.CacheHits.Total != 1 {
+		t.Errorf("cache hits = %+v, want 1 details hit", snap.CacheHits)
+	}
+}
+
+func TestServer_CacheStats(t *testing.T) {
+	s := newTestServer(t, &fakeClient{})
+	if stats := s.cacheStats(); stats != nil {
+		t.Errorf("cacheStats() = %v, want nil with caching off", stats)
+	}
+
+	s.cache = newResponseCache(time.Hour, 1)
+	s.cache.set("a", 1)
+	s.cache.set("b", 2)
+	stats := s.cacheStats()
+	if got := stats["responses"]; got.Entries != 1 || got.Evictions != 1 {
+		t.Errorf("responses stats = %+v, want 1 entry and 1 eviction", got)
+	}
+	if _, ok := stats["autocomplete"]; ok {
+		t.Error("disabled caches should be omitted")
+	}
+
+	s.validators = newResponseCache(validatorCacheTTL, 10)
+	s.validators.set("https://example.com/plant", &storedResponse{etag: `"v1"`, body: []byte("{}")})
+	if got := s.cacheStats()["validators"]; got.Entries != 1 || got.MaxEntries != 10 {
+		t.Errorf("validators stats = %+v, want 1 of 10 entries", got)
+	}
+}
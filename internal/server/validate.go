@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// pidValidation is the outcome of checking one pid
+type pidValidation struct {
+	pid         string
+	displayName string
+	err         error // nil when valid
+}
+
+// valid reports whether the pid resolved to a plant
+func (v pidValidation) valid() bool {
+	return v.err == nil
+}
+
+// notFound reports whether the API said the plant doesn't exist, as opposed to the lookup failing
+func (v pidValidation) notFound() bool {
+	return errors.Is(v.err, openplantbook.ErrNotFound)
+}
+
+// validatePids checks every pid in parallel, at most fetchConcurrency at a time, with a
+// single-language details lookup. Unlike getPlantDetails it does not walk the fallback chain: existence doesn't depend
+// on language, so the first language is enough. Results keep the order of pids.
+func (s *Server) validatePids(ctx context.Context, pids []string) []pidValidation {
+	language := s.languageChain(ctx, "")[0]
+
+	results := make([]pidValidation, len(pids))
+	s.runConcurrently(len(pids), func(i int) {
+		details, err := s.fetchDetails(ctx, pids[i], language)
+		results[i] = pidValidation{pid: pids[i], err: err}
+		if err == nil {
+			results[i].displayName = details.DisplayPID
+			if details.Alias != "" {
+				results[i].displayName += fmt.Sprintf(" (%s)", details.Alias)
+			}
+		}
+	})
+	return results
+}
+
+// formatPidValidation renders valid, invalid and failed pids as separate sections
+func formatPidValidation(results []pidValidation) string {
+	var valid, invalid, failed []string
+	for _, r := range results {
+		switch {
+		case r.valid():
+			valid = append(valid, fmt.Sprintf("`%s` → %s", r.pid, r.displayName))
+		case r.notFound():
+			invalid = append(invalid, fmt.Sprintf("`%s`", r.pid))
+		default:
+			failed = append(failed, fmt.Sprintf("`%s`: %v", r.pid, r.err))
+		}
+	}
+
+	output := fmt.Sprintf("# PID Validation (%d of %d valid)\n\n", len(valid), len(results))
+	for _, section := range []struct {
+		title string
+		items []string
+	}{
+		{"✅ Valid", valid},
+		{"❌ Invalid (not found)", invalid},
+		{"⚠️ Lookup Failed (retry later)", failed},
+	} {
+		if len(section.items) == 0 {
+			continue
+		}
+		output += fmt.Sprintf("## %s\n\n", section.title)
+		for _, item := range section.items {
+			output += fmt.Sprintf("- %s\n", item)
+		}
+		output += "\n"
+	}
+	return output
+}
+
+// handleValidatePids handles the validate_pids tool
+func (s *Server) handleValidatePids(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "validate_pids")
+
+	// Extract parameters
+	pids := request.GetStringSlice("pids", nil)
+	if len(pids) == 0 {
+		logger.Warn("invalid pids parameter")
+		return mcp.NewToolResultError("pids parameter is required and must be a non-empty array of strings"), nil
+	}
+	if err := s.checkBatchSize("pids", len(pids)); err != nil {
+		logger.Warn("batch too large", "pids", len(pids))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("validating pids", "pids", len(pids))
+
+	results := s.validatePids(ctx, pids)
+
+	validCount := 0
+	for _, r := range results {
+		if r.valid() {
+			validCount++
+		}
+	}
+	logger.Info("pid validation completed", "pids", len(pids), "valid", validCount)
+
+	return mcp.NewToolResultText(formatPidValidation(results)), nil
+}
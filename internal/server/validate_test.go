@@ -0,0 +1,65 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestHandleValidatePids(t *testing.T) {
+	client := &fakeClient{
+		details: map[string]*openplantbook.PlantDetails{
+			"monstera deliciosa|": {PID: "monstera deliciosa", DisplayPID: "Monstera deliciosa", Alias: "Monstera"},
+		},
+		detailErrs: map[string]error{"ficus lyrata": errors.New("connection reset")},
+	}
+	srv := newTestServer(t, client)
+	srv.cache = newResponseCache(time.Hour, 0)
+	srv.missingCache = newResponseCache(missingPlantCacheTTL, 0)
+
+	call := func() string {
+		t.Helper()
+		result, err := srv.handleValidatePids(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "validate_pids", Arguments: map[string]interface{}{
+				"pids": []interface{}{"monstera deliciosa", "not a plant", "ficus lyrata"},
+			}},
+		})
+		if err != nil || result.IsError {
+			t.Fatalf("handleValidatePids() = %v, %v", result, err)
+		}
+		return resultText(t, result)
+	}
+
+	text := call()
+	for _, want := range []string{
+		"1 of 3 valid",
+		"`monstera deliciosa` → Monstera deliciosa (Monstera)",
+		"## ❌ Invalid (not found)\n\n- `not a plant`",
+		"`ficus lyrata`: connection reset",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+
+	// Valid and not-found answers are cached; the transient failure is retried
+	client.detailCalls = nil
+	call()
+	if len(client.detailCalls) != 1 || client.detailCalls[0] != "ficus lyrata|en" {
+		t.Errorf("second run calls = %v, want only the failed lookup retried", client.detailCalls)
+	}
+
+	t.Run("empty list", func(t *testing.T) {
+		result, _ := srv.handleValidatePids(context.Background(), mcp.CallToolRequest{
+			Params: mcp.CallToolParams{Name: "validate_pids", Arguments: map[string]interface{}{"pids": []interface{}{}}},
+		})
+		if !result.IsError {
+			t.Error("expected error for empty pids")
+		}
+	})
+}
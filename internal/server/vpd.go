@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// Limits on calculate_vpd inputs; outside them the formula is no longer meaningful for plants
+const (
+	minVPDTemperature = -20.0
+	maxVPDTemperature = 60.0
+	maxLeafTempOffset = 10.0
+)
+
+// saturationVaporPressure is the most water vapor air at temperature (°C) can hold, in
+// kPa, from the Magnus formula with the Tetens coefficients
+func saturationVaporPressure(temperature float64) float64 {
+	return 0.6108 * math.Exp(17.27*temperature/(temperature+237.3))
+}
+
+// leafVaporPressureDeficit is the VPD between a leaf at temperature+leafOffset and the
+// surrounding air, in kPa. With no offset it is the air VPD. It is negative when the leaf
+// is cold enough for dew to form on it.
+func leafVaporPressureDeficit(temperature, humidity, leafOffset float64) float64 {
+	return saturationVaporPressure(temperature+leafOffset) - saturationVaporPressure(temperature)*humidity/100
+}
+
+// vpdBand is a named VPD range, keyed by its upper bound
+type vpdBand struct {
+	below       float64 // the band covers VPDs below this value (kPa); the last band is open-ended
+	name        string
+	description string
+}
+
+// vpdBands lists the VPD bands from most humid to driest, following the usual grower charts
+var vpdBands = []vpdBand{
+	{0.4, "too humid", "leaves barely transpire, so nutrient uptake stalls and mold and mildew thrive"},
+	{0.8, "propagation", "gentle transpiration for cuttings, seedlings and humidity-loving tropicals"},
+	{1.2, "vegetative", "steady transpiration for leafy growth; the sweet spot for most houseplants"},
+	{1.6, "flowering", "strong transpiration for mature and flowering plants"},
+	{0, "too dry", "leaves close their stomata to save water, so growth slows and edges crisp"},
+}
+
+// classifyVPD returns the band a VPD falls in
+func classifyVPD(vpd float64) vpdBand {
+	for _, b := range vpdBands[:len(vpdBands)-1] {
+		if vpd < b.below {
+			return b
+		}
+	}
+	return vpdBands[len(vpdBands)-1]
+}
+
+// formatVPD renders the VPD, its band and the band chart
+func formatVPD(temperature, humidity, leafOffset, vpd float64) string {
+	band := classifyVPD(vpd)
+	output := "# Vapor Pressure Deficit\n\n"
+	output += fmt.Sprintf("**%.2f kPa - %s**: %s.\n\n", vpd, band.name, band.description)
+	output += fmt.Sprintf("- **Air**: %g°C at %g%% humidity, holding %.2f of a possible %.2f kPa of water vapor\n",
+		temperature, humidity, saturationVaporPressure(temperature)*humidity/100, saturationVaporPressure(temperature))
+	if leafOffset != 0 {
+		output += fmt.Sprintf("- **Leaf**: %g°C (%+g°C from the air), saturation %.2f kPa\n", temperature+leafOffset, leafOffset, saturationVaporPressure(temperature+leafOffset))
+	} else {
+		output += "- **Leaf**: assumed at air temperature; pass leaf_temp_offset (typically -1 to -3°C) for leaf VPD\n"
+	}
+	if vpd < 0 {
+		output += "- **Condensation**: the leaf is below the dew point, so water will condense on it\n"
+	}
+
+	output += "\n## Bands\n\n"
+	output += "| VPD (kPa) | Band |\n"
+	output += "|-----------|------|\n"
+	lower := 0.0
+	for i, b := range vpdBands {
+		marker := ""
+		if b.name == band.name {
+			marker = " ⬅"
+		}
+		if i == len(vpdBands)-1 {
+			output += fmt.Sprintf("| %.1f and up | %s%s |\n", lower, b.name, marker)
+			break
+		}
+		if i == 0 {
+			output += fmt.Sprintf("| below %.1f | %s%s |\n", b.below, b.name, marker)
+		} else {
+			output += fmt.Sprintf("| %.1f-%.1f | %s%s |\n", lower, b.below, b.name, marker)
+		}
+		lower = b.below
+	}
+	return output
+}
+
+// handleCalculateVPD handles the calculate_vpd tool
+func (s *Server) handleCalculateVPD(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "calculate_vpd")
+
+	// Extract parameters
+	temperature, err := request.RequireFloat("temperature")
+	if err != nil {
+		logger.Warn("invalid temperature parameter", "error", err)
+		return mcp.NewToolResultError("temperature parameter is required and must be a number (°C)"), nil
+	}
+	if temperature < minVPDTemperature || temperature > maxVPDTemperature {
+		logger.Warn("temperature out of range", "temperature", temperature)
+		return mcp.NewToolResultError(fmt.Sprintf("temperature must be between %g and %g°C", minVPDTemperature, maxVPDTemperature)), nil
+	}
+
+	humidity, err := request.RequireFloat("humidity")
+	if err != nil {
+		logger.Warn("invalid humidity parameter", "error", err)
+		return mcp.NewToolResultError("humidity parameter is required and must be a number (%)"), nil
+	}
+	if humidity < 0 || humidity > 100 {
+		logger.Warn("humidity out of range", "humidity", humidity)
+		return mcp.NewToolResultError("humidity must be a percentage (0-100)"), nil
+	}
+
+	leafOffset := request.GetFloat("leaf_temp_offset", 0)
+	if math.Abs(leafOffset) > maxLeafTempOffset {
+		logger.Warn("leaf_temp_offset out of range", "leaf_temp_offset", leafOffset)
+		return mcp.NewToolResultError(fmt.Sprintf("leaf_temp_offset must be between -%g and %g°C", maxLeafTempOffset, maxLeafTempOffset)), nil
+	}
+
+	vpd := leafVaporPressureDeficit(temperature, humidity, leafOffset)
+
+	logger.Info("vpd calculated", "temperature", temperature, "humidity", humidity, "leaf_temp_offset", leafOffset, "vpd_kpa", vpd)
+
+	return mcp.NewToolResultText(formatVPD(temperature, humidity, leafOffset, vpd)), nil
+}
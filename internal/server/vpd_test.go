@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+func TestSaturationVaporPressure(t *testing.T) {
+	// Reference saturation vapor pressures over water (kPa)
+	tests := []struct {
+		temperature float64
+		want        float64
+	}{
+		{0, 0.611},
+		{10, 1.228},
+		{20, 2.338},
+		{25, 3.168},
+		{30, 4.243},
+	}
+
+	for _, tt := range tests {
+		if got := saturationVaporPressure(tt.temperature); math.Abs(got-tt.want) > 0.005 {
+			t.Errorf("saturationVaporPressure(%g) = %.3f, want %.3f", tt.temperature, got, tt.want)
+		}
+	}
+}
+
+func TestLeafVaporPressureDeficit(t *testing.T) {
+	tests := []struct {
+		name        string
+		temperature float64
+		humidity    float64
+		leafOffset  float64
+		want        float64
+	}{
+		{"saturated air", 25, 100, 0, 0},
+		{"dry air", 25, 0, 0, 3.168},
+		{"25°C at 50%", 25, 50, 0, 1.584},
+		{"20°C at 60%", 20, 60, 0, 0.935},
+		{"30°C at 70%", 30, 70, 0, 1.273},
+		{"cooler leaf", 25, 60, -2, 0.909},
+		{"leaf below dew point", 20, 90, -5, -0.400},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := leafVaporPressureDeficit(tt.temperature, tt.humidity, tt.leafOffset)
+			if math.Abs(got-tt.want) > 0.005 {
+				t.Errorf("leafVaporPressureDeficit(%g, %g, %g) = %.3f, want %.3f", tt.temperature, tt.humidity, tt.leafOffset, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyVPD(t *testing.T) {
+	tests := []struct {
+		vpd  float64
+		want string
+	}{
+		{-0.2, "too humid"},
+		{0.39, "too humid"},
+		{0.4, "propagation"},
+		{0.8, "vegetative"},
+		{1.19, "vegetative"},
+		{1.2, "flowering"},
+		{1.6, "too dry"},
+		{3.2, "too dry"},
+	}
+
+	for _, tt := range tests {
+		if got := classifyVPD(tt.vpd).name; got != tt.want {
+			t.Errorf("classifyVPD(%g) = %q, want %q", tt.vpd, got, tt.want)
+		}
+	}
+}
+
+func TestHandleCalculateVPD(t *testing.T) {
+	srv := newTestServer(t, &fakeClient{})
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    string
+	}{
+		{"air vpd", map[string]interface{}{"temperature": 25.0, "humidity": 50.0}, "", "**1.58 kPa - flowering**"},
+		{"leaf vpd", map[string]interface{}{"temperature": 25.0, "humidity": 60.0, "leaf_temp_offset": -2.0}, "", "**0.91 kPa - vegetative**"},
+		{"condensation", map[string]interface{}{"temperature": 20.0, "humidity": 90.0, "leaf_temp_offset": -5.0}, "", "water will condense"},
+		{"missing humidity", map[string]interface{}{"temperature": 25.0}, "humidity parameter is required", ""},
+		{"humidity out of range", map[string]interface{}{"temperature": 25.0, "humidity": 120.0}, "humidity must be a percentage", ""},
+		{"temperature out of range", map[string]interface{}{"temperature": 90.0, "humidity": 50.0}, "temperature must be between", ""},
+		{"offset out of range", map[string]interface{}{"temperature": 25.0, "humidity": 50.0, "leaf_temp_offset": -15.0}, "leaf_temp_offset must be between", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleCalculateVPD(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleCalculateVPD() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError || !strings.Contains(text, tt.want) {
+				t.Errorf("result = %q, want it to contain %q", text, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// potDryingRates is the approximate soil moisture lost per day, in percentage points,
+// by pot size. Smaller pots hold less water and dry out faster.
+var potDryingRates = map[string]float64{
+	"small":  8,
+	"medium": 5,
+	"large":  3,
+}
+
+// defaultPotSize is assumed when a plant doesn't say
+const defaultPotSize = "medium"
+
+// potSizes lists the accepted pot_size values
+var potSizes = []string{"small", "medium", "large"}
+
+// wateringEntry is one plant in a collection watering plan
+type wateringEntry struct {
+	pid      string
+	nickname string
+	moisture float64
+	potSize  string
+}
+
+// label is the name shown in the plan
+func (e wateringEntry) label() string {
+	if e.nickname != "" {
+		return fmt.Sprintf("%s (%s)", e.nickname, e.pid)
+	}
+	return e.pid
+}
+
+// parseWateringEntries validates the plants array
+func parseWateringEntries(raw interface{}) ([]wateringEntry, error) {
+	items, ok := raw.([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("plants parameter is required and must be a non-empty array of {pid, current_moisture} objects")
+	}
+
+	entries := make([]wateringEntry, 0, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("plants[%d] must be an object", i)
+		}
+		pid, _ := obj["pid"].(string)
+		if pid == "" {
+			return nil, fmt.Errorf("plants[%d].pid is required", i)
+		}
+		moisture, ok := obj["current_moisture"].(float64)
+		if !ok || moisture < 0 || moisture > 100 {
+			return nil, fmt.Errorf("plants[%d].current_moisture is required and must be a percentage (0-100)", i)
+		}
+		potSize, _ := obj["pot_size"].(string)
+		if potSize == "" {
+			potSize = defaultPotSize
+		}
+		if _, ok := potDryingRates[potSize]; !ok {
+			return nil, fmt.Errorf("plants[%d].pot_size must be one of: %s", i, strings.Join(potSizes, ", "))
+		}
+		nickname, _ := obj["nickname"].(string)
+		entries = append(entries, wateringEntry{pid: pid, nickname: nickname, moisture: moisture, potSize: potSize})
+	}
+	return entries, nil
+}
+
+// daysUntilWatering projects when soil at moisture drops to the plant's minimum, using the
+// same linear projection as project_conditions with a pot-size drying rate as the slope.
+// Soil wetter than the maximum simply takes longer to dry; zero means water today.
+func daysUntilWatering(moisture, min, max, ratePerDay float64) int {
+	trend := linearTrend{slopePerHour: -ratePerDay / 24, latestFit: moisture}
+	p := projectCrossing(trend, min, math.Max(max, moisture))
+	if p.status != "crossing" {
+		return 0
+	}
+	return int(p.hours / 24)
+}
+
+// scheduledPlant is a plant placed in the watering plan
+type scheduledPlant struct {
+	entry   wateringEntry
+	days    int
+	hours   float64 // hours of moisture left above the minimum, for ordering by urgency
+	minimum int
+}
+
+// wateringPlan is the consolidated schedule
+type wateringPlan struct {
+	days      []int                    // distinct watering days, soonest first
+	byDay     map[int][]scheduledPlant // plants per day, most urgent first
+	unplanned []string                 // plants that couldn't be scheduled, with the reason
+}
+
+// buildWateringPlan groups plants by the day they'll next need water
+func buildWateringPlan(entries []wateringEntry, fetched []plantFetch) wateringPlan {
+	plan := wateringPlan{byDay: map[int][]scheduledPlant{}}
+
+	for i, entry := range entries {
+		f := fetched[i]
+		switch {
+		case f.err != nil:
+			plan.unplanned = append(plan.unplanned, fmt.Sprintf("%s: %v", entry.label(), f.err))
+			continue
+		case f.details.MaxSoilMoist <= 0:
+			plan.unplanned = append(plan.unplanned, fmt.Sprintf("%s: no soil moisture data", entry.label()))
+			continue
+		}
+
+		min, max := float64(f.details.MinSoilMoist), float64(f.details.MaxSoilMoist)
+		rate := potDryingRates[entry.potSize]
+		sp := scheduledPlant{
+			entry:   entry,
+			days:    daysUntilWatering(entry.moisture, min, max, rate),
+			hours:   (entry.moisture - min) / rate * 24,
+			minimum: f.details.MinSoilMoist,
+		}
+		if _, seen := plan.byDay[sp.days]; !seen {
+			plan.days = append(plan.days, sp.days)
+		}
+		plan.byDay[sp.days] = append(plan.byDay[sp.days], sp)
+	}
+
+	sort.Ints(plan.days)
+	for _, day := range plan.days {
+		plants := plan.byDay[day]
+		sort.SliceStable(plants, func(i, j int) bool {
+			return plants[i].hours < plants[j].hours
+		})
+	}
+	return plan
+}
+
+// wateringDayLabel names a day offset relative to today
+func wateringDayLabel(days int, today time.Time) string {
+	switch days {
+	case 0:
+		return "Today"
+	case 1:
+		return fmt.Sprintf("Tomorrow (%s)", today.AddDate(0, 0, 1).Weekday())
+	}
+	return fmt.Sprintf("%s (in %d days)", today.AddDate(0, 0, days).Weekday(), days)
+}
+
+// formatWateringPlan renders the plan grouped by day
+func formatWateringPlan(plan wateringPlan, today time.Time) string {
+	output := "# Collection Watering Plan\n\n"
+
+	for _, day := range plan.days {
+		plants := plan.byDay[day]
+		output += fmt.Sprintf("## %s - water these %d\n\n", wateringDayLabel(day, today), len(plants))
+		for _, p := range plants {
+			output += fmt.Sprintf("- %s: %.0f%% now, water below %d%% (%s pot)\n", p.entry.label(), p.entry.moisture, p.minimum, p.entry.potSize)
+		}
+		output += "\n"
+	}
+
+	if len(plan.unplanned) > 0 {
+		output += "## Could Not Schedule\n\n"
+		for _, u := range plan.unplanned {
+			output += fmt.Sprintf("- %s\n", u)
+		}
+		output += "\n"
+	}
+
+	output += "_Drying rates are estimates by pot size (small 8, medium 5, large 3 points/day); check the soil before watering._\n"
+	return output
+}
+
+// handleCollectionWateringPlan handles the collection_watering_plan tool
+func (s *Server) handleCollectionWateringPlan(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "collection_watering_plan")
+
+	// Extract parameters
+	entries, err := parseWateringEntries(request.GetArguments()["plants"])
+	if err != nil {
+		logger.Warn("invalid plants parameter", "error", err)
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if err := s.checkBatchSize("plants", len(entries)); err != nil {
+		logger.Warn("batch too large", "plants", len(entries))
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	logger.Info("planning collection watering", "plants", len(entries))
+
+	pids := make([]string, len(entries))
+	for i, e := range entries {
+		pids[i] = e.pid
+	}
+	plan := buildWateringPlan(entries, s.fetchPlantsConcurrently(ctx, pids))
+
+	logger.Info("watering plan built", "plants", len(entries), "days", len(plan.days), "unplanned", len(plan.unplanned))
+
+	return mcp.NewToolResultText(formatWateringPlan(plan, time.Now())), nil
+}
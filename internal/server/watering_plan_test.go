@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestDaysUntilWatering(t *testing.T) {
+	tests := []struct {
+		name     string
+		moisture float64
+		rate     float64
+		expected int
+	}{
+		{"already dry", 10, 5, 0},
+		{"less than a day left", 23, 5, 0},
+		{"three days in a medium pot", 35, 5, 3},
+		{"same soil in a small pot", 35, 8, 1},
+		{"overwatered takes longer", 80, 5, 12},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ideal 20-60%
+			if got := daysUntilWatering(tt.moisture, 20, 60, tt.rate); got != tt.expected {
+				t.Errorf("daysUntilWatering() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHandleCollectionWateringPlan(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"monstera deliciosa|": {PID: "monstera deliciosa", MinSoilMoist: 20, MaxSoilMoist: 60},
+		"ficus lyrata|":       {PID: "ficus lyrata", MinSoilMoist: 20, MaxSoilMoist: 60},
+		"cactus|":             {PID: "cactus", MinTemp: 10, MaxTemp: 35},
+	}}
+	srv := newTestServer(t, client)
+
+	result, err := srv.handleCollectionWateringPlan(context.Background(), mcp.CallToolRequest{
+		Params: mcp.CallToolParams{Name: "collection_watering_plan", Arguments: map[string]interface{}{
+			"plants": []interface{}{
+				map[string]interface{}{"pid": "monstera deliciosa", "nickname": "Monty", "current_moisture": 35.0},
+				map[string]interface{}{"pid": "ficus lyrata", "current_moisture": 18.0, "pot_size": "small"},
+				map[string]interface{}{"pid": "monstera deliciosa", "nickname": "Baby Monty", "current_moisture": 36.0, "pot_size": "medium"},
+				map[string]interface{}{"pid": "cactus", "current_moisture": 10.0},
+				map[string]interface{}{"pid": "unknown", "current_moisture": 10.0},
+			},
+		}},
+	})
+	if err != nil || result.IsError {
+		t.Fatalf("handleCollectionWateringPlan() = %v, %v", result, err)
+	}
+	text := resultText(t, result)
+
+	today := strings.Index(text, "## Today - water these 1")
+	later := strings.Index(text, "(in 3 days) - water these 2")
+	if today < 0 || later < 0 || today > later {
+		t.Fatalf("expected today before the 3-day group, got:\n%s", text)
+	}
+	// Monty is drier, so it is more urgent within the group
+	if strings.Index(text, "- Monty") > strings.Index(text, "Baby Monty") {
+		t.Errorf("expected the drier plant first within a day, got:\n%s", text)
+	}
+	for _, want := range []string{"cactus: no soil moisture data", "unknown: get plant details"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in unplanned section, got:\n%s", want, text)
+		}
+	}
+
+	t.Run("invalid pot size", func(t *testing.T) {
+		_, err := parseWateringEntries([]interface{}{map[string]interface{}{"pid": "x", "current_moisture": 30.0, "pot_size": "huge"}})
+		if err == nil || !strings.Contains(err.Error(), "small, medium, large") {
+			t.Errorf("expected pot_size error, got %v", err)
+		}
+	})
+}
+
+func TestWateringDayLabel(t *testing.T) {
+	monday := time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC)
+	for days, expected := range map[int]string{0: "Today", 1: "Tomorrow (Tuesday)", 4: "Friday (in 4 days)"} {
+		if got := wateringDayLabel(days, monday); got != expected {
+			t.Errorf("wateringDayLabel(%d) = %q, want %q", days, got, expected)
+		}
+	}
+}
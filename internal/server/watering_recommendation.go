@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+// Watering recommendations, from most to least urgent
+const (
+	recommendWaterNow   = "water now"
+	recommendCheckLater = "check later"
+	recommendTooWet     = "do not water"
+)
+
+// wateringRecommendation is what to do about one moisture reading
+type wateringRecommendation struct {
+	action                string
+	moisture              float64
+	minMoisture, midpoint float64
+	maxMoisture           float64
+	checkInDays           int     // for recommendCheckLater: days until the minimum is reached
+	dryDays               int     // for recommendTooWet: days until the soil is back in range
+	ratePerDay            float64 // assumed drying rate, in percentage points per day
+	fromMidpoint          float64 // reading minus the midpoint, in percentage points
+	towardEdge            float64 // share of the way from the midpoint to the nearer edge of the band
+	edgeName              string  // "minimum" or "maximum"
+}
+
+// recommendWatering decides whether to water from a moisture reading, using the same
+// drying model as get_watering_schedule for a medium pot
+func recommendWatering(details *openplantbook.PlantDetails, moisture float64) wateringRecommendation {
+	ws := estimateWateringSchedule(details, referencePotLiters, moisture)
+	r := wateringRecommendation{
+		moisture:    moisture,
+		minMoisture: ws.minMoisture,
+		maxMoisture: ws.maxMoisture,
+		midpoint:    (ws.minMoisture + ws.maxMoisture) / 2,
+		ratePerDay:  ws.ratePerDay,
+	}
+
+	r.fromMidpoint = moisture - r.midpoint
+	r.edgeName = "minimum"
+	if r.fromMidpoint > 0 {
+		r.edgeName = "maximum"
+	}
+	if halfWidth := (r.maxMoisture - r.minMoisture) / 2; halfWidth > 0 {
+		r.towardEdge = math.Abs(r.fromMidpoint) / halfWidth
+	}
+
+	switch {
+	case moisture <= r.minMoisture || ws.nextDays == 0:
+		r.action = recommendWaterNow
+	case moisture > r.maxMoisture:
+		r.action = recommendTooWet
+		r.dryDays = int(math.Ceil((moisture - r.maxMoisture) / r.ratePerDay))
+	default:
+		r.action = recommendCheckLater
+		r.checkInDays = ws.nextDays
+	}
+	return r
+}
+
+// formatWateringRecommendation renders the recommendation and where the reading sits in
+// the ideal band
+func formatWateringRecommendation(details *openplantbook.PlantDetails, r wateringRecommendation) string {
+	output := fmt.Sprintf("# Watering Recommendation for %s\n\n", details.Alias)
+	switch r.action {
+	case recommendWaterNow:
+		reason := fmt.Sprintf("%.0f%% is at or below the %.0f%% minimum", r.moisture, r.minMoisture)
+		if r.moisture > r.minMoisture {
+			reason = fmt.Sprintf("%.0f%% will drop to the %.0f%% minimum within a day", r.moisture, r.minMoisture)
+		}
+		output += fmt.Sprintf("**💧 Water now** - %s. Water until the soil reaches about %.0f%%.\n\n", reason, r.maxMoisture)
+	case recommendTooWet:
+		output += fmt.Sprintf("**🚫 Do not water - soil too wet** - %.0f%% is above the %.0f%% maximum. Let it dry; it should be back in range in about %d day(s).\n\n", r.moisture, r.maxMoisture, r.dryDays)
+	default:
+		output += fmt.Sprintf("**⏳ Check in %d day(s)** - %.0f%% is within the ideal range and should reach the %.0f%% minimum around then.\n\n", r.checkInDays, r.moisture, r.minMoisture)
+	}
+
+	output += "## Where the reading sits\n\n"
+	output += fmt.Sprintf("- **Ideal soil moisture**: %.0f-%.0f%%, midpoint %.0f%%\n", r.minMoisture, r.maxMoisture, r.midpoint)
+	switch {
+	case r.fromMidpoint == 0:
+		output += fmt.Sprintf("- **Reading**: %.0f%%, right at the midpoint\n", r.moisture)
+	case r.towardEdge > 1:
+		output += fmt.Sprintf("- **Reading**: %.0f%%, %.0f points %s the midpoint and past the %s\n", r.moisture, math.Abs(r.fromMidpoint), aboveOrBelow(r.fromMidpoint), r.edgeName)
+	default:
+		output += fmt.Sprintf("- **Reading**: %.0f%%, %.0f points %s the midpoint (%.0f%% of the way to the %s)\n", r.moisture, math.Abs(r.fromMidpoint), aboveOrBelow(r.fromMidpoint), r.towardEdge*100, r.edgeName)
+	}
+	output += fmt.Sprintf("- **Drying rate**: about %.1f points/day, assuming a medium (%g L) pot\n\n", r.ratePerDay, referencePotLiters)
+	output += "_Use `get_watering_schedule` for other pot sizes. Check the soil before watering._\n"
+	return output
+}
+
+// aboveOrBelow names the side of a signed difference
+func aboveOrBelow(diff float64) string {
+	if diff > 0 {
+		return "above"
+	}
+	return "below"
+}
+
+// handleGetWateringRecommendation handles the get_watering_recommendation tool
+func (s *Server) handleGetWateringRecommendation(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "get_watering_recommendation")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	moisture, err := request.RequireFloat("moisture")
+	if err != nil {
+		logger.Warn("invalid moisture parameter", "error", err)
+		return mcp.NewToolResultError("moisture parameter is required and must be a number"), nil
+	}
+	if moisture < 0 || moisture > 100 {
+		logger.Warn("moisture out of range", "moisture", moisture)
+		return mcp.NewToolResultError("moisture must be a percentage (0-100)"), nil
+	}
+
+	logger.Info("recommending watering", "pid", pid, "moisture", moisture)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxSoilMoist <= 0 {
+		logger.Warn("plant has no moisture data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("no moisture data available for this plant (%s), so a watering recommendation can't be made", pid)), nil
+	}
+
+	r := recommendWatering(details, moisture)
+
+	logger.Info("watering recommended", "pid", details.PID, "action", r.action)
+
+	return mcp.NewToolResultText(formatWateringRecommendation(details, r)), nil
+}
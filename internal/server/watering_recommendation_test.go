@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestRecommendWatering(t *testing.T) {
+	// Without temperature or humidity data the medium pot dries 5 points a day
+	details := &openplantbook.PlantDetails{Alias: "Pothos", MinSoilMoist: 20, MaxSoilMoist: 60}
+
+	tests := []struct {
+		name     string
+		moisture float64
+		action   string
+		want     []string
+	}{
+		{"dry", 10, recommendWaterNow, []string{"**💧 Water now** - 10% is at or below the 20% minimum", "30 points below the midpoint and past the minimum"}},
+		{"almost dry", 22, recommendWaterNow, []string{"22% will drop to the 20% minimum within a day", "(90% of the way to the minimum)"}},
+		{"in range", 45, recommendCheckLater, []string{"**⏳ Check in 5 day(s)**", "5 points above the midpoint (25% of the way to the maximum)"}},
+		{"midpoint", 40, recommendCheckLater, []string{"midpoint 40%", "right at the midpoint"}},
+		{"too wet", 75, recommendTooWet, []string{"**🚫 Do not water - soil too wet** - 75% is above the 60% maximum", "back in range in about 3 day(s)", "past the maximum"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := recommendWatering(details, tt.moisture)
+			if r.action != tt.action {
+				t.Errorf("action = %q, want %q", r.action, tt.action)
+			}
+			output := formatWateringRecommendation(details, r)
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output missing %q:\n%s", want, output)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleGetWateringRecommendation(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"epipremnum aureum|en": {PID: "epipremnum aureum", Alias: "Pothos", MinSoilMoist: 20, MaxSoilMoist: 60},
+		"no moisture|en":       {PID: "no moisture", Alias: "Mystery", MinTemp: 10, MaxTemp: 30},
+	}}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    string
+	}{
+		{"recommendation", map[string]interface{}{"pid": "epipremnum aureum", "moisture": 15.0}, "", "Water now"},
+		{"missing moisture", map[string]interface{}{"pid": "epipremnum aureum"}, "moisture parameter is required", ""},
+		{"moisture above 100", map[string]interface{}{"pid": "epipremnum aureum", "moisture": 120.0}, "moisture must be a percentage (0-100)", ""},
+		{"negative moisture", map[string]interface{}{"pid": "epipremnum aureum", "moisture": -1.0}, "moisture must be a percentage (0-100)", ""},
+		{"no moisture data", map[string]interface{}{"pid": "no moisture", "moisture": 30.0}, "no moisture data available", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleGetWateringRecommendation(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleGetWateringRecommendation() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError || !strings.Contains(text, tt.want) {
+				t.Errorf("result = %q, want it to contain %q", text, tt.want)
+			}
+		})
+	}
+}
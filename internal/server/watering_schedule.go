@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rmrfslashbin/openplantbook-go"
+)
+
+const (
+	// referencePotLiters is the pot the base drying rate applies to: the "medium" pot of
+	// collection_watering_plan
+	referencePotLiters = 5.0
+
+	// maxPotLiters rejects values that are almost certainly not liters
+	maxPotLiters = 500.0
+
+	// referenceTemperature and referenceHumidity are the conditions the base drying rate
+	// applies to
+	referenceTemperature = 20.0
+	referenceHumidity    = 50.0
+)
+
+// vaporPressureDeficit is how strongly air pulls water out of the soil, in kPa: the
+// saturation vapor pressure minus the vapor already in the air
+func vaporPressureDeficit(temperature, humidity float64) float64 {
+	return saturationVaporPressure(temperature) * (1 - humidity/100)
+}
+
+// evaporationFactor scales the drying rate by the vapor pressure deficit relative to the
+// reference conditions, clamped so extreme ranges can't produce absurd intervals
+func evaporationFactor(temperature, humidity float64) float64 {
+	factor := vaporPressureDeficit(temperature, humidity) / vaporPressureDeficit(referenceTemperature, referenceHumidity)
+	return math.Min(math.Max(factor, 0.5), 2)
+}
+
+// potDryingRate is the moisture lost per day, in percentage points, for a pot of the given
+// volume. Evaporation scales with the soil surface, so relative to the volume a pot dries
+// with the inverse cube root of its size: a 1 L pot dries about 1.7x faster than 5 L.
+func potDryingRate(liters float64) float64 {
+	return potDryingRates[defaultPotSize] * math.Cbrt(referencePotLiters/liters)
+}
+
+// wateringSchedule is an estimated watering cadence for one plant
+type wateringSchedule struct {
+	minMoisture, maxMoisture float64
+	potLiters                float64
+	temperature, humidity    float64 // assumed conditions
+	assumedFromRanges        bool    // whether they came from the plant's ideal ranges
+	factor                   float64 // evaporation relative to the reference conditions
+	ratePerDay               float64
+	intervalDays             float64 // from the ideal maximum down to the minimum
+	nextDays                 int     // -1 when the current moisture is unknown
+	moisture                 float64
+}
+
+// estimateWateringSchedule models the soil drying linearly from the plant's ideal maximum
+// to its minimum. The drying rate depends on the pot volume and on evaporation at the
+// midpoint of the plant's ideal temperature and humidity (the reference conditions when
+// the plant has no data for them). moisture is negative when unknown.
+func estimateWateringSchedule(details *openplantbook.PlantDetails, liters, moisture float64) wateringSchedule {
+	ws := wateringSchedule{
+		minMoisture: float64(details.MinSoilMoist),
+		maxMoisture: float64(details.MaxSoilMoist),
+		potLiters:   liters,
+		temperature: referenceTemperature,
+		humidity:    referenceHumidity,
+		nextDays:    -1,
+		moisture:    moisture,
+	}
+	if details.MaxTemp > 0 {
+		ws.temperature = (details.MinTemp + details.MaxTemp) / 2
+		ws.assumedFromRanges = true
+	}
+	if details.MaxEnvHumid > 0 {
+		ws.humidity = float64(details.MinEnvHumid+details.MaxEnvHumid) / 2
+		ws.assumedFromRanges = true
+	}
+
+	ws.factor = evaporationFactor(ws.temperature, ws.humidity)
+	ws.ratePerDay = potDryingRate(liters) * ws.factor
+	ws.intervalDays = math.Max((ws.maxMoisture-ws.minMoisture)/ws.ratePerDay, 1)
+	if moisture >= 0 {
+		ws.nextDays = daysUntilWatering(moisture, ws.minMoisture, ws.maxMoisture, ws.ratePerDay)
+	}
+	return ws
+}
+
+// formatWateringSchedule renders the estimate
+func formatWateringSchedule(details *openplantbook.PlantDetails, ws wateringSchedule, now time.Time) string {
+	interval := int(math.Round(ws.intervalDays))
+
+	output := fmt.Sprintf("# Watering Schedule for %s\n\n", details.Alias)
+	output += fmt.Sprintf("- **Estimated interval**: water about every %d day(s)\n", interval)
+	switch {
+	case ws.nextDays < 0:
+		output += fmt.Sprintf("- **Next watering**: about %d day(s) after the next thorough watering (around %s if you water today). Pass `current_moisture` for a date from today's reading\n",
+			interval, now.AddDate(0, 0, interval).Format("Mon Jan 2"))
+	case ws.nextDays == 0:
+		output += fmt.Sprintf("- **Next watering**: today - %.0f%% is at or below the %.0f%% minimum\n", ws.moisture, ws.minMoisture)
+	default:
+		output += fmt.Sprintf("- **Next watering**: %s, in about %d day(s) (%.0f%% now)\n", now.AddDate(0, 0, ws.nextDays).Format("Mon Jan 2"), ws.nextDays, ws.moisture)
+	}
+	output += "\n## How this was estimated\n\n"
+	output += fmt.Sprintf("- **Ideal soil moisture**: %.0f-%.0f%%; water when it drops to %.0f%%, until it reaches about %.0f%%\n", ws.minMoisture, ws.maxMoisture, ws.minMoisture, ws.maxMoisture)
+	output += fmt.Sprintf("- **Pot**: %g L, drying about %.1f points/day\n", ws.potLiters, ws.ratePerDay)
+	conditions := "reference conditions, as the plant has no temperature or humidity data"
+	if ws.assumedFromRanges {
+		conditions = "the middle of the plant's ideal ranges"
+	}
+	output += fmt.Sprintf("- **Conditions**: %.1f°C and %.0f%% humidity (%s), so evaporation is %.1fx that of %.0f°C and %.0f%%\n\n",
+		ws.temperature, ws.humidity, conditions, ws.factor, referenceTemperature, referenceHumidity)
+	output += "_This is a simple linear model: sun, airflow, soil mix and the season all change real drying times. Check the soil before watering._\n"
+	return output
+}
+
+// handleGetWateringSchedule handles the get_watering_schedule tool
+func (s *Server) handleGetWateringSchedule(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	traceID := traceIDFromContext(ctx)
+	logger := s.logger.With("trace_id", traceID, "tool", "get_watering_schedule")
+
+	// Extract parameters
+	pid, err := request.RequireString("pid")
+	if err != nil {
+		logger.Warn("invalid pid parameter", "error", err)
+		return mcp.NewToolResultError("pid parameter is required and must be a string"), nil
+	}
+
+	liters := request.GetFloat("pot_size_liters", referencePotLiters)
+	if liters <= 0 || liters > maxPotLiters {
+		logger.Warn("invalid pot_size_liters parameter", "pot_size_liters", liters)
+		return mcp.NewToolResultError(fmt.Sprintf("pot_size_liters must be greater than 0 and at most %g", maxPotLiters)), nil
+	}
+
+	moisture := request.GetFloat("current_moisture", -1)
+	if _, given := request.GetArguments()["current_moisture"]; given && (moisture < 0 || moisture > 100) {
+		logger.Warn("invalid current_moisture parameter", "current_moisture", moisture)
+		return mcp.NewToolResultError("current_moisture must be a percentage (0-100)"), nil
+	}
+
+	logger.Info("estimating watering schedule", "pid", pid, "pot_size_liters", liters)
+
+	// Get plant details
+	details, err := s.getPlantDetails(ctx, pid, "")
+	if err != nil {
+		logger.Error("get details failed", "error", err)
+		return mcp.NewToolResultError(fmt.Sprintf("failed to get plant details: %v", err)), nil
+	}
+	if details.MaxSoilMoist <= 0 {
+		logger.Warn("plant has no moisture data", "pid", pid)
+		return mcp.NewToolResultError(fmt.Sprintf("no moisture data available for this plant (%s), so a watering interval can't be estimated", pid)), nil
+	}
+
+	ws := estimateWateringSchedule(details, liters, moisture)
+
+	logger.Info("watering schedule estimated", "pid", details.PID, "interval_days", ws.intervalDays, "next_days", ws.nextDays)
+
+	return mcp.NewToolResultText(formatWateringSchedule(details, ws, time.Now())), nil
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	mcp "github.com/mark3labs/mcp-go/mcp"
+	openplantbook "github.com/rmrfslashbin/openplantbook-go"
+)
+
+func TestEvaporationFactor(t *testing.T) {
+	if got := evaporationFactor(referenceTemperature, referenceHumidity); math.Abs(got-1) > 1e-9 {
+		t.Errorf("reference conditions factor = %v, want 1", got)
+	}
+	if warm, humid := evaporationFactor(28, 50), evaporationFactor(20, 80); warm <= 1 || humid >= 1 {
+		t.Errorf("warm factor = %v (want > 1), humid factor = %v (want < 1)", warm, humid)
+	}
+	if got := evaporationFactor(40, 5); got != 2 {
+		t.Errorf("hot, dry factor = %v, want clamped to 2", got)
+	}
+	if got := evaporationFactor(5, 98); got != 0.5 {
+		t.Errorf("cold, saturated factor = %v, want clamped to 0.5", got)
+	}
+}
+
+func TestEstimateWateringSchedule(t *testing.T) {
+	details := &openplantbook.PlantDetails{MinSoilMoist: 20, MaxSoilMoist: 60}
+
+	// Without temperature or humidity data the reference conditions apply
+	ws := estimateWateringSchedule(details, referencePotLiters, -1)
+	if ws.assumedFromRanges || ws.ratePerDay != potDryingRates[defaultPotSize] {
+		t.Fatalf("reference schedule = %+v, want the medium pot rate", ws)
+	}
+	if ws.intervalDays != 8 || ws.nextDays != -1 {
+		t.Errorf("interval = %v, next = %d; want 8 days and an unknown next watering", ws.intervalDays, ws.nextDays)
+	}
+
+	small := estimateWateringSchedule(details, 1, -1)
+	large := estimateWateringSchedule(details, 20, -1)
+	if !(small.intervalDays < ws.intervalDays && ws.intervalDays < large.intervalDays) {
+		t.Errorf("intervals by pot = %v (1 L), %v (5 L), %v (20 L); want smaller pots to dry faster", small.intervalDays, ws.intervalDays, large.intervalDays)
+	}
+
+	// A tropical plant's warm, humid ranges change the rate
+	tropical := *details
+	tropical.MinTemp, tropical.MaxTemp = 24, 32
+	tropical.MinEnvHumid, tropical.MaxEnvHumid = 40, 60
+	if got := estimateWateringSchedule(&tropical, referencePotLiters, -1); !got.assumedFromRanges || got.temperature != 28 || got.ratePerDay <= ws.ratePerDay {
+		t.Errorf("tropical schedule = %+v, want faster drying at 28°C", got)
+	}
+
+	if got := estimateWateringSchedule(details, referencePotLiters, 45); got.nextDays != 5 {
+		t.Errorf("next watering from 45%% = %d days, want 5", got.nextDays)
+	}
+	if got := estimateWateringSchedule(details, referencePotLiters, 10); got.nextDays != 0 {
+		t.Errorf("next watering from 10%% = %d days, want today", got.nextDays)
+	}
+}
+
+func TestFormatWateringSchedule(t *testing.T) {
+	details := &openplantbook.PlantDetails{Alias: "Pothos", MinSoilMoist: 20, MaxSoilMoist: 60}
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	output := formatWateringSchedule(details, estimateWateringSchedule(details, referencePotLiters, 45), now)
+	for _, want := range []string{"# Watering Schedule for Pothos", "water about every 8 day(s)", "Mon May 6, in about 5 day(s) (45% now)", "reference conditions"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+
+	output = formatWateringSchedule(details, estimateWateringSchedule(details, referencePotLiters, -1), now)
+	if !strings.Contains(output, "around Thu May 9 if you water today") {
+		t.Errorf("output without a reading should date from a watering today:\n%s", output)
+	}
+}
+
+func TestHandleGetWateringSchedule(t *testing.T) {
+	client := &fakeClient{details: map[string]*openplantbook.PlantDetails{
+		"epipremnum aureum|en": {PID: "epipremnum aureum", Alias: "Pothos", MinSoilMoist: 20, MaxSoilMoist: 60},
+		"no moisture|en":       {PID: "no moisture", Alias: "Mystery", MinTemp: 10, MaxTemp: 30},
+	}}
+	srv := newTestServer(t, client)
+
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		wantErr string
+		want    string
+	}{
+		{"schedule", map[string]interface{}{"pid": "epipremnum aureum", "pot_size_liters": 2.0}, "", "2 L"},
+		{"no moisture data", map[string]interface{}{"pid": "no moisture"}, "no moisture data available", ""},
+		{"bad pot size", map[string]interface{}{"pid": "epipremnum aureum", "pot_size_liters": 0.0}, "pot_size_liters must be greater than 0", ""},
+		{"bad moisture", map[string]interface{}{"pid": "epipremnum aureum", "current_moisture": 140.0}, "current_moisture must be a percentage", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := mcp.CallToolRequest{}
+			request.Params.Arguments = tt.args
+			result, err := srv.handleGetWateringSchedule(context.Background(), request)
+			if err != nil {
+				t.Fatalf("handleGetWateringSchedule() error = %v", err)
+			}
+			text := resultText(t, result)
+			if tt.wantErr != "" {
+				if !result.IsError || !strings.Contains(text, tt.wantErr) {
+					t.Errorf("result = %q, want error containing %q", text, tt.wantErr)
+				}
+				return
+			}
+			if result.IsError || !strings.Contains(text, tt.want) {
+				t.Errorf("result = %q, want it to contain %q", text, tt.want)
+			}
+		})
+	}
+}